@@ -119,6 +119,11 @@ module.exports = {
               directory: false,
               path: "/ibc/proposals.html"
             },
+            {
+              title: "Channel Upgrades",
+              directory: false,
+              path: "/ibc/channel-upgrades.html"
+            },
             {
               title: "Relayer",
               directory: false,
@@ -176,6 +181,42 @@ module.exports = {
                 },
             ]
             },
+            {
+              title: "Packet Forward Middleware",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/packet-forward/overview.html"
+                },
+              ]
+            },
+            {
+              title: "Rate Limiting Middleware",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/rate-limiting/overview.html"
+                },
+              ]
+            },
+            {
+              title: "Fee Middleware",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/fee/overview.html"
+                },
+              ]
+            },
           ]
         },
         {
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 )
 
@@ -15,8 +16,29 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
+		GetCmdQueryInterchainAccounts(),
+		GetCmdQueryChannelHistory(),
 		GetCmdParams(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd returns the transaction commands for the ICA controller submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "controller",
+		Short:                      "interchain-accounts controller subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterInterchainAccountCmd(),
+		NewReopenInterchainAccountChannelCmd(),
+		NewSendTxCmd(),
+	)
+
+	return txCmd
+}
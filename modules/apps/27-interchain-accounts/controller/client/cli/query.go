@@ -11,6 +11,11 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 )
 
+const (
+	flagConnectionID = "connection-id"
+	flagOwnerPrefix  = "owner-prefix"
+)
+
 // GetCmdQueryInterchainAccount returns the command handler for the controller submodule parameter querying.
 func GetCmdQueryInterchainAccount() *cobra.Command {
 	cmd := &cobra.Command{
@@ -45,6 +50,93 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelHistory returns the command handler for querying the channels opened for an interchain account.
+func GetCmdQueryChannelHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-history [owner] [connection-id]",
+		Short:   "Query the channels opened for a given owner on a particular connection",
+		Long:    "Query the controller submodule for the channels opened for a given owner on a particular connection, ordered by the height at which they were opened",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller channel-history cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryChannelHistoryRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.ChannelHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInterchainAccounts returns the command handler for querying the interchain accounts registered on the controller chain.
+func GetCmdQueryInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query the interchain accounts registered on the controller chain",
+		Long:    "Query the controller submodule for the interchain accounts registered on the controller chain, including their owner and active channel. The results may be filtered by connection and owner address prefix",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-accounts --connection-id connection-0 --owner-prefix cosmos1", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			ownerPrefix, err := cmd.Flags().GetString(flagOwnerPrefix)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountsRequest{
+				ConnectionId: connectionID,
+				OwnerPrefix:  ownerPrefix,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagConnectionID, "", "Filter the interchain accounts by controller connection identifier")
+	cmd.Flags().String(flagOwnerPrefix, "", "Filter the interchain accounts by owner address prefix")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for the controller submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+const (
+	// The channel version to be used in the channel open init call
+	flagVersion = "version"
+	// The channel ordering to be used in the channel open init call
+	flagOrdering = "ordering"
+	// The relative timeout timestamp in nanoseconds added to the current block time
+	flagRelativePacketTimeout = "relative-packet-timeout"
+)
+
+// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+// relative to the current block timestamp of the controller chain. The timeout is disabled when set to 0.
+var DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+// NewRegisterInterchainAccountCmd returns the command to create a MsgRegisterInterchainAccount
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account on the provided connection.",
+		Long: strings.TrimSpace(`Register an interchain account on the provided connection. The signer of the transaction
+is the owner of the interchain account. The channel version may be set using the "version" flag, the default
+interchain accounts metadata is used if it is omitted. The channel ordering may be set to ORDERED or UNORDERED using
+the "ordering" flag, packets timing out on an UNORDERED channel do not close the channel.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller register connection-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			owner := clientCtx.GetFromAddress().String()
+
+			version, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			orderingFlag, err := cmd.Flags().GetString(flagOrdering)
+			if err != nil {
+				return err
+			}
+
+			ordering, found := channeltypes.Order_value[fmt.Sprintf("ORDER_%s", strings.ToUpper(orderingFlag))]
+			if !found {
+				return fmt.Errorf("invalid channel ordering %s, expected one of [%s, %s]", orderingFlag, channeltypes.ORDERED, channeltypes.UNORDERED)
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, version, channeltypes.Order(ordering))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
+	cmd.Flags().String(flagOrdering, "ORDERED", "Channel ordering, ORDERED or UNORDERED")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewReopenInterchainAccountChannelCmd returns the command to create a MsgReopenInterchainAccountChannel
+func NewReopenInterchainAccountChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen [connection-id]",
+		Short: "Reopen the closed interchain account channel on the provided connection.",
+		Long: strings.TrimSpace(`Reopen the closed interchain account channel on the provided connection. The signer of the transaction
+is the owner of the interchain account. The version of the closed channel is reused, such that the existing interchain
+account is reused on the host chain.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller reopen connection-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReopenInterchainAccountChannel(args[0], clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendTxCmd returns the command to create a MsgSendTx
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_msg.json]",
+		Short: "Send an interchain account tx on the provided connection.",
+		Long: strings.TrimSpace(`Send an interchain account tx on the provided connection. The signer of the transaction
+is the owner of the interchain account. The packet data must be the JSON encoded InterchainAccountPacketData, provided
+either as a file path or as a raw JSON string. The packet timeout is relative to the block time of the controller chain
+and may be set using the "relative-packet-timeout" flag.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller send-tx connection-0 packet-data.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			owner := clientCtx.GetFromAddress().String()
+
+			// attempt to read the packet data from a file, falling back to a raw JSON string
+			packetDataBz, err := os.ReadFile(args[1])
+			if err != nil {
+				packetDataBz = []byte(args[1])
+			}
+
+			var packetData icatypes.InterchainAccountPacketData
+			if err := clientCtx.Codec.UnmarshalJSON(packetDataBz, &packetData); err != nil {
+				return fmt.Errorf("error unmarshalling packet data: %w", err)
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendTx(owner, connectionID, relativeTimeout, packetData)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, DefaultRelativePacketTimeoutTimestamp, "Relative packet timeout in nanoseconds from the controller chain block time")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,6 +10,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -19,7 +20,9 @@ type IBCModule struct {
 	app    porttypes.IBCModule
 }
 
-// NewIBCModule creates a new IBCModule given the associated keeper and underlying application
+// NewIBCModule creates a new IBCModule given the associated keeper and underlying application.
+// The underlying application may be nil if interchain accounts are only registered and used
+// through the controller msg server.
 func NewIBCModule(k keeper.Keeper, app porttypes.IBCModule) IBCModule {
 	return IBCModule{
 		keeper: k,
@@ -52,8 +55,14 @@ func (im IBCModule) OnChanOpenInit(
 	}
 
 	// call underlying app's OnChanOpenInit callback with the appVersion
-	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
-		chanCap, counterparty, version)
+	// the underlying app is responsible for claiming the channel capability
+	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, portID, connectionHops[0]) {
+		return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
+			chanCap, counterparty, version)
+	}
+
+	// claim the channel capability such that packets may be sent through the msg server
+	return im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
 }
 
 // OnChanOpenTry implements the IBCModule interface
@@ -91,8 +100,17 @@ func (im IBCModule) OnChanOpenAck(
 		return err
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
 	// call underlying app's OnChanOpenAck callback with the counterparty app version.
-	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, portID, connectionID) {
+		return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	}
+
+	return nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -123,6 +141,89 @@ func (im IBCModule) OnChanCloseConfirm(
 	return im.keeper.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
+// OnChanUpgradeInit implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	if !im.keeper.IsControllerEnabled(ctx) {
+		return "", types.ErrControllerSubModuleDisabled
+	}
+
+	version, err := im.keeper.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+	if err != nil {
+		return "", err
+	}
+
+	// call underlying app's OnChanUpgradeInit callback, the underlying app may not modify the version.
+	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, portID, connectionHops[0]) {
+		if _, err := im.app.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version); err != nil {
+			return "", err
+		}
+	}
+
+	return version, nil
+}
+
+// OnChanUpgradeTry implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	return "", sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrade handshake must be initiated by controller chain")
+}
+
+// OnChanUpgradeAck implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	if !im.keeper.IsControllerEnabled(ctx) {
+		return types.ErrControllerSubModuleDisabled
+	}
+
+	if err := im.keeper.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion); err != nil {
+		return err
+	}
+
+	connectionID, err := im.keeper.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	// call underlying app's OnChanUpgradeAck callback with the counterparty app version.
+	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, portID, connectionID) {
+		return im.app.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+	}
+
+	return nil
+}
+
+// OnChanUpgradeOpen implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) {
+	// call underlying app's OnChanUpgradeOpen callback with the upgraded version.
+	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, portID, connectionHops[0]) {
+		im.app.OnChanUpgradeOpen(ctx, portID, channelID, order, connectionHops, version)
+	}
+}
+
 // OnRecvPacket implements the IBCModule interface
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
@@ -143,6 +244,25 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return types.ErrControllerSubModuleDisabled
 	}
 
+	connectionID, err := im.keeper.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if im.app == nil || !im.keeper.IsMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
+		return nil
+	}
+
+	// deliver the decoded execution result if supported by the underlying app
+	if handler, ok := im.app.(types.ExecutionResultHandler); ok {
+		result, err := im.keeper.GetExecutionResult(packet, acknowledgement)
+		if err != nil {
+			return err
+		}
+
+		return handler.OnExecutionResult(ctx, packet, result, relayer)
+	}
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
@@ -161,5 +281,14 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	connectionID, err := im.keeper.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	return nil
 }
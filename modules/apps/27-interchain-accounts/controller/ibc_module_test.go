@@ -5,13 +5,17 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
+	icacontroller "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -129,8 +133,13 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 			}, false,
 		},
 		{
-			"ICA OnChanOpenInit fails - UNORDERED channel", func() {
+			"success: UNORDERED channel", func() {
 				channel.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"ICA OnChanOpenInit fails - unspecified channel ordering", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 		{
@@ -143,6 +152,18 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 				}
 			}, false,
 		},
+		{
+			"ICA auth module callback is not called - middleware disabled", func() {
+				suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnChanOpenInit = func(ctx sdk.Context, order channeltypes.Order, connectionHops []string,
+					portID, channelID string, chanCap *capabilitytypes.Capability,
+					counterparty channeltypes.Counterparty, version string,
+				) error {
+					return fmt.Errorf("mock ica auth fails")
+				}
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), TestPortID, channel.ConnectionHops[0])
+			}, true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -546,6 +567,111 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 	}
 }
 
+// executionResultApp wraps the mock ICA auth module, recording the execution results delivered by the controller
+type executionResultApp struct {
+	porttypes.IBCModule
+
+	results []icatypes.ExecutionResult
+}
+
+// OnExecutionResult implements the ExecutionResultHandler interface
+func (app *executionResultApp) OnExecutionResult(
+	ctx sdk.Context, packet channeltypes.Packet, result icatypes.ExecutionResult, relayer sdk.AccAddress,
+) error {
+	app.results = append(app.results, result)
+	return nil
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacketExecutionResult() {
+	var (
+		path            *ibctesting.Path
+		acknowledgement []byte
+		expResult       icatypes.ExecutionResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {
+				txMsgData := &sdk.TxMsgData{
+					Data: []*sdk.MsgData{{MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+				}
+				bz, err := proto.Marshal(txMsgData)
+				suite.Require().NoError(err)
+
+				acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+				expResult = icatypes.ExecutionResult{
+					MsgResponses: []icatypes.MsgResponse{
+						{MsgTypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), Response: &banktypes.MsgSendResponse{}},
+					},
+				}
+			}, true,
+		},
+		{
+			"success: error acknowledgement", func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgement("ABCI code: 4: error handling packet on host chain: see events for details").Acknowledgement()
+				expResult = icatypes.ExecutionResult{
+					Error: &icatypes.ExecutionError{
+						Code:    4,
+						Message: "ABCI code: 4: error handling packet on host chain: see events for details",
+					},
+				}
+			}, true,
+		},
+		{
+			"invalid acknowledgement", func() {
+				acknowledgement = []byte("ack")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			tc.malleate() // malleate mutates test data
+
+			app := &executionResultApp{IBCModule: suite.chainA.GetSimApp().ICAAuthModule}
+			cbs := icacontroller.NewIBCModule(suite.chainA.GetSimApp().ICAControllerKeeper, app)
+
+			err = cbs.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, acknowledgement, nil)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]icatypes.ExecutionResult{expResult}, app.results)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(app.results)
+			}
+		})
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 	var path *ibctesting.Path
 
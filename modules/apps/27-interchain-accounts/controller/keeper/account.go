@@ -14,59 +14,111 @@ import (
 // It generates a new port identifier using the owner address. It will bind to the
 // port identifier and call 04-channel 'ChanOpenInit'. An error is returned if the port
 // identifier is already in use. Gaining access to interchain accounts whose channels
-// have closed cannot be done with this function. A regular MsgChanOpenInit must be used.
+// have closed cannot be done with this function. ReopenInterchainAccountChannel must be used.
+//
+// The underlying application callbacks are enabled for accounts registered through this function.
+// Chains which do not use an authentication module should use MsgRegisterInterchainAccount instead.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) error {
+	return k.RegisterInterchainAccountWithOrdering(ctx, connectionID, owner, channeltypes.ORDERED)
+}
+
+// RegisterInterchainAccountWithOrdering registers an interchain account in the same manner as RegisterInterchainAccount,
+// opening a channel with the provided ordering. Packets timing out on an UNORDERED channel do not close the channel.
+func (k Keeper) RegisterInterchainAccountWithOrdering(ctx sdk.Context, connectionID, owner string, ordering channeltypes.Order) error {
 	portID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
 		return err
 	}
 
+	k.SetMiddlewareEnabled(ctx, portID, connectionID)
+
+	if _, err := k.registerInterchainAccount(ctx, connectionID, portID, "", ordering); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReopenInterchainAccountChannel calls 04-channel 'ChanOpenInit' for an interchain account whose active channel has
+// been closed, returning the identifier of the new channel. The version and ordering of the closed channel are reused,
+// such that the existing interchain account is reused on the host chain once the channel handshake completes.
+// The underlying application callbacks remain enabled or disabled as they were for the closed channel.
+func (k Keeper) ReopenInterchainAccountChannel(ctx sdk.Context, connectionID, portID string) (string, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", activeChannelID, portID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelState, "active channel %s for portID %s must be %s to be reopened, got %s", activeChannelID, portID, channeltypes.CLOSED, channel.State)
+	}
+
+	return k.registerInterchainAccount(ctx, connectionID, portID, channel.Version, channel.Ordering)
+}
+
+// registerInterchainAccount registers an interchain account, returning the channel id of the MsgChannelOpenInitResponse
+// and an error if one occurred. If the provided version is empty, the default interchain accounts metadata is used.
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID, version string, ordering channeltypes.Order) (string, error) {
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s", activeChannelID, portID, connectionID)
 	}
 
 	switch {
 	case k.portKeeper.IsBound(ctx, portID) && !k.IsBound(ctx, portID):
-		return sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
+		return "", sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
 	case !k.portKeeper.IsBound(ctx, portID):
 		cap := k.BindPort(ctx, portID)
 		if err := k.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
-			return sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
 		}
 	}
 
-	connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
-	if err != nil {
-		return err
-	}
+	if version == "" {
+		connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
+		if err != nil {
+			return "", err
+		}
 
-	// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
-	metadata := icatypes.NewMetadata(
-		icatypes.Version,
-		connectionID,
-		connectionEnd.GetCounterparty().GetConnectionID(),
-		"",
-		icatypes.EncodingProtobuf,
-		icatypes.TxTypeSDKMultiMsg,
-	)
-
-	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
-	if err != nil {
-		return err
+		// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
+		metadata := icatypes.NewMetadata(
+			icatypes.Version,
+			connectionID,
+			connectionEnd.GetCounterparty().GetConnectionID(),
+			"",
+			icatypes.EncodingProtobuf,
+			icatypes.TxTypeSDKMultiMsg,
+		)
+
+		versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+		if err != nil {
+			return "", err
+		}
+
+		version = string(versionBytes)
 	}
 
-	msg := channeltypes.NewMsgChannelOpenInit(portID, string(versionBytes), channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, authtypes.NewModuleAddress(icatypes.ModuleName).String())
+	msg := channeltypes.NewMsgChannelOpenInit(portID, version, ordering, []string{connectionID}, icatypes.PortID, authtypes.NewModuleAddress(icatypes.ModuleName).String())
 	handler := k.msgRouter.Handler(msg)
 
 	res, err := handler(ctx, msg)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
-	return nil
+	var channelOpenInitResponse channeltypes.MsgChannelOpenInitResponse
+	if err := k.cdc.Unmarshal(res.Data, &channelOpenInitResponse); err != nil {
+		return "", err
+	}
+
+	return channelOpenInitResponse.ChannelId, nil
 }
@@ -100,3 +100,32 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path2.EndpointA.ConnectionID, owner)
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountWithOrdering() {
+	for _, ordering := range []channeltypes.Order{channeltypes.ORDERED, channeltypes.UNORDERED} {
+		suite.Run(ordering.String(), func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccountWithOrdering(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress, ordering)
+			suite.Require().NoError(err)
+
+			channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), TestPortID, ibctesting.FirstChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(ordering, channel.Ordering)
+
+			isMiddlewareEnabled := suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, path.EndpointA.ConnectionID)
+			suite.Require().True(isMiddlewareEnabled)
+		})
+	}
+
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccountWithOrdering(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress, channeltypes.NONE)
+	suite.Require().Error(err)
+}
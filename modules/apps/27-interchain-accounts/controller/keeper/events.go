@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+// EmitChannelOpenEvent emits an event signalling that an interchain account channel has been opened for an owner and connection,
+// including the previously active channel if the interchain account channel has been reopened.
+func EmitChannelOpenEvent(ctx sdk.Context, connectionID, portID, channelID, previousChannelID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelOpen,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyPreviousChannelID, previousChannelID),
+		),
+	)
+}
+
+// EmitChannelClosedEvent emits an event signalling that an interchain account channel has been closed, including the
+// sequence of the timed out packet if the channel was closed by a timeout.
+func EmitChannelClosedEvent(ctx sdk.Context, connectionID, portID, channelID string, timeoutSequence uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelClosed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyTimeoutSequence, fmt.Sprintf("%d", timeoutSequence)),
+		),
+	)
+}
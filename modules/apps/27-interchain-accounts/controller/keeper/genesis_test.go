@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -11,15 +12,16 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest()
 
 	interchainAccAddr := icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
-	genesisState := icatypes.ControllerGenesisState{
-		ActiveChannels: []icatypes.ActiveChannel{
+	genesisState := genesistypes.ControllerGenesisState{
+		ActiveChannels: []genesistypes.ActiveChannel{
 			{
-				ConnectionId: ibctesting.FirstConnectionID,
-				PortId:       TestPortID,
-				ChannelId:    ibctesting.FirstChannelID,
+				ConnectionId:        ibctesting.FirstConnectionID,
+				PortId:              TestPortID,
+				ChannelId:           ibctesting.FirstChannelID,
+				IsMiddlewareEnabled: true,
 			},
 		},
-		InterchainAccounts: []icatypes.RegisteredInterchainAccount{
+		InterchainAccounts: []genesistypes.RegisteredInterchainAccount{
 			{
 				ConnectionId:   ibctesting.FirstConnectionID,
 				PortId:         TestPortID,
@@ -27,6 +29,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: []string{TestPortID},
+		ChannelHistory: []types.ChannelRecord{
+			types.NewChannelRecord(ibctesting.FirstConnectionID, TestPortID, ibctesting.FirstChannelID, 10),
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper, genesisState)
@@ -39,6 +44,12 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
+	isMiddlewareEnabled := suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+	suite.Require().True(isMiddlewareEnabled)
+
+	history := suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelHistory(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().Equal(genesisState.ChannelHistory, history)
+
 	expParams := types.NewParams(false)
 	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
@@ -60,12 +71,16 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
 	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, genesisState.ActiveChannels[0].PortId)
+	suite.Require().True(genesisState.ActiveChannels[0].IsMiddlewareEnabled)
 
 	suite.Require().Equal(interchainAccAddr, genesisState.InterchainAccounts[0].AccountAddress)
 	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, genesisState.InterchainAccounts[0].PortId)
 
 	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
 
+	suite.Require().Len(genesisState.ChannelHistory, 1)
+	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ChannelHistory[0].ChannelId)
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
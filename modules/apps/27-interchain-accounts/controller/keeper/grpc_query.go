@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -35,6 +36,53 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 	}, nil
 }
 
+// ChannelHistory implements the Query/ChannelHistory gRPC method
+func (k Keeper) ChannelHistory(goCtx context.Context, req *types.QueryChannelHistoryRequest) (*types.QueryChannelHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryChannelHistoryResponse{
+		Channels: k.GetChannelHistory(ctx, req.ConnectionId, portID),
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (k Keeper) InterchainAccounts(goCtx context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the active channels of interchain accounts are bound to the controller port identifier
+	interchainAccounts, pageRes, err := icatypes.PaginateInterchainAccounts(
+		ctx, ctx.KVStore(k.storeKey), k.channelKeeper, func(portID string) string { return portID },
+		req.OwnerPrefix, req.ConnectionId, req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
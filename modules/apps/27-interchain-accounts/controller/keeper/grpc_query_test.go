@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -75,9 +78,192 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryChannelHistory() {
+	var req *types.QueryChannelHistoryRequest
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expChannels int
+		expPass     bool
+	}{
+		{
+			"success",
+			func() {},
+			1,
+			true,
+		},
+		{
+			"success: no channels opened on the connection",
+			func() {
+				req.ConnectionId = "connection-100"
+			},
+			0,
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			0,
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				req.Owner = ""
+			},
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, ibctesting.TestAccAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryChannelHistoryRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				Owner:        ibctesting.TestAccAddress,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ChannelHistory(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.Channels, tc.expChannels)
+
+				if tc.expChannels > 0 {
+					suite.Require().Equal(path.EndpointA.ChannelID, res.Channels[0].ChannelId)
+					suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, res.Channels[0].PortId)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req         *types.QueryInterchainAccountsRequest
+		expAccounts int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expAccounts = 2
+			},
+			true,
+		},
+		{
+			"success: filter by connection",
+			func() {
+				req.ConnectionId = ibctesting.FirstConnectionID
+				expAccounts = 1
+			},
+			true,
+		},
+		{
+			"success: filter by owner prefix",
+			func() {
+				req.OwnerPrefix = TestOwnerAddress[:10]
+				expAccounts = 1
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expAccounts = 1
+			},
+			true,
+		},
+		{
+			"success: no matching accounts",
+			func() {
+				req.ConnectionId = "connection-100"
+				expAccounts = 0
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid|connection"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// an interchain account without an active channel registered for another owner on another connection
+			otherPortID, err := icatypes.NewControllerPortID("other-owner")
+			suite.Require().NoError(err)
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-1", otherPortID, suite.chainB.SenderAccount.GetAddress().String())
+
+			req = &types.QueryInterchainAccountsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.InterchainAccounts, expAccounts)
+
+				for _, account := range res.InterchainAccounts {
+					if account.PortId == TestPortID {
+						suite.Require().Equal(TestOwnerAddress, account.Owner)
+						suite.Require().Equal(path.EndpointA.ChannelID, account.ActiveChannelId)
+						suite.Require().Equal(channeltypes.OPEN, account.ActiveChannelState)
+					} else {
+						suite.Require().Equal("other-owner", account.Owner)
+						suite.Require().Empty(account.ActiveChannelId)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -2,18 +2,20 @@ package keeper
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
-// The channel order must be ORDERED, the counterparty port identifier
+// The channel order must be ORDERED or UNORDERED, the counterparty port identifier
 // must be the host chain representation as defined in the types package,
 // the channel version must be equal to the version in the types package,
 // there must not be an active channel for the specfied port identifier,
@@ -29,8 +31,8 @@ func (k Keeper) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) error {
-	if order != channeltypes.ORDERED {
-		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if err := icatypes.ValidateChannelOrdering(order); err != nil {
+		return err
 	}
 
 	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
@@ -61,6 +63,10 @@ func (k Keeper) OnChanOpenInit(
 			return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
 		}
 
+		if channel.Ordering != order {
+			return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "previous active channel ordering does not match provided ordering: expected %s, got %s", channel.Ordering, order)
+		}
+
 		if !icatypes.IsPreviousMetadataEqual(channel.Version, metadata) {
 			return sdkerrors.Wrap(icatypes.ErrInvalidVersion, "previous active channel metadata does not match provided version")
 		}
@@ -70,7 +76,8 @@ func (k Keeper) OnChanOpenInit(
 }
 
 // OnChanOpenAck sets the active channel for the interchain account/owner pair
-// and stores the associated interchain account address in state keyed by it's corresponding port identifier
+// and stores the associated interchain account address in state keyed by it's corresponding port identifier.
+// The channel is added to the channel history of the interchain account/owner pair.
 func (k Keeper) OnChanOpenAck(
 	ctx sdk.Context,
 	portID,
@@ -103,21 +110,128 @@ func (k Keeper) OnChanOpenAck(
 		return err
 	}
 
+	// the version proposed by the controller is retrieved from the ics4Wrapper as middleware may wrap the channel version
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	var proposedMetadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &proposedMetadata); err != nil {
+		return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	if metadata.Encoding != proposedMetadata.Encoding {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "expected encoding format %s, got %s", proposedMetadata.Encoding, metadata.Encoding)
+	}
+
+	if metadata.TxType != proposedMetadata.TxType {
+		return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "expected transaction type %s, got %s", proposedMetadata.TxType, metadata.TxType)
+	}
+
 	if strings.TrimSpace(metadata.Address) == "" {
 		return sdkerrors.Wrap(icatypes.ErrInvalidAccountAddress, "interchain account address cannot be empty")
 	}
 
+	previousChannelID, _ := k.GetActiveChannelID(ctx, metadata.ControllerConnectionId, portID)
+
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
+	k.SetChannelRecord(ctx, types.NewChannelRecord(metadata.ControllerConnectionId, portID, channelID, ctx.BlockHeight()))
+
+	EmitChannelOpenEvent(ctx, metadata.ControllerConnectionId, portID, channelID, previousChannelID)
+
+	return nil
+}
+
+// OnChanUpgradeInit performs validation of an interchain accounts channel upgrade proposed by governance.
+// The channel order may not change, the connection hops may not change and the interchain account
+// address included in the metadata must match the address registered for the channel.
+func (k Keeper) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	if order != channel.Ordering {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "interchain accounts channel ordering cannot be upgraded: expected %s, got %s", channel.Ordering, order)
+	}
+
+	if err := k.validateUpgradeMetadata(ctx, portID, channelID, connectionHops, version); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// OnChanUpgradeAck performs validation of the interchain accounts channel upgrade version selected by the counterparty.
+func (k Keeper) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	return k.validateUpgradeMetadata(ctx, portID, channelID, channel.ConnectionHops, counterpartyVersion)
+}
+
+// validateUpgradeMetadata ensures the upgraded metadata is valid and that the connection hops and
+// interchain account address of the existing channel remain unchanged.
+func (k Keeper) validateUpgradeMetadata(ctx sdk.Context, portID, channelID string, connectionHops []string, version string) error {
+	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.PortPrefix, portID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	if !reflect.DeepEqual(channel.ConnectionHops, connectionHops) {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidUpgrade, "interchain accounts channel connection hops cannot be upgraded: expected %s, got %s", channel.ConnectionHops, connectionHops)
+	}
+
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+		return err
+	}
+
+	interchainAccAddr, found := k.GetInterchainAccountAddress(ctx, connectionHops[0], portID)
+	if !found || interchainAccAddr != metadata.Address {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "interchain account address cannot be upgraded: expected %s, got %s", interchainAccAddr, metadata.Address)
+	}
 
 	return nil
 }
 
-// OnChanCloseConfirm removes the active channel stored in state
+// OnChanCloseConfirm marks the channel as closed in the channel history. The active channel is kept in state
+// so that the interchain account channel may be reopened with the same version.
 func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
+	connectionID, err := k.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	k.setChannelClosed(ctx, connectionID, portID, channelID, 0)
+
 	return nil
 }
@@ -87,10 +87,36 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success: UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
 			},
+			true,
+		},
+		{
+			"invalid order - unspecified",
+			func() {
+				channel.Ordering = channeltypes.NONE
+			},
+			false,
+		},
+		{
+			"invalid order - previous active channel ordering is different",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+				counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				closedChannel := channeltypes.Channel{
+					State:          channeltypes.CLOSED,
+					Ordering:       channeltypes.ORDERED,
+					Counterparty:   counterparty,
+					ConnectionHops: []string{path.EndpointA.ConnectionID},
+					Version:        TestVersion,
+				}
+				path.EndpointA.SetChannel(closedChannel)
+
+				channel.Ordering = channeltypes.UNORDERED
+			},
 			false,
 		},
 		{
@@ -316,6 +342,18 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 			},
 			false,
 		},
+		{
+			"encoding format does not match the proposed encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.Counterparty.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"invalid account address",
 			func() {
@@ -407,6 +445,11 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 				suite.Require().True(found)
 
 				suite.Require().Equal(metadata.Address, interchainAccAddress)
+
+				record, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelRecord(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.chainA.GetContext().BlockHeight(), record.OpenHeight)
+				suite.Require().Zero(record.CloseHeight)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -425,6 +468,11 @@ func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"channel not found", func() {
+				path.EndpointA.ChannelID = "channel-100"
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -439,15 +487,21 @@ func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
 
 			tc.malleate() // malleate mutates test data
 
-			err = suite.chainB.GetSimApp().ICAControllerKeeper.OnChanCloseConfirm(suite.chainB.GetContext(),
-				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
-
-			activeChannelID, found := suite.chainB.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointB.ChannelConfig.PortID)
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnChanCloseConfirm(suite.chainA.GetContext(),
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().False(found)
-				suite.Require().Empty(activeChannelID)
+
+				// the active channel is kept so that the interchain account channel may be reopened
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+
+				record, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelRecord(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.chainA.GetContext().BlockHeight(), record.CloseHeight)
+				suite.Require().Zero(record.TimeoutSequence)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1,18 +1,22 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
 
 	baseapp "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -101,6 +105,16 @@ func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
 
+// GetConnectionID returns the connection id for the given port and channelIDs.
+func (k Keeper) GetConnectionID(ctx sdk.Context, portID, channelID string) (string, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port-id: %s, channel-id: %s", portID, channelID)
+	}
+
+	return channel.ConnectionHops[0], nil
+}
+
 // GetActiveChannelID retrieves the active channelID from the store, keyed by the provided connectionID and portID
 func (k Keeper) GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -130,19 +144,22 @@ func (k Keeper) GetOpenActiveChannel(ctx sdk.Context, connectionID, portID strin
 }
 
 // GetAllActiveChannels returns a list of all active interchain accounts controller channels and their associated connection and port identifiers
-func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []icatypes.ActiveChannel {
+func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []genesistypes.ActiveChannel {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.ActiveChannelKeyPrefix))
 	defer iterator.Close()
 
-	var activeChannels []icatypes.ActiveChannel
+	var activeChannels []genesistypes.ActiveChannel
 	for ; iterator.Valid(); iterator.Next() {
 		keySplit := strings.Split(string(iterator.Key()), "/")
 
-		ch := icatypes.ActiveChannel{
-			ConnectionId: keySplit[2],
-			PortId:       keySplit[1],
-			ChannelId:    string(iterator.Value()),
+		portID := keySplit[1]
+		connectionID := keySplit[2]
+		ch := genesistypes.ActiveChannel{
+			ConnectionId:        connectionID,
+			PortId:              portID,
+			ChannelId:           string(iterator.Value()),
+			IsMiddlewareEnabled: k.IsMiddlewareEnabled(ctx, portID, connectionID),
 		}
 
 		activeChannels = append(activeChannels, ch)
@@ -176,15 +193,15 @@ func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 }
 
 // GetAllInterchainAccounts returns a list of all registered interchain account addresses and their associated connection and controller port identifiers
-func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []icatypes.RegisteredInterchainAccount {
+func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.RegisteredInterchainAccount {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.OwnerKeyPrefix))
 
-	var interchainAccounts []icatypes.RegisteredInterchainAccount
+	var interchainAccounts []genesistypes.RegisteredInterchainAccount
 	for ; iterator.Valid(); iterator.Next() {
 		keySplit := strings.Split(string(iterator.Key()), "/")
 
-		acc := icatypes.RegisteredInterchainAccount{
+		acc := genesistypes.RegisteredInterchainAccount{
 			ConnectionId:   keySplit[2],
 			PortId:         keySplit[1],
 			AccountAddress: string(iterator.Value()),
@@ -201,3 +218,99 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
+
+// GetChannelRecord retrieves the channel history record of the provided channelID, keyed by the provided connectionID and portID
+func (k Keeper) GetChannelRecord(ctx sdk.Context, connectionID, portID, channelID string) (types.ChannelRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyChannelRecord(portID, connectionID, channelID))
+	if bz == nil {
+		return types.ChannelRecord{}, false
+	}
+
+	var record types.ChannelRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetChannelRecord stores the provided channel history record, keyed by its connectionID, portID and channelID
+func (k Keeper) SetChannelRecord(ctx sdk.Context, record types.ChannelRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(icatypes.KeyChannelRecord(record.PortId, record.ConnectionId, record.ChannelId), bz)
+}
+
+// GetChannelHistory returns the channel history records for the provided connectionID and portID, ordered by the height at which the channels were opened
+func (k Keeper) GetChannelHistory(ctx sdk.Context, connectionID, portID string) []types.ChannelRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, icatypes.KeyChannelHistory(portID, connectionID))
+	defer iterator.Close()
+
+	records := k.collectChannelRecords(iterator)
+
+	// records are stored by channel identifier, which does not follow the order in which channels were opened
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].OpenHeight < records[j].OpenHeight
+	})
+
+	return records
+}
+
+// GetAllChannelRecords returns all channel history records of the interchain accounts controller. Used in ExportGenesis
+func (k Keeper) GetAllChannelRecords(ctx sdk.Context) []types.ChannelRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.ChannelHistoryKeyPrefix))
+	defer iterator.Close()
+
+	return k.collectChannelRecords(iterator)
+}
+
+func (k Keeper) collectChannelRecords(iterator sdk.Iterator) []types.ChannelRecord {
+	var records []types.ChannelRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ChannelRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// setChannelClosed marks the channel history record of the provided channel as closed at the current block height.
+// A record is created for channels opened before channel history was recorded. Records which are already closed are left unchanged.
+func (k Keeper) setChannelClosed(ctx sdk.Context, connectionID, portID, channelID string, timeoutSequence uint64) {
+	record, found := k.GetChannelRecord(ctx, connectionID, portID, channelID)
+	if found && record.CloseHeight != 0 {
+		return
+	}
+
+	if !found {
+		record = types.NewChannelRecord(connectionID, portID, channelID, 0)
+	}
+
+	record.CloseHeight = ctx.BlockHeight()
+	record.TimeoutSequence = timeoutSequence
+	k.SetChannelRecord(ctx, record)
+
+	EmitChannelClosedEvent(ctx, connectionID, portID, channelID, timeoutSequence)
+}
+
+// SetMiddlewareEnabled stores a flag to indicate that the underlying application callbacks should be called for the given port and connection
+func (k Keeper) SetMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyIsMiddlewareEnabled(portID, connectionID), icatypes.MiddlewareEnabled)
+}
+
+// SetMiddlewareDisabled stores a flag to indicate that the underlying application callbacks should not be called for the given port and connection
+func (k Keeper) SetMiddlewareDisabled(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyIsMiddlewareEnabled(portID, connectionID), icatypes.MiddlewareDisabled)
+}
+
+// IsMiddlewareEnabled returns true if the underlying application callbacks are enabled for the given port and connection identifier pair.
+// Ports registered before the flag was introduced have no flag set and are treated as enabled.
+func (k Keeper) IsMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return !bytes.Equal(icatypes.MiddlewareDisabled, store.Get(icatypes.KeyIsMiddlewareEnabled(portID, connectionID)))
+}
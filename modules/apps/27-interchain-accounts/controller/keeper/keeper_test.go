@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	genesistypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
@@ -171,16 +172,18 @@ func (suite *KeeperTestSuite) TestGetAllActiveChannels() {
 
 	suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedChannelID)
 
-	expectedChannels := []icatypes.ActiveChannel{
+	expectedChannels := []genesistypes.ActiveChannel{
 		{
-			ConnectionId: ibctesting.FirstConnectionID,
-			PortId:       TestPortID,
-			ChannelId:    path.EndpointA.ChannelID,
+			ConnectionId:        ibctesting.FirstConnectionID,
+			PortId:              TestPortID,
+			ChannelId:           path.EndpointA.ChannelID,
+			IsMiddlewareEnabled: true,
 		},
 		{
-			ConnectionId: ibctesting.FirstConnectionID,
-			PortId:       expectedPortID,
-			ChannelId:    expectedChannelID,
+			ConnectionId:        ibctesting.FirstConnectionID,
+			PortId:              expectedPortID,
+			ChannelId:           expectedChannelID,
+			IsMiddlewareEnabled: true,
 		},
 	}
 
@@ -208,7 +211,7 @@ func (suite *KeeperTestSuite) TestGetAllInterchainAccounts() {
 
 	suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedAccAddr)
 
-	expectedAccounts := []icatypes.RegisteredInterchainAccount{
+	expectedAccounts := []genesistypes.RegisteredInterchainAccount{
 		{
 			ConnectionId:   ibctesting.FirstConnectionID,
 			PortId:         TestPortID,
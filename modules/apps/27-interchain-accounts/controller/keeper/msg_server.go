@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ICS27 controller MsgServer interface for the provided Keeper
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
+// The owner is the signer of the message, the underlying application callbacks are disabled
+// for the generated port and connection as no authentication module is involved.
+func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SetMiddlewareDisabled(ctx, portID, msg.ConnectionId)
+
+	// ORDERED channels are used unless the ordering is specified
+	ordering := msg.Ordering
+	if ordering == channeltypes.NONE {
+		ordering = channeltypes.ORDERED
+	}
+
+	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version, ordering)
+	if err != nil {
+		s.Logger(ctx).Error("error registering interchain account", "error", err.Error())
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully registered interchain account", "channel-id", channelID)
+
+	return &types.MsgRegisterInterchainAccountResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// ReopenInterchainAccountChannel defines a rpc handler for MsgReopenInterchainAccountChannel.
+// The owner is the signer of the message, a new channel is opened for the interchain account using the version of
+// the closed active channel.
+func (s msgServer) ReopenInterchainAccountChannel(goCtx context.Context, msg *types.MsgReopenInterchainAccountChannel) (*types.MsgReopenInterchainAccountChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := s.Keeper.ReopenInterchainAccountChannel(ctx, msg.ConnectionId, portID)
+	if err != nil {
+		s.Logger(ctx).Error("error reopening interchain account channel", "error", err.Error())
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully reopened interchain account channel", "channel-id", channelID)
+
+	return &types.MsgReopenInterchainAccountChannelResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// SendTx defines a rpc handler for MsgSendTx.
+// The packet is sent on the active channel of the owner using the channel capability claimed by the controller.
+func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, found := s.GetActiveChannelID(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", msg.ConnectionId, portID)
+	}
+
+	chanCap, found := s.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
+	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
+	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+	seq, err := s.Keeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTxResponse{Sequence: seq}, nil
+}
@@ -0,0 +1,528 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
+	var (
+		msg  *types.MsgRegisterInterchainAccount
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with explicit channel version",
+			func() {
+				msg.Version = TestVersion
+			},
+			true,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = "connection-100"
+			},
+			false,
+		},
+		{
+			"invalid channel version",
+			func() {
+				msg.Version = "invalid-version"
+			},
+			false,
+		},
+		{
+			"success: UNORDERED channel",
+			func() {
+				msg.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"success: unspecified ordering defaults to ORDERED",
+			func() {
+				msg.Ordering = channeltypes.NONE
+			},
+			true,
+		},
+		{
+			"port is already bound for owner but capability is claimed by another module",
+			func() {
+				cap := suite.chainA.GetSimApp().IBCKeeper.PortKeeper.BindPort(suite.chainA.GetContext(), TestPortID)
+				err := suite.chainA.GetSimApp().TransferKeeper.ClaimCapability(suite.chainA.GetContext(), cap, host.PortPath(TestPortID))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			msg = types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, "", channeltypes.ORDERED)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(ibctesting.FirstChannelID, res.ChannelId)
+
+				isMiddlewareEnabled := suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(ctx, TestPortID, path.EndpointA.ConnectionID)
+				suite.Require().False(isMiddlewareEnabled)
+
+				// the controller claims the channel capability in place of an authentication module
+				_, found := suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(ctx, host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().True(found)
+
+				_, found = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(ctx, host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().False(found)
+
+				// ORDERED channels are used unless the ordering is specified
+				expOrdering := msg.Ordering
+				if expOrdering == channeltypes.NONE {
+					expOrdering = channeltypes.ORDERED
+				}
+
+				channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(ctx, TestPortID, res.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(expOrdering, channel.Ordering)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTx_MsgServer() {
+	var (
+		msg   *types.MsgSendTx
+		path  *ibctesting.Path
+		owner string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"no active channel for the connection",
+			func() {
+				msg.ConnectionId = "connection-100"
+			},
+			false,
+		},
+		{
+			"channel capability is owned by the authentication module",
+			func() {
+				// register the interchain account through the keeper such that the mock authentication module claims the channel capability
+				ownerPath := NewICAPath(suite.chainA, suite.chainB)
+				ownerPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+				ownerPath.EndpointA.ClientID = path.EndpointA.ClientID
+				ownerPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+				ownerPath.EndpointB.ClientID = path.EndpointB.ClientID
+
+				err := SetupICAPath(ownerPath, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				msg.Owner = TestOwnerAddress
+			},
+			false,
+		},
+		{
+			"invalid packet data",
+			func() {
+				msg.PacketData = icatypes.InterchainAccountPacketData{Type: icatypes.UNSPECIFIED}
+			},
+			false,
+		},
+		{
+			"channel is closed",
+			func() {
+				path.EndpointA.SetChannelClosed()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			// the owner must sign the MsgRegisterInterchainAccount
+			owner = suite.chainA.SenderAccount.GetAddress().String()
+			err := setupICAPathWithMsgServer(path, owner, channeltypes.ORDERED)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			bankMsg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{bankMsg})
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msg = types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), packetData)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Sequence)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReopenInterchainAccountChannel_MsgServer() {
+	var (
+		msg  *types.MsgReopenInterchainAccountChannel
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"active channel is OPEN",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				path.EndpointA.SetChannel(channel)
+			},
+			false,
+		},
+		{
+			"no active channel for the connection",
+			func() {
+				msg.ConnectionId = "connection-100"
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			owner := suite.chainA.SenderAccount.GetAddress().String()
+			err := setupICAPathWithMsgServer(path, owner, channeltypes.ORDERED)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgReopenInterchainAccountChannel(path.EndpointA.ConnectionID, owner)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ReopenInterchainAccountChannel(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal("channel-1", res.ChannelId)
+
+				// the new channel is initialized with the version of the closed channel
+				channel, found := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.GetChannel(ctx, path.EndpointA.ChannelConfig.PortID, res.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+				suite.Require().Equal(path.EndpointA.GetChannel().Version, channel.Version)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReopenInterchainAccountChannelAfterTimeout() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	err := setupICAPathWithMsgServer(path, owner, channeltypes.ORDERED)
+	suite.Require().NoError(err)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	interchainAccountAddr, found := controllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	// send a packet which times out on the host chain
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	res, err := suite.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()))
+	nextSeqRecv, found := suite.chainB.GetSimApp().GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+
+	res, err = suite.chainA.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	var closedEventFound bool
+	for _, event := range res.GetEvents() {
+		if event.Type == types.EventTypeChannelClosed {
+			closedEventFound = true
+		}
+	}
+	suite.Require().True(closedEventFound)
+
+	// the closure of the controller channel is relayed to the host chain
+	err = path.EndpointB.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	// reopen the interchain account channel using the version of the closed channel
+	closedChannelID := path.EndpointA.ChannelID
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+
+	res, err = suite.chainA.SendMsgs(types.NewMsgReopenInterchainAccountChannel(path.EndpointA.ConnectionID, owner))
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	path.EndpointB.ChannelID = ""
+	err = path.EndpointB.ChanOpenTry()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.ChanOpenAck()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanOpenConfirm()
+	suite.Require().NoError(err)
+
+	activeChannelID, found := controllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+
+	// the existing interchain account is reused
+	addr, found := controllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(interchainAccountAddr, addr)
+
+	history := controllerKeeper.GetChannelHistory(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().Len(history, 2)
+	suite.Require().Equal(closedChannelID, history[0].ChannelId)
+	suite.Require().NotZero(history[0].CloseHeight)
+	suite.Require().Equal(packet.GetSequence(), history[0].TimeoutSequence)
+	suite.Require().Equal(path.EndpointA.ChannelID, history[1].ChannelId)
+	suite.Require().Zero(history[1].CloseHeight)
+}
+
+func (suite *KeeperTestSuite) TestUnorderedChannelRemainsOpenAfterTimeout() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	suite.coordinator.SetupConnections(path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	err := setupICAPathWithMsgServer(path, owner, channeltypes.UNORDERED)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(channeltypes.UNORDERED, path.EndpointA.GetChannel().Ordering)
+	suite.Require().Equal(channeltypes.UNORDERED, path.EndpointB.GetChannel().Ordering)
+
+	// the host registers the interchain account as for ORDERED channels
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	interchainAccountAddr, found := controllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	hostAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(hostAccountAddr, interchainAccountAddr)
+
+	activeChannelID, found := suite.chainB.GetSimApp().ICAHostKeeper.GetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointB.ChannelID, activeChannelID)
+
+	// send a packet which times out on the host chain
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	res, err := suite.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	res, err = suite.chainA.SendMsgs(channeltypes.NewMsgTimeout(packet, packet.GetSequence(), proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	for _, event := range res.GetEvents() {
+		suite.Require().NotEqual(types.EventTypeChannelClosed, event.Type)
+	}
+
+	// the channel remains open and active
+	suite.Require().Equal(channeltypes.OPEN, path.EndpointA.GetChannel().State)
+
+	activeChannelID, found = controllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+
+	history := controllerKeeper.GetChannelHistory(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().Len(history, 1)
+	suite.Require().Zero(history[0].CloseHeight)
+
+	// packets may still be sent and received on the channel
+	res, err = suite.chainA.SendMsgs(types.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), packetData))
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+}
+
+// setupICAPathWithMsgServer registers an interchain account through the controller msg server and completes the channel handshake.
+// The owner must be the sender account of the controller chain.
+func setupICAPathWithMsgServer(path *ibctesting.Path, owner string, ordering channeltypes.Order) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, "", ordering)
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	channelID, err := ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	if err != nil {
+		return err
+	}
+
+	path.EndpointA.ChannelID = channelID
+	path.EndpointA.ChannelConfig.PortID = portID
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointB.ChanOpenConfirm(); err != nil {
+		return err
+	}
+
+	if channel := path.EndpointA.GetChannel(); channel.State != channeltypes.OPEN {
+		return channeltypes.ErrInvalidChannelState
+	}
+
+	return nil
+}
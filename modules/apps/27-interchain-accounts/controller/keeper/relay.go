@@ -74,8 +74,24 @@ func (k Keeper) createOutgoingPacket(
 	return packet.Sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// OnTimeoutPacket marks the channel associated with the provided packet as closed in the channel history, the underlying
+// channel end is closed due to the semantics of ORDERED channels. The interchain account channel may then be reopened
+// using MsgReopenInterchainAccountChannel. UNORDERED channels remain open and active on timeout.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port-id: %s, channel-id: %s", packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
+	if channel.Ordering == channeltypes.ORDERED {
+		k.setChannelClosed(ctx, channel.ConnectionHops[0], packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+
 	return nil
 }
+
+// GetExecutionResult decodes the acknowledgement of the provided interchain accounts packet into an execution
+// result, resolving the message responses using the codec of the controller chain.
+func (k Keeper) GetExecutionResult(packet channeltypes.Packet, acknowledgement []byte) (icatypes.ExecutionResult, error) {
+	return icatypes.DecodeExecutionResult(k.cdc, packet.GetData(), acknowledgement)
+}
@@ -195,6 +195,13 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			func() {},
 			true,
 		},
+		{
+			"channel not found",
+			func() {
+				path.EndpointA.ChannelID = "channel-100"
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -224,6 +231,11 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				record, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelRecord(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.chainA.GetContext().BlockHeight(), record.CloseHeight)
+				suite.Require().Equal(packet.GetSequence(), record.TimeoutSequence)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// ExecutionResultHandler defines an optional interface which may be implemented by the underlying application
+// of the controller submodule. If implemented, OnExecutionResult is called with the decoded execution result of
+// an acknowledged interchain accounts packet in place of the OnAcknowledgementPacket callback.
+type ExecutionResultHandler interface {
+	OnExecutionResult(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		result icatypes.ExecutionResult,
+		relayer sdk.AccAddress,
+	) error
+}
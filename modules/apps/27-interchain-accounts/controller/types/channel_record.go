@@ -0,0 +1,11 @@
+package types
+
+// NewChannelRecord creates and returns a new ChannelRecord for a channel opened at the provided height
+func NewChannelRecord(connectionID, portID, channelID string, openHeight int64) ChannelRecord {
+	return ChannelRecord{
+		ConnectionId: connectionID,
+		PortId:       portID,
+		ChannelId:    channelID,
+		OpenHeight:   openHeight,
+	}
+}
@@ -70,8 +70,98 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+// ChannelRecord records a channel opened for an interchain account owner on a connection.
+// A new record is added each time the interchain account channel is reopened.
+type ChannelRecord struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// block height at which the channel was opened
+	OpenHeight int64 `protobuf:"varint,4,opt,name=open_height,json=openHeight,proto3" json:"open_height,omitempty" yaml:"open_height"`
+	// block height at which the channel was closed, zero if the channel has not been closed
+	CloseHeight int64 `protobuf:"varint,5,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty" yaml:"close_height"`
+	// sequence of the timed out packet which closed the channel, zero if the channel was not closed by a timeout
+	TimeoutSequence uint64 `protobuf:"varint,6,opt,name=timeout_sequence,json=timeoutSequence,proto3" json:"timeout_sequence,omitempty" yaml:"timeout_sequence"`
+}
+
+func (m *ChannelRecord) Reset()         { *m = ChannelRecord{} }
+func (m *ChannelRecord) String() string { return proto.CompactTextString(m) }
+func (*ChannelRecord) ProtoMessage()    {}
+func (*ChannelRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *ChannelRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRecord.Merge(m, src)
+}
+func (m *ChannelRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRecord proto.InternalMessageInfo
+
+func (m *ChannelRecord) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ChannelRecord) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelRecord) GetOpenHeight() int64 {
+	if m != nil {
+		return m.OpenHeight
+	}
+	return 0
+}
+
+func (m *ChannelRecord) GetCloseHeight() int64 {
+	if m != nil {
+		return m.CloseHeight
+	}
+	return 0
+}
+
+func (m *ChannelRecord) GetTimeoutSequence() uint64 {
+	if m != nil {
+		return m.TimeoutSequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*ChannelRecord)(nil), "ibc.applications.interchain_accounts.controller.v1.ChannelRecord")
 }
 
 func init() {
@@ -79,23 +169,35 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0xce, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe2,
-	0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x19, 0x65, 0x26, 0x25, 0xeb, 0x21, 0x1b, 0xa2, 0x87,
-	0xc5, 0x10, 0x3d, 0x24, 0x6d, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xed, 0xfa,
-	0x20, 0x16, 0xc4, 0x24, 0xa5, 0x30, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x1f,
-	0x2e, 0x21, 0x84, 0x86, 0xf8, 0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x0e, 0x27, 0xd9, 0x4f, 0xf7, 0xe4, 0x25, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0xd5,
-	0x28, 0x05, 0x09, 0x22, 0x04, 0x5d, 0x21, 0x62, 0x4e, 0x59, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x15, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x9f, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66,
-	0xac, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0x0a, 0xa0, 0x62, 0x7d, 0x23, 0x73, 0x5d,
-	0x84, 0xb7, 0x74, 0xb1, 0x85, 0x4d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x2b, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x79, 0xfc, 0x19, 0x5b, 0x01, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0x6d, 0x5d, 0xed, 0xb4, 0x55, 0x3b, 0x56, 0xbb, 0x2a, 0x26, 0xcb, 0x9c, 0x16,
+	0x64, 0x77, 0x68, 0x2b, 0x14, 0x0a, 0x5e, 0x52, 0x14, 0x17, 0x3c, 0x94, 0x08, 0x1e, 0xbc, 0x84,
+	0xc9, 0x64, 0x48, 0x46, 0x92, 0x79, 0x63, 0x66, 0xb2, 0xd0, 0x6f, 0xe1, 0xc7, 0xf2, 0xd8, 0xa3,
+	0xa7, 0x20, 0xbb, 0x77, 0x0f, 0xf9, 0x04, 0x92, 0x3f, 0x36, 0x41, 0x7b, 0x9b, 0xe7, 0x7d, 0xde,
+	0xdf, 0xf3, 0xce, 0xe1, 0x41, 0x97, 0x32, 0xe0, 0x94, 0x65, 0x59, 0x22, 0x39, 0x33, 0x12, 0x94,
+	0xa6, 0x52, 0x19, 0x91, 0xf3, 0x98, 0x49, 0xe5, 0x33, 0xce, 0xa1, 0x50, 0x46, 0x53, 0x0e, 0xca,
+	0xe4, 0x90, 0x24, 0x22, 0xa7, 0xab, 0x93, 0x81, 0x5a, 0x64, 0x39, 0x18, 0xc0, 0xa7, 0x32, 0xe0,
+	0x8b, 0x61, 0xc8, 0xe2, 0x8e, 0x90, 0xc5, 0x00, 0x5b, 0x9d, 0xbc, 0x38, 0x8a, 0x20, 0x82, 0x06,
+	0xa7, 0xf5, 0xab, 0x4d, 0x22, 0x9f, 0xd1, 0xf8, 0x8a, 0xe5, 0x2c, 0xd5, 0xf8, 0x23, 0xc2, 0x3d,
+	0xe0, 0x0b, 0xc5, 0x82, 0x44, 0x84, 0x13, 0x6b, 0x6a, 0xcd, 0x1e, 0xb8, 0xaf, 0xaa, 0xd2, 0x79,
+	0x7e, 0xcd, 0xd2, 0xe4, 0x82, 0xfc, 0xbf, 0x43, 0xbc, 0xc3, 0x7e, 0xf8, 0xae, 0x9b, 0xfd, 0xde,
+	0x42, 0x07, 0x97, 0x31, 0x53, 0x4a, 0x24, 0x9e, 0xe0, 0x90, 0x87, 0xf8, 0x2d, 0x3a, 0xe0, 0xa0,
+	0x94, 0xe0, 0xf5, 0x87, 0x7d, 0xd9, 0x46, 0xef, 0xba, 0x93, 0xaa, 0x74, 0x8e, 0x6e, 0xa3, 0x7b,
+	0x9b, 0x78, 0xfb, 0xbd, 0x5e, 0x86, 0xf8, 0x35, 0xba, 0x9f, 0x41, 0x6e, 0x6a, 0x70, 0xab, 0x01,
+	0x71, 0x55, 0x3a, 0x0f, 0x5b, 0xb0, 0x33, 0x88, 0x37, 0xae, 0x5f, 0xcb, 0x10, 0xbf, 0x41, 0x88,
+	0xb7, 0xc7, 0xeb, 0xfd, 0xed, 0x66, 0xff, 0x69, 0x55, 0x3a, 0x87, 0xdd, 0xa1, 0x5b, 0x8f, 0x78,
+	0xbb, 0x9d, 0x58, 0x86, 0xf8, 0x1c, 0xed, 0x41, 0x26, 0x94, 0x1f, 0x0b, 0x19, 0xc5, 0x66, 0xb2,
+	0x33, 0xb5, 0x66, 0xdb, 0xee, 0xb3, 0xaa, 0x74, 0x70, 0x8b, 0x0d, 0x4c, 0xe2, 0xa1, 0x5a, 0x7d,
+	0x68, 0x04, 0xbe, 0x40, 0xfb, 0x3c, 0x01, 0x2d, 0xfe, 0x92, 0xf7, 0x1a, 0xf2, 0xb8, 0x2a, 0x9d,
+	0x27, 0xdd, 0xc1, 0x81, 0x4b, 0xbc, 0xbd, 0x46, 0x76, 0xec, 0x7b, 0xf4, 0xd8, 0xc8, 0x54, 0x40,
+	0x61, 0x7c, 0x2d, 0xbe, 0x15, 0x42, 0x71, 0x31, 0x19, 0x4f, 0xad, 0xd9, 0x8e, 0xfb, 0xb2, 0x2a,
+	0x9d, 0xe3, 0x96, 0xff, 0x77, 0x83, 0x78, 0x8f, 0xba, 0xd1, 0xa7, 0x6e, 0xe2, 0x7e, 0xfd, 0xb1,
+	0xb6, 0xad, 0x9b, 0xb5, 0x6d, 0xfd, 0x5a, 0xdb, 0xd6, 0xf7, 0x8d, 0x3d, 0xba, 0xd9, 0xd8, 0xa3,
+	0x9f, 0x1b, 0x7b, 0xf4, 0xe5, 0x2a, 0x92, 0x26, 0x2e, 0x82, 0x05, 0x87, 0x94, 0x72, 0xd0, 0x29,
+	0x68, 0x2a, 0x03, 0x3e, 0x8f, 0x80, 0xae, 0xce, 0x68, 0x0a, 0x61, 0x91, 0x08, 0x5d, 0x37, 0x52,
+	0xd3, 0xd3, 0xf3, 0x79, 0xdf, 0xa3, 0xf9, 0x5d, 0x65, 0x34, 0xd7, 0x99, 0xd0, 0xc1, 0xb8, 0xe9,
+	0xce, 0xd9, 0x9f, 0x01, 0x00, 0xb5, 0x09, 0xbf, 0x28, 0xcc, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -131,6 +233,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutSequence != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.TimeoutSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CloseHeight != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.CloseHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OpenHeight != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.OpenHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintController(dAtA []byte, offset int, v uint64) int {
 	offset -= sovController(v)
 	base := offset
@@ -154,6 +315,36 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *ChannelRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.OpenHeight != 0 {
+		n += 1 + sovController(uint64(m.OpenHeight))
+	}
+	if m.CloseHeight != 0 {
+		n += 1 + sovController(uint64(m.CloseHeight))
+	}
+	if m.TimeoutSequence != 0 {
+		n += 1 + sovController(uint64(m.TimeoutSequence))
+	}
+	return n
+}
+
 func sovController(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -230,6 +421,209 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChannelRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenHeight", wireType)
+			}
+			m.OpenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseHeight", wireType)
+			}
+			m.CloseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSequence", wireType)
+			}
+			m.TimeoutSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipController(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
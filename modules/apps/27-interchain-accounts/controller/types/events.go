@@ -0,0 +1,13 @@
+package types
+
+// ICS27 Interchain Accounts controller events
+const (
+	EventTypeChannelOpen   = "ics27_channel_open"
+	EventTypeChannelClosed = "ics27_channel_closed"
+
+	AttributeKeyConnectionID      = "connection_id"
+	AttributeKeyPortID            = "port_id"
+	AttributeKeyChannelID         = "channel_id"
+	AttributeKeyPreviousChannelID = "previous_channel_id"
+	AttributeKeyTimeoutSequence   = "timeout_sequence"
+)
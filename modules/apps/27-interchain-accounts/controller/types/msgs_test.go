@@ -0,0 +1,236 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	// TestOwnerAddress defines a reusable bech32 address for testing purposes
+	TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+	validPacketData = icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+)
+
+func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterInterchainAccount
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with empty channel version",
+			func() {
+				msg.Version = ""
+			},
+			true,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-owner"
+			},
+			false,
+		},
+		{
+			"success: unspecified ordering",
+			func() {
+				msg.Ordering = channeltypes.NONE
+			},
+			true,
+		},
+		{
+			"success: unordered channel",
+			func() {
+				msg.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"invalid ordering",
+			func() {
+				msg.Ordering = channeltypes.Order(5)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, TestOwnerAddress, icatypes.Version, channeltypes.ORDERED)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterInterchainAccountGetSigners(t *testing.T) {
+	msg := types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, TestOwnerAddress, "", channeltypes.ORDERED)
+	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgReopenInterchainAccountChannelValidateBasic(t *testing.T) {
+	var msg *types.MsgReopenInterchainAccountChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-owner"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgReopenInterchainAccountChannel(ibctesting.FirstConnectionID, TestOwnerAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgReopenInterchainAccountChannelGetSigners(t *testing.T) {
+	msg := types.NewMsgReopenInterchainAccountChannel(ibctesting.FirstConnectionID, TestOwnerAddress)
+	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
+}
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	var msg *types.MsgSendTx
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid connection id",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				msg.Owner = "invalid-owner"
+			},
+			false,
+		},
+		{
+			"relative timeout is zero",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			false,
+		},
+		{
+			"invalid packet type",
+			func() {
+				msg.PacketData.Type = icatypes.UNSPECIFIED
+			},
+			false,
+		},
+		{
+			"empty packet data",
+			func() {
+				msg.PacketData.Data = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, 100000, validPacketData)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSendTxGetSigners(t *testing.T) {
+	msg := types.NewMsgSendTx(TestOwnerAddress, ibctesting.FirstConnectionID, 100000, validPacketData)
+	require.Equal(t, TestOwnerAddress, msg.GetSigners()[0].String())
+}
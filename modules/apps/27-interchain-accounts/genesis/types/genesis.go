@@ -1,11 +1,21 @@
 package types
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
+var (
+	_ codectypes.UnpackInterfacesMessage = GenesisState{}
+	_ codectypes.UnpackInterfacesMessage = HostGenesisState{}
+)
+
 // DefaultGenesis creates and returns the interchain accounts GenesisState
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -35,6 +45,11 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return gs.HostGenesisState.UnpackInterfaces(unpacker)
+}
+
 // DefaultControllerGenesis creates and returns the default interchain accounts ControllerGenesisState
 func DefaultControllerGenesis() ControllerGenesisState {
 	return ControllerGenesisState{
@@ -43,12 +58,13 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params, channelHistory []controllertypes.ChannelRecord) ControllerGenesisState {
 	return ControllerGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Ports:              ports,
 		Params:             controllerParams,
+		ChannelHistory:     channelHistory,
 	}
 }
 
@@ -69,7 +85,7 @@ func (gs ControllerGenesisState) Validate() error {
 			return err
 		}
 
-		if err := ValidateAccountAddress(acc.AccountAddress); err != nil {
+		if err := icatypes.ValidateAccountAddress(acc.AccountAddress); err != nil {
 			return err
 		}
 	}
@@ -80,6 +96,20 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	for _, record := range gs.ChannelHistory {
+		if err := host.ConnectionIdentifierValidator(record.ConnectionId); err != nil {
+			return err
+		}
+
+		if err := host.PortIdentifierValidator(record.PortId); err != nil {
+			return err
+		}
+
+		if err := host.ChannelIdentifierValidator(record.ChannelId); err != nil {
+			return err
+		}
+	}
+
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
@@ -90,18 +120,22 @@ func (gs ControllerGenesisState) Validate() error {
 // DefaultHostGenesis creates and returns the default interchain accounts HostGenesisState
 func DefaultHostGenesis() HostGenesisState {
 	return HostGenesisState{
-		Port:   PortID,
+		Port:   icatypes.PortID,
 		Params: hosttypes.DefaultParams(),
 	}
 }
 
 // NewHostGenesisState creates a returns a new HostGenesisState instance
-func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params) HostGenesisState {
+func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params, msgConstraints []hosttypes.MsgConstraint, accountAllowances []hosttypes.AccountAllowance, executionQuotas []hosttypes.ExecutionQuota, quotaUsages []hosttypes.QuotaUsage) HostGenesisState {
 	return HostGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Port:               port,
 		Params:             hostParams,
+		MsgConstraints:     msgConstraints,
+		AccountAllowances:  accountAllowances,
+		ExecutionQuotas:    executionQuotas,
+		QuotaUsages:        quotaUsages,
 	}
 }
 
@@ -122,7 +156,7 @@ func (gs HostGenesisState) Validate() error {
 			return err
 		}
 
-		if err := ValidateAccountAddress(acc.AccountAddress); err != nil {
+		if err := icatypes.ValidateAccountAddress(acc.AccountAddress); err != nil {
 			return err
 		}
 	}
@@ -135,5 +169,68 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	msgTypeURLs := make(map[string]bool)
+	for _, constraint := range gs.MsgConstraints {
+		if err := constraint.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if msgTypeURLs[constraint.MsgTypeURL()] {
+			return fmt.Errorf("duplicate message constraint for message type: %s", constraint.MsgTypeURL())
+		}
+
+		msgTypeURLs[constraint.MsgTypeURL()] = true
+	}
+
+	for _, allowance := range gs.AccountAllowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		if !msgTypeURLs[allowance.MsgTypeUrl] {
+			return fmt.Errorf("allowance of interchain account %s has no message constraint for message type: %s", allowance.Address, allowance.MsgTypeUrl)
+		}
+	}
+
+	connectionIDs := make(map[string]bool)
+	for _, quota := range gs.ExecutionQuotas {
+		if err := quota.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if connectionIDs[quota.ConnectionId] {
+			return fmt.Errorf("duplicate execution quota for connection: %s", quota.ConnectionId)
+		}
+
+		connectionIDs[quota.ConnectionId] = true
+	}
+
+	for _, usage := range gs.QuotaUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+
+		if !connectionIDs[usage.ConnectionId] {
+			return fmt.Errorf("quota usage of interchain account %s has no execution quota for connection: %s", usage.Address, usage.ConnectionId)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs HostGenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, constraint := range gs.MsgConstraints {
+		if err := constraint.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for _, allowance := range gs.AccountAllowances {
+		if err := allowance.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
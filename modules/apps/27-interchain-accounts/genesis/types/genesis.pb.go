@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: ibc/applications/interchain_accounts/v1/genesis.proto
+// source: ibc/applications/interchain_accounts/genesis/v1/genesis.proto
 
 package types
 
@@ -35,7 +35,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{0}
+	return fileDescriptor_d4aa48c8e29a1947, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -84,13 +84,14 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ChannelHistory     []types.ChannelRecord         `protobuf:"bytes,5,rep,name=channel_history,json=channelHistory,proto3" json:"channel_history" yaml:"channel_history"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
 func (m *ControllerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ControllerGenesisState) ProtoMessage()    {}
 func (*ControllerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{1}
+	return fileDescriptor_d4aa48c8e29a1947, []int{1}
 }
 func (m *ControllerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,19 +148,30 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetChannelHistory() []types.ChannelRecord {
+	if m != nil {
+		return m.ChannelHistory
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
 	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
 	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	MsgConstraints     []types1.MsgConstraint        `protobuf:"bytes,5,rep,name=msg_constraints,json=msgConstraints,proto3" json:"msg_constraints" yaml:"msg_constraints"`
+	AccountAllowances  []types1.AccountAllowance     `protobuf:"bytes,6,rep,name=account_allowances,json=accountAllowances,proto3" json:"account_allowances" yaml:"account_allowances"`
+	ExecutionQuotas    []types1.ExecutionQuota       `protobuf:"bytes,7,rep,name=execution_quotas,json=executionQuotas,proto3" json:"execution_quotas" yaml:"execution_quotas"`
+	QuotaUsages        []types1.QuotaUsage           `protobuf:"bytes,8,rep,name=quota_usages,json=quotaUsages,proto3" json:"quota_usages" yaml:"quota_usages"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
 func (m *HostGenesisState) String() string { return proto.CompactTextString(m) }
 func (*HostGenesisState) ProtoMessage()    {}
 func (*HostGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{2}
+	return fileDescriptor_d4aa48c8e29a1947, []int{2}
 }
 func (m *HostGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,18 +228,48 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
-// ActiveChannel contains a connection ID, port ID and associated active channel ID
+func (m *HostGenesisState) GetMsgConstraints() []types1.MsgConstraint {
+	if m != nil {
+		return m.MsgConstraints
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetAccountAllowances() []types1.AccountAllowance {
+	if m != nil {
+		return m.AccountAllowances
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetExecutionQuotas() []types1.ExecutionQuota {
+	if m != nil {
+		return m.ExecutionQuotas
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetQuotaUsages() []types1.QuotaUsage {
+	if m != nil {
+		return m.QuotaUsages
+	}
+	return nil
+}
+
+// ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
+// indicate if the channel is middleware enabled
 type ActiveChannel struct {
-	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	ConnectionId        string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId              string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId           string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	IsMiddlewareEnabled bool   `protobuf:"varint,4,opt,name=is_middleware_enabled,json=isMiddlewareEnabled,proto3" json:"is_middleware_enabled,omitempty" yaml:"is_middleware_enabled"`
 }
 
 func (m *ActiveChannel) Reset()         { *m = ActiveChannel{} }
 func (m *ActiveChannel) String() string { return proto.CompactTextString(m) }
 func (*ActiveChannel) ProtoMessage()    {}
 func (*ActiveChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{3}
+	return fileDescriptor_d4aa48c8e29a1947, []int{3}
 }
 func (m *ActiveChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,6 +319,13 @@ func (m *ActiveChannel) GetChannelId() string {
 	return ""
 }
 
+func (m *ActiveChannel) GetIsMiddlewareEnabled() bool {
+	if m != nil {
+		return m.IsMiddlewareEnabled
+	}
+	return false
+}
+
 // RegisteredInterchainAccount contains a connection ID, port ID and associated interchain account address
 type RegisteredInterchainAccount struct {
 	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
@@ -288,7 +337,7 @@ func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchai
 func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
 func (*RegisteredInterchainAccount) ProtoMessage()    {}
 func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{4}
+	return fileDescriptor_d4aa48c8e29a1947, []int{4}
 }
 func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,60 +388,76 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 }
 
 func init() {
-	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.v1.GenesisState")
-	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.v1.ControllerGenesisState")
-	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.v1.HostGenesisState")
-	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.v1.ActiveChannel")
-	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.GenesisState")
+	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
+	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
+	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.genesis.v1.ActiveChannel")
+	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredInterchainAccount")
 }
 
 func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/v1/genesis.proto", fileDescriptor_629b3ced0911516b)
-}
-
-var fileDescriptor_629b3ced0911516b = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0x13, 0x3f,
-	0x18, 0xc7, 0xe3, 0xa4, 0xbf, 0xfe, 0x14, 0xf7, 0x0f, 0xc5, 0x94, 0xea, 0x08, 0xd2, 0x25, 0x78,
-	0x69, 0x24, 0xd4, 0x3b, 0xf5, 0x0f, 0x54, 0x54, 0x42, 0xa8, 0x17, 0x10, 0x64, 0x43, 0x66, 0x41,
-	0x2c, 0x27, 0xc7, 0x67, 0x25, 0x96, 0x92, 0x73, 0x74, 0x76, 0x23, 0x75, 0x62, 0x67, 0x62, 0x43,
-	0xac, 0x48, 0xbc, 0x00, 0xde, 0x01, 0x63, 0x27, 0xd4, 0x91, 0x29, 0x42, 0xed, 0x3b, 0xc8, 0x2b,
-	0x40, 0xf6, 0x9d, 0x92, 0xf4, 0x48, 0xab, 0x9b, 0x98, 0x98, 0x62, 0xdf, 0xe3, 0xef, 0xf7, 0xf9,
-	0x3c, 0x7e, 0x1c, 0x1b, 0x3e, 0x12, 0x1d, 0xe6, 0xd3, 0xe1, 0xb0, 0x2f, 0x18, 0xd5, 0x42, 0xc6,
-	0xca, 0x17, 0xb1, 0xe6, 0x09, 0xeb, 0x51, 0x11, 0x87, 0x94, 0x31, 0x79, 0x12, 0x6b, 0xe5, 0x8f,
-	0x76, 0xfd, 0x2e, 0x8f, 0xb9, 0x12, 0xca, 0x1b, 0x26, 0x52, 0x4b, 0xb4, 0x2d, 0x3a, 0xcc, 0x9b,
-	0x97, 0x79, 0x0b, 0x64, 0xde, 0x68, 0xb7, 0xb6, 0xd9, 0x95, 0x5d, 0x69, 0x35, 0xbe, 0x19, 0xa5,
-	0xf2, 0x5a, 0xab, 0x50, 0x56, 0x26, 0x63, 0x9d, 0xc8, 0x7e, 0x9f, 0x27, 0x06, 0x60, 0x36, 0xcb,
-	0x4c, 0x0e, 0x0b, 0x99, 0xf4, 0xa4, 0xd2, 0x46, 0x6e, 0x7e, 0x53, 0x21, 0xfe, 0x5e, 0x86, 0xab,
-	0x2f, 0xd3, 0x72, 0xde, 0x68, 0xaa, 0x39, 0xfa, 0x02, 0xa0, 0x33, 0xb3, 0x0f, 0xb3, 0x52, 0x43,
-	0x65, 0x82, 0x0e, 0x68, 0x80, 0xe6, 0xca, 0xde, 0x33, 0xaf, 0x60, 0xc5, 0x5e, 0x6b, 0x6a, 0x34,
-	0x9f, 0x23, 0xd8, 0x3e, 0x1b, 0xd7, 0x4b, 0x93, 0x71, 0xbd, 0x7e, 0x4a, 0x07, 0xfd, 0x23, 0x7c,
-	0x5d, 0x3a, 0x4c, 0xb6, 0xd8, 0x42, 0x03, 0xf4, 0x01, 0x40, 0x64, 0x8a, 0xc8, 0xe1, 0x95, 0x2d,
-	0xde, 0x93, 0xc2, 0x78, 0xaf, 0xa4, 0xd2, 0x57, 0xc0, 0x1e, 0x64, 0x60, 0xf7, 0x52, 0xb0, 0x3f,
-	0x53, 0x60, 0xb2, 0xd1, 0xcb, 0x89, 0xf0, 0xd7, 0x0a, 0xdc, 0x5a, 0x5c, 0x28, 0x7a, 0x0f, 0x6f,
-	0x51, 0xa6, 0xc5, 0x88, 0x87, 0xac, 0x47, 0xe3, 0x98, 0xf7, 0x95, 0x03, 0x1a, 0x95, 0xe6, 0xca,
-	0xde, 0xe3, 0xc2, 0x8c, 0xc7, 0x56, 0xdf, 0x4a, 0xe5, 0x81, 0x9b, 0x01, 0x6e, 0xa5, 0x80, 0x39,
-	0x73, 0x4c, 0xd6, 0xe9, 0xfc, 0x72, 0x85, 0x3e, 0x03, 0x78, 0x67, 0x81, 0xb1, 0x53, 0xb6, 0x14,
-	0xcf, 0x0b, 0x53, 0x10, 0xde, 0x15, 0x4a, 0xf3, 0x84, 0x47, 0xed, 0xe9, 0x82, 0xe3, 0x34, 0x1e,
-	0xe0, 0x8c, 0xa9, 0x96, 0x32, 0x2d, 0x70, 0xc0, 0x04, 0x89, 0xbc, 0x4c, 0xa1, 0x4d, 0xf8, 0xdf,
-	0x50, 0x26, 0x5a, 0x39, 0x95, 0x46, 0xa5, 0x59, 0x25, 0xe9, 0x04, 0xbd, 0x85, 0xcb, 0x43, 0x9a,
-	0xd0, 0x81, 0x72, 0x96, 0x6c, 0x37, 0x8f, 0x8a, 0x31, 0xce, 0xfd, 0x23, 0x46, 0xbb, 0xde, 0x6b,
-	0xeb, 0x10, 0x2c, 0x19, 0x32, 0x92, 0xf9, 0xe1, 0x4f, 0x15, 0xb8, 0x91, 0xef, 0xf8, 0xbf, 0x0e,
-	0xdd, 0xd4, 0x21, 0x04, 0x97, 0x4c, 0x53, 0x9c, 0x4a, 0x03, 0x34, 0xab, 0xc4, 0x8e, 0x11, 0xc9,
-	0xf5, 0xe7, 0xa0, 0x18, 0xa1, 0xbd, 0x72, 0xae, 0xeb, 0xcc, 0x37, 0x00, 0xd7, 0xae, 0xec, 0x22,
-	0x7a, 0x0a, 0xd7, 0x98, 0x8c, 0x63, 0xce, 0x8c, 0x63, 0x28, 0x22, 0x7b, 0xf3, 0x54, 0x03, 0x67,
-	0x32, 0xae, 0x6f, 0x4e, 0x2f, 0x8d, 0x59, 0x18, 0x93, 0xd5, 0xd9, 0xbc, 0x1d, 0xa1, 0x87, 0xf0,
-	0x7f, 0x03, 0x6b, 0x84, 0x65, 0x2b, 0x44, 0x93, 0x71, 0x7d, 0x3d, 0x15, 0x66, 0x01, 0x4c, 0x96,
-	0xcd, 0xa8, 0x1d, 0xa1, 0x03, 0x08, 0xb3, 0xf6, 0x98, 0xf5, 0xb6, 0xd6, 0xe0, 0xee, 0x64, 0x5c,
-	0xbf, 0x9d, 0x25, 0x9a, 0xc6, 0x30, 0xa9, 0x66, 0x93, 0x76, 0x84, 0x7f, 0x00, 0x78, 0xff, 0x86,
-	0x3d, 0xff, 0xab, 0x15, 0xb4, 0xcc, 0x21, 0xb6, 0x69, 0x43, 0x1a, 0x45, 0x09, 0x57, 0x2a, 0x2b,
-	0xa3, 0x36, 0x7f, 0x10, 0xaf, 0x2c, 0xb0, 0x07, 0xd1, 0x7e, 0x39, 0x4e, 0x3f, 0x04, 0xe1, 0xd9,
-	0x85, 0x0b, 0xce, 0x2f, 0x5c, 0xf0, 0xeb, 0xc2, 0x05, 0x1f, 0x2f, 0xdd, 0xd2, 0xf9, 0xa5, 0x5b,
-	0xfa, 0x79, 0xe9, 0x96, 0xde, 0xbd, 0xe8, 0x0a, 0xdd, 0x3b, 0xe9, 0x78, 0x4c, 0x0e, 0x7c, 0x26,
-	0xd5, 0x40, 0x2a, 0x5f, 0x74, 0xd8, 0x4e, 0x57, 0xfa, 0xa3, 0x7d, 0x7f, 0x20, 0xa3, 0x93, 0x3e,
-	0x57, 0xe6, 0xf1, 0x51, 0xfe, 0xde, 0xe1, 0xce, 0xac, 0xf9, 0x3b, 0xd3, 0x77, 0x47, 0x9f, 0x0e,
-	0xb9, 0xea, 0x2c, 0xdb, 0x17, 0x67, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x08, 0x8f,
-	0x1f, 0x67, 0x07, 0x00, 0x00,
+	proto.RegisterFile("ibc/applications/interchain_accounts/genesis/v1/genesis.proto", fileDescriptor_d4aa48c8e29a1947)
+}
+
+var fileDescriptor_d4aa48c8e29a1947 = []byte{
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x4d, 0x6f, 0xdb, 0x36,
+	0x18, 0xc7, 0x23, 0x3b, 0x4d, 0x1a, 0x26, 0x4d, 0x52, 0x26, 0xcd, 0xb4, 0x74, 0xb0, 0x3d, 0x5d,
+	0x16, 0x60, 0x88, 0x85, 0xa4, 0x05, 0x5a, 0x74, 0xcb, 0x80, 0xc8, 0x28, 0xda, 0x00, 0x0b, 0xb0,
+	0x71, 0x1b, 0x30, 0xec, 0x22, 0xd0, 0x14, 0x21, 0x13, 0x90, 0x44, 0x57, 0x8f, 0xec, 0x36, 0xf7,
+	0x01, 0x1d, 0x76, 0x2a, 0xf6, 0x0d, 0x76, 0xdd, 0xd7, 0xd8, 0x61, 0x3d, 0x0d, 0x39, 0xee, 0x64,
+	0x0c, 0xc9, 0x37, 0xf0, 0x27, 0x18, 0x48, 0xd1, 0x6f, 0x8a, 0x33, 0x58, 0x97, 0x9d, 0x76, 0xb2,
+	0xf8, 0xf2, 0xff, 0x3f, 0x3f, 0x3f, 0x7a, 0xf8, 0x88, 0xe8, 0x44, 0xb4, 0x99, 0x4b, 0xbb, 0xdd,
+	0x48, 0x30, 0x9a, 0x09, 0x99, 0x80, 0x2b, 0x92, 0x8c, 0xa7, 0xac, 0x43, 0x45, 0xe2, 0x53, 0xc6,
+	0x64, 0x2f, 0xc9, 0xc0, 0x0d, 0x79, 0xc2, 0x41, 0x80, 0xdb, 0x3f, 0x1a, 0x3d, 0x36, 0xbb, 0xa9,
+	0xcc, 0x24, 0x76, 0x45, 0x9b, 0x35, 0xa7, 0xe5, 0xcd, 0x39, 0xf2, 0xe6, 0x48, 0xd3, 0x3f, 0xda,
+	0xdf, 0x0d, 0x65, 0x28, 0xb5, 0xd6, 0x55, 0x4f, 0xb9, 0xcd, 0x7e, 0x6b, 0x21, 0x0a, 0x26, 0x93,
+	0x2c, 0x95, 0x51, 0xc4, 0x53, 0x05, 0x32, 0x19, 0x19, 0x93, 0x27, 0x0b, 0x99, 0x74, 0x24, 0x64,
+	0x4a, 0xae, 0x7e, 0x8d, 0xf0, 0xa4, 0x94, 0x90, 0xc9, 0x04, 0xb2, 0x94, 0x8a, 0x64, 0x24, 0x7f,
+	0x5a, 0x4a, 0xfe, 0xaa, 0x27, 0x33, 0x9a, 0x2b, 0x9d, 0xcb, 0x0a, 0xda, 0x78, 0x91, 0xe7, 0xe6,
+	0x9b, 0x8c, 0x66, 0x1c, 0xff, 0x66, 0x21, 0x7b, 0xf2, 0xbf, 0x7c, 0x93, 0x37, 0x1f, 0xd4, 0xa2,
+	0x6d, 0x35, 0xac, 0x83, 0xf5, 0xe3, 0x17, 0xcd, 0x92, 0x29, 0x6f, 0xb6, 0xc6, 0x86, 0xd3, 0xb1,
+	0xbc, 0x4f, 0xde, 0x0f, 0xea, 0x4b, 0xc3, 0x41, 0xbd, 0x7e, 0x41, 0xe3, 0xe8, 0x99, 0x73, 0x5b,
+	0x58, 0x87, 0xec, 0xb1, 0xb9, 0x06, 0xf8, 0x17, 0x0b, 0x61, 0xf5, 0xaf, 0x0a, 0x98, 0x15, 0x8d,
+	0x79, 0x5a, 0x1a, 0xf3, 0xa5, 0x84, 0x6c, 0x06, 0xf0, 0x63, 0x03, 0xf8, 0x61, 0x0e, 0x78, 0x33,
+	0x94, 0x43, 0xb6, 0x3b, 0x05, 0x91, 0xf3, 0xfb, 0x32, 0xda, 0x9b, 0xff, 0x87, 0xf1, 0x5b, 0x0b,
+	0x6d, 0x51, 0x96, 0x89, 0x3e, 0xf7, 0x59, 0x87, 0x26, 0x09, 0x8f, 0xc0, 0xb6, 0x1a, 0xd5, 0x83,
+	0xf5, 0xe3, 0x2f, 0x4a, 0xc3, 0x9e, 0x6a, 0x9f, 0x56, 0x6e, 0xe3, 0xd5, 0x0c, 0xe9, 0x5e, 0x4e,
+	0x5a, 0x08, 0xe2, 0x90, 0x4d, 0x3a, 0xbd, 0x1d, 0xf0, 0xaf, 0x16, 0xda, 0x99, 0x13, 0xc0, 0xae,
+	0x68, 0x9a, 0x2f, 0x4b, 0xd3, 0x10, 0x1e, 0x0a, 0xc8, 0x78, 0xca, 0x83, 0xb3, 0xf1, 0xc6, 0xd3,
+	0x7c, 0x9f, 0xe7, 0x18, 0xb6, 0xfd, 0x9c, 0x6d, 0x8e, 0x93, 0x43, 0xb0, 0x28, 0xca, 0x00, 0xef,
+	0xa2, 0x3b, 0x5d, 0x99, 0x66, 0x60, 0x57, 0x1b, 0xd5, 0x83, 0x35, 0x92, 0x0f, 0xf0, 0xf7, 0x68,
+	0xa5, 0x4b, 0x53, 0x1a, 0x83, 0xbd, 0xac, 0x5f, 0xf3, 0xb3, 0xc5, 0x58, 0xa7, 0xce, 0x6a, 0xff,
+	0xa8, 0xf9, 0x95, 0x76, 0xf0, 0x96, 0x15, 0x19, 0x31, 0x7e, 0xf8, 0x67, 0x0b, 0x6d, 0x99, 0x8c,
+	0xf9, 0x1d, 0x01, 0x99, 0x4c, 0x2f, 0xec, 0x3b, 0x8d, 0xea, 0xe2, 0xa5, 0x34, 0x1b, 0xc3, 0xe4,
+	0x9a, 0x70, 0x26, 0xd3, 0xa0, 0xf8, 0x82, 0x0a, 0x71, 0x1c, 0xb2, 0x69, 0x66, 0x5e, 0x9a, 0x89,
+	0x3f, 0x56, 0xd1, 0x76, 0xb1, 0x1e, 0xff, 0xaf, 0x9f, 0x52, 0xf5, 0x83, 0xd1, 0xb2, 0x2a, 0x19,
+	0xbb, 0xda, 0xb0, 0x0e, 0xd6, 0x88, 0x7e, 0xc6, 0xa4, 0x50, 0x3d, 0x8f, 0x17, 0x23, 0xd5, 0xad,
+	0xfa, 0xb6, 0xba, 0xf9, 0xd1, 0x42, 0x5b, 0x31, 0x84, 0xfe, 0xa4, 0x2d, 0x83, 0xa9, 0x9b, 0xcf,
+	0xca, 0xb9, 0x9f, 0x43, 0xd8, 0x1a, 0x7b, 0x14, 0x5f, 0x49, 0x21, 0x82, 0x43, 0x36, 0xe3, 0xe9,
+	0xed, 0x80, 0xdf, 0x59, 0x08, 0x1b, 0x4b, 0x9f, 0x46, 0x91, 0x7c, 0x4d, 0x13, 0xc6, 0xc1, 0x5e,
+	0x29, 0x53, 0x1f, 0x23, 0x12, 0x93, 0xc3, 0xd3, 0x91, 0x4d, 0xb1, 0x13, 0xde, 0x8c, 0xe3, 0x90,
+	0xfb, 0xb4, 0x20, 0x02, 0xfc, 0x93, 0x85, 0xb6, 0xf9, 0x1b, 0xce, 0x7a, 0x2a, 0xa2, 0xaf, 0xbf,
+	0x3b, 0x60, 0xaf, 0x6a, 0xa0, 0xcf, 0xcb, 0x01, 0x3d, 0x1f, 0xb9, 0x7c, 0xad, 0x4c, 0xbc, 0xba,
+	0xc1, 0xf9, 0x20, 0xc7, 0x29, 0xc6, 0x70, 0xc8, 0x16, 0x9f, 0x11, 0x00, 0x7e, 0x83, 0x36, 0xf4,
+	0x9a, 0xdf, 0x03, 0x1a, 0x72, 0xb0, 0xef, 0x6a, 0x8a, 0xa7, 0xe5, 0x28, 0xb4, 0xd7, 0x77, 0xca,
+	0xc0, 0x7b, 0x68, 0x08, 0x76, 0x72, 0x82, 0x69, 0x6f, 0x87, 0xac, 0xbf, 0x1a, 0x6f, 0x04, 0xe7,
+	0x6d, 0x05, 0xdd, 0x9b, 0x39, 0x6c, 0xf8, 0x04, 0xdd, 0x63, 0x32, 0x49, 0x38, 0xd3, 0xc8, 0x22,
+	0xd0, 0xdf, 0xd5, 0x35, 0xcf, 0x1e, 0x0e, 0xea, 0xbb, 0xe3, 0x4f, 0xe1, 0x64, 0xd9, 0x21, 0x1b,
+	0x93, 0xf1, 0x59, 0x80, 0x3f, 0x45, 0xab, 0xaa, 0x96, 0x95, 0xb0, 0xa2, 0x85, 0x78, 0x38, 0xa8,
+	0x6f, 0xe6, 0x42, 0xb3, 0xe0, 0x90, 0x15, 0xf5, 0x74, 0x16, 0xe0, 0xc7, 0x08, 0x8d, 0x7a, 0x8d,
+	0x08, 0xf2, 0xa3, 0xe0, 0x3d, 0x18, 0x0e, 0xea, 0xf7, 0x67, 0xfb, 0x90, 0x92, 0xac, 0x99, 0xc1,
+	0x59, 0x80, 0xbf, 0x45, 0x0f, 0x04, 0xf8, 0xb1, 0x08, 0x82, 0x88, 0xbf, 0xa6, 0x29, 0xf7, 0x79,
+	0x42, 0xdb, 0x11, 0x0f, 0xf4, 0xa9, 0xb9, 0xeb, 0x35, 0x86, 0x83, 0xfa, 0x47, 0xe6, 0x34, 0xce,
+	0xdb, 0xe6, 0x90, 0x1d, 0x01, 0xe7, 0xe3, 0xe9, 0xe7, 0x66, 0xf6, 0x4f, 0x0b, 0x3d, 0xfc, 0x97,
+	0x83, 0xfe, 0x9f, 0xe6, 0xa5, 0xa5, 0x3a, 0xa9, 0x29, 0xe2, 0x20, 0x48, 0x39, 0x80, 0x49, 0xce,
+	0xfe, 0x74, 0x17, 0x9c, 0xd9, 0xa0, 0xbb, 0x60, 0x5e, 0xe2, 0xf9, 0x84, 0x17, 0xbe, 0xbf, 0xaa,
+	0x59, 0x97, 0x57, 0x35, 0xeb, 0xef, 0xab, 0x9a, 0xf5, 0xee, 0xba, 0xb6, 0x74, 0x79, 0x5d, 0x5b,
+	0xfa, 0xeb, 0xba, 0xb6, 0xf4, 0xc3, 0x79, 0x28, 0xb2, 0x4e, 0xaf, 0xdd, 0x64, 0x32, 0x76, 0x99,
+	0x84, 0x58, 0x82, 0xba, 0xa7, 0x1e, 0x86, 0xd2, 0xed, 0x3f, 0x72, 0x63, 0x19, 0xf4, 0x22, 0x0e,
+	0xea, 0xc6, 0x06, 0xee, 0xf1, 0x93, 0xc3, 0x49, 0xc9, 0x1d, 0xde, 0xb8, 0xef, 0x66, 0x17, 0x5d,
+	0x0e, 0xed, 0x15, 0x7d, 0x5b, 0x7b, 0xf4, 0xcf, 0x00, 0x68, 0xe4, 0x6c, 0x43, 0x2c, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -458,6 +523,20 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelHistory) > 0 {
+		for iNdEx := len(m.ChannelHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -528,6 +607,62 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QuotaUsages) > 0 {
+		for iNdEx := len(m.QuotaUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuotaUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ExecutionQuotas) > 0 {
+		for iNdEx := len(m.ExecutionQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AccountAllowances) > 0 {
+		for iNdEx := len(m.AccountAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MsgConstraints) > 0 {
+		for iNdEx := len(m.MsgConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -596,6 +731,16 @@ func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsMiddlewareEnabled {
+		i--
+		if m.IsMiddlewareEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -714,6 +859,12 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChannelHistory) > 0 {
+		for _, e := range m.ChannelHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -741,6 +892,30 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MsgConstraints) > 0 {
+		for _, e := range m.MsgConstraints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountAllowances) > 0 {
+		for _, e := range m.AccountAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutionQuotas) > 0 {
+		for _, e := range m.ExecutionQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuotaUsages) > 0 {
+		for _, e := range m.QuotaUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -762,6 +937,9 @@ func (m *ActiveChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.IsMiddlewareEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1070,6 +1248,40 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelHistory = append(m.ChannelHistory, types.ChannelRecord{})
+			if err := m.ChannelHistory[len(m.ChannelHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1253,6 +1465,142 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgConstraints = append(m.MsgConstraints, types1.MsgConstraint{})
+			if err := m.MsgConstraints[len(m.MsgConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAllowances = append(m.AccountAllowances, types1.AccountAllowance{})
+			if err := m.AccountAllowances[len(m.AccountAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionQuotas = append(m.ExecutionQuotas, types1.ExecutionQuota{})
+			if err := m.ExecutionQuotas[len(m.ExecutionQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotaUsages = append(m.QuotaUsages, types1.QuotaUsage{})
+			if err := m.QuotaUsages[len(m.QuotaUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1399,6 +1747,26 @@ func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMiddlewareEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMiddlewareEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
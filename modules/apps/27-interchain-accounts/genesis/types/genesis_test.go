@@ -1,13 +1,37 @@
 package types_test
 
 import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/suite"
+
 	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
-	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
-func (suite *TypesTestSuite) TestValidateGenesisState() {
+var (
+	// TestOwnerAddress defines a reusable bech32 address for testing purposes
+	TestOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+	// TestPortID defines a resuable port identifier for testing purposes
+	TestPortID, _ = icatypes.NewControllerPortID(TestOwnerAddress)
+)
+
+type GenesisTypesTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTypesTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTypesTestSuite))
+}
+
+func (suite *GenesisTypesTestSuite) TestValidateGenesisState() {
 	var genesisState types.GenesisState
 
 	testCases := []struct {
@@ -60,7 +84,7 @@ func (suite *TypesTestSuite) TestValidateGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
+func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 	var genesisState types.ControllerGenesisState
 
 	testCases := []struct {
@@ -83,7 +107,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -97,7 +121,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -118,7 +142,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -139,7 +163,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -160,7 +184,18 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams(), nil)
+			},
+			false,
+		},
+		{
+			"failed to validate controller channel history - invalid channel identifier",
+			func() {
+				channelHistory := []controllertypes.ChannelRecord{
+					controllertypes.NewChannelRecord(ibctesting.FirstConnectionID, TestPortID, "invalid|channel", 1),
+				}
+
+				genesisState = types.NewControllerGenesisState(nil, nil, []string{TestPortID}, controllertypes.DefaultParams(), channelHistory)
 			},
 			false,
 		},
@@ -183,8 +218,14 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 	}
 }
 
-func (suite *TypesTestSuite) TestValidateHostGenesisState() {
-	var genesisState types.HostGenesisState
+func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
+	var (
+		genesisState types.HostGenesisState
+		quota        hosttypes.ExecutionQuota
+	)
+
+	constraint, err := hosttypes.NewMsgConstraint(hosttypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), nil), 24)
+	suite.Require().NoError(err)
 
 	testCases := []struct {
 		name     string
@@ -206,7 +247,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, nil, nil)
 			},
 			false,
 		},
@@ -220,7 +261,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, nil, nil)
 			},
 			false,
 		},
@@ -241,7 +282,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, nil, nil)
 			},
 			false,
 		},
@@ -262,7 +303,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, types.PortID, hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, nil, nil)
 			},
 			false,
 		},
@@ -283,7 +324,87 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams())
+				genesisState = types.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams(), nil, nil, nil, nil)
+			},
+			false,
+		},
+		{
+			"success with message constraints and allowances",
+			func() {
+				allowance, err := hosttypes.NewAccountAllowance(TestOwnerAddress, constraint.MsgTypeURL(), nil, time.Unix(1000, 0))
+				suite.Require().NoError(err)
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), []hosttypes.MsgConstraint{constraint}, []hosttypes.AccountAllowance{allowance}, nil, nil)
+			},
+			true,
+		},
+		{
+			"failed to validate message constraints - duplicate message type",
+			func() {
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), []hosttypes.MsgConstraint{constraint, constraint}, nil, nil, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate message constraints - invalid authorization",
+			func() {
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), []hosttypes.MsgConstraint{{}}, nil, nil, nil)
+			},
+			false,
+		},
+		{
+			"success with execution quotas and usages",
+			func() {
+				usages := []hosttypes.QuotaUsage{
+					hosttypes.NewQuotaUsage(ibctesting.FirstConnectionID, "", 10, 2, 1000),
+					hosttypes.NewQuotaUsage(ibctesting.FirstConnectionID, TestOwnerAddress, 10, 1, 500),
+				}
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, []hosttypes.ExecutionQuota{quota}, usages)
+			},
+			true,
+		},
+		{
+			"failed to validate execution quotas - duplicate connection",
+			func() {
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, []hosttypes.ExecutionQuota{quota, quota}, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate execution quotas - invalid window",
+			func() {
+				quota.WindowBlocks = 0
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, []hosttypes.ExecutionQuota{quota}, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate quota usages - connection has no execution quota",
+			func() {
+				usage := hosttypes.NewQuotaUsage("connection-1", "", 10, 2, 1000)
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, []hosttypes.ExecutionQuota{quota}, []hosttypes.QuotaUsage{usage})
+			},
+			false,
+		},
+		{
+			"failed to validate quota usages - invalid window start height",
+			func() {
+				usage := hosttypes.NewQuotaUsage(ibctesting.FirstConnectionID, "", 0, 2, 1000)
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), nil, nil, []hosttypes.ExecutionQuota{quota}, []hosttypes.QuotaUsage{usage})
+			},
+			false,
+		},
+		{
+			"failed to validate allowances - message type is not constrained",
+			func() {
+				allowance, err := hosttypes.NewAccountAllowance(TestOwnerAddress, "/cosmos.staking.v1beta1.MsgDelegate", nil, time.Unix(1000, 0))
+				suite.Require().NoError(err)
+
+				genesisState = types.NewHostGenesisState(nil, nil, icatypes.PortID, hosttypes.DefaultParams(), []hosttypes.MsgConstraint{constraint}, []hosttypes.AccountAllowance{allowance}, nil, nil)
 			},
 			false,
 		},
@@ -292,6 +413,7 @@ func (suite *TypesTestSuite) TestValidateHostGenesisState() {
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			genesisState = types.DefaultHostGenesis()
+			quota = hosttypes.NewExecutionQuota(ibctesting.FirstConnectionID, 100, hosttypes.NewQuotaLimits(10, 0), hosttypes.NewQuotaLimits(0, 1000000))
 
 			tc.malleate() // malleate mutates test data
 
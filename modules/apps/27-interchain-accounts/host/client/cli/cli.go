@@ -15,8 +15,30 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdQueryInterchainAccounts(),
+		GetCmdAllowMessages(),
 		GetCmdPacketEvents(),
+		GetCmdMsgConstraints(),
+		GetCmdAllowance(),
+		GetCmdExecutionQuotas(),
+		GetCmdQuotaUsage(),
 	)
 
 	return queryCmd
 }
+
+// NewTxCmd returns the transaction commands for the ICA host submodule
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "host",
+		Short:                      "interchain-accounts host subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		NewGeneratePacketDataCmd(),
+	)
+
+	return txCmd
+}
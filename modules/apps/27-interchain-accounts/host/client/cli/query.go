@@ -18,6 +18,65 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
+const (
+	flagConnectionID = "connection-id"
+	flagOwnerPrefix  = "owner-prefix"
+	flagAddress      = "address"
+)
+
+// GetCmdQueryInterchainAccounts returns the command handler for querying the interchain accounts registered on the host chain.
+func GetCmdQueryInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query the interchain accounts registered on the host chain",
+		Long:    "Query the host submodule for the interchain accounts registered on the host chain, including their owner and active channel. The results may be filtered by connection and owner address prefix",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts --connection-id connection-0 --owner-prefix cosmos1", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			ownerPrefix, err := cmd.Flags().GetString(flagOwnerPrefix)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountsRequest{
+				ConnectionId: connectionID,
+				OwnerPrefix:  ownerPrefix,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagConnectionID, "", "Filter the interchain accounts by host connection identifier")
+	cmd.Flags().String(flagOwnerPrefix, "", "Filter the interchain accounts by owner address prefix")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for the host submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -47,6 +106,35 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdAllowMessages returns the command handler for the host submodule allowed message types querying.
+func GetCmdAllowMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allow-messages [connection-id]",
+		Short:   "Query the message types allowed to be executed by interchain accounts on a connection",
+		Long:    "Query the message types allowed to be executed on the host chain by interchain accounts controlled through the provided host connection",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host allow-messages connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllowMessages(cmd.Context(), &types.QueryAllowMessagesRequest{ConnectionId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdPacketEvents returns the command handler for the host packet events querying.
 func GetCmdPacketEvents() *cobra.Command {
 	cmd := &cobra.Command{
@@ -95,3 +183,125 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdMsgConstraints returns the command handler for the host submodule message constraints querying.
+func GetCmdMsgConstraints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "msg-constraints",
+		Short:   "Query the constraints on the message types executed by interchain accounts",
+		Long:    "Query the authorizations constraining the message types executed on the host chain by interchain accounts and the duration of their windows",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host msg-constraints", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgConstraints(cmd.Context(), &types.QueryMsgConstraintsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllowance returns the command handler for the interchain account allowance querying.
+func GetCmdAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowance [address] [msg-type-url]",
+		Short:   "Query the remaining allowance of an interchain account for a constrained message type",
+		Long:    "Query the remaining authorization of an interchain account for a constrained message type within the current window",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host allowance cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs /cosmos.bank.v1beta1.MsgSend", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Allowance(cmd.Context(), &types.QueryAllowanceRequest{Address: args[0], MsgTypeUrl: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExecutionQuotas returns the command handler for the host submodule execution quotas querying.
+func GetCmdExecutionQuotas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execution-quotas",
+		Short:   "Query the execution quotas of the host connections",
+		Long:    "Query the limits on the number of packets executed and the gas consumed by interchain accounts within a window of blocks for each host connection",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host execution-quotas", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExecutionQuotas(cmd.Context(), &types.QueryExecutionQuotasRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuotaUsage returns the command handler for the execution quota usage querying.
+func GetCmdQuotaUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quota-usage [connection-id]",
+		Short:   "Query the usage of the execution quota of a host connection",
+		Long:    "Query the execution quota of a host connection and its usage within the current window, optionally including the usage of an interchain account using the address flag",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host quota-usage connection-0 --address cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := cmd.Flags().GetString(flagAddress)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuotaUsage(cmd.Context(), &types.QueryQuotaUsageRequest{ConnectionId: args[0], Address: address})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagAddress, "", "interchain account address")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
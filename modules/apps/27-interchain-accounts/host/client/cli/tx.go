@@ -0,0 +1,371 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	flagDurationHours = "duration-hours"
+	flagMemo          = "memo"
+	flagEncoding      = "encoding"
+
+	flagConnectionMaxPackets = "connection-max-packets"
+	flagConnectionMaxGas     = "connection-max-gas"
+	flagAccountMaxPackets    = "account-max-packets"
+	flagAccountMaxGas        = "account-max-gas"
+)
+
+// NewGeneratePacketDataCmd returns the command to generate the interchain accounts packet data executing the provided messages
+func NewGeneratePacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-packet-data [path/to/messages.json]",
+		Short: "Generate interchain accounts packet data executing the provided messages",
+		Long: "Generate the JSON encoded InterchainAccountPacketData executing the provided messages on the host chain, ready to be sent through a controller transaction or a governance proposal.\n" +
+			"The messages are provided as a single JSON encoded message or a list of JSON encoded messages, either as a file path or as a raw JSON string, and are resolved using the interface registry.\n" +
+			"If the node flag is set, the message types are validated against the AllowMessages host param of the node, or against the allowlist of the host connection if the connection-id flag is set.",
+		Example: fmt.Sprintf(`%s tx interchain-accounts host generate-packet-data messages.json --memo memo
+
+Where messages.json contains:
+[
+  {
+    "@type": "/cosmos.bank.v1beta1.MsgSend",
+    "from_address": "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+    "to_address": "cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+    "amount": [{"denom": "stake", "amount": "1000"}]
+  }
+]`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// attempt to read the messages from a file, falling back to a raw JSON string
+			msgsBz, err := os.ReadFile(args[0])
+			if err != nil {
+				msgsBz = []byte(args[0])
+			}
+
+			msgs, err := parseMsgs(clientCtx.Codec, msgsBz)
+			if err != nil {
+				return err
+			}
+
+			if cmd.Flags().Changed(flags.FlagNode) {
+				if err := validateAllowMessages(cmd, clientCtx, msgs); err != nil {
+					return err
+				}
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			data, err := icatypes.SerializeCosmosTxWithEncoding(clientCtx.Codec, msgs, encoding)
+			if err != nil {
+				return err
+			}
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: memo,
+			}
+
+			if err := packetData.ValidateBasic(); err != nil {
+				return err
+			}
+
+			packetDataBz, err := clientCtx.Codec.MarshalJSON(&packetData)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", packetDataBz))
+		},
+	}
+
+	cmd.Flags().String(flagMemo, "", "memo to be included in the packet data")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("encoding format of the channel, either %s or %s", icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON))
+	cmd.Flags().String(flagConnectionID, "", "host connection identifier used to validate the messages against the allowlist of the connection")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for the host chain, the messages are validated against the host params if set")
+
+	return cmd
+}
+
+// parseMsgs resolves the provided single JSON encoded message or list of JSON encoded messages using the interface registry
+func parseMsgs(cdc codec.Codec, bz []byte) ([]sdk.Msg, error) {
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(bz, &rawMsgs); err != nil {
+		rawMsgs = []json.RawMessage{bz}
+	}
+
+	if len(rawMsgs) == 0 {
+		return nil, fmt.Errorf("no messages provided")
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal message %d: %w", i, err)
+		}
+
+		if err := msgs[i].ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
+
+// validateAllowMessages returns an error if a message type is not allowed by the host chain of the node, using the
+// allowlist of the host connection if the connection-id flag is set
+func validateAllowMessages(cmd *cobra.Command, clientCtx client.Context, msgs []sdk.Msg) error {
+	connectionID, err := cmd.Flags().GetString(flagConnectionID)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+
+	var allowMsgs []string
+	if connectionID != "" {
+		res, err := queryClient.AllowMessages(cmd.Context(), &types.QueryAllowMessagesRequest{ConnectionId: connectionID})
+		if err != nil {
+			return err
+		}
+
+		allowMsgs = res.AllowMessages
+	} else {
+		res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+		if err != nil {
+			return err
+		}
+
+		if res.Params != nil {
+			allowMsgs = res.Params.AllowMessages
+		}
+	}
+
+	for i, msg := range msgs {
+		if !types.ContainsMsgType(allowMsgs, msg) {
+			return fmt.Errorf("message %d of type %s is not allowed by the host chain", i, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// NewCmdSubmitSetMsgConstraintProposal implements a command handler for submitting a set message constraint proposal transaction.
+func NewCmdSubmitSetMsgConstraintProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ica-msg-constraint [path/to/authorization.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a set interchain accounts message constraint proposal",
+		Long: "Submit a proposal to constrain the execution of a message type by interchain accounts using an authz authorization along with an initial deposit.\n" +
+			"The authorization is evaluated separately for each interchain account and reset after each window, a duration of zero never resets the allowances.\n" +
+			"The allowances of all interchain accounts for the message type are reset if the proposal passes.",
+		Example: fmt.Sprintf(`%s tx gov submit-proposal set-ica-msg-constraint authorization.json --duration-hours 24 --title "..." --description "..." --deposit 10stake
+
+Where authorization.json contains:
+{
+  "@type": "/ibc.applications.interchain_accounts.host.v1.SendAuthorization",
+  "spend_limit": [{"denom": "stake", "amount": "1000"}],
+  "allow_list": ["cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs"]
+}`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization authz.Authorization
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &authorization); err != nil {
+				return fmt.Errorf("failed to unmarshal authorization: %w", err)
+			}
+
+			durationHours, err := cmd.Flags().GetUint64(flagDurationHours)
+			if err != nil {
+				return err
+			}
+
+			constraint, err := types.NewMsgConstraint(authorization, durationHours)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewSetMsgConstraintProposal(title, description, constraint)
+			})
+		},
+	}
+
+	cmd.Flags().Uint64(flagDurationHours, 0, "duration of the allowance window in hours, zero never resets the allowances")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRemoveMsgConstraintProposal implements a command handler for submitting a remove message constraint proposal transaction.
+func NewCmdSubmitRemoveMsgConstraintProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-ica-msg-constraint [msg-type-url]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a remove interchain accounts message constraint proposal",
+		Long:    "Submit a proposal to remove the constraint of a message type executed by interchain accounts along with an initial deposit.",
+		Example: fmt.Sprintf(`%s tx gov submit-proposal remove-ica-msg-constraint /cosmos.bank.v1beta1.MsgSend --title "..." --description "..." --deposit 10stake`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewRemoveMsgConstraintProposal(title, description, args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitSetExecutionQuotaProposal implements a command handler for submitting a set execution quota proposal transaction.
+func NewCmdSubmitSetExecutionQuotaProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ica-execution-quota [connection-id] [window-blocks]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a set interchain accounts execution quota proposal",
+		Long: "Submit a proposal to limit the number of packets executed and the gas consumed by interchain accounts controlled through a host connection within a window of blocks along with an initial deposit.\n" +
+			"The connection limits apply to all interchain accounts controlled through the connection together, the account limits apply to each interchain account, a limit of zero is unlimited.\n" +
+			"The usages of the quota of the connection are reset if the proposal passes.",
+		Example: fmt.Sprintf(`%s tx gov submit-proposal set-ica-execution-quota connection-0 100 --connection-max-packets 50 --account-max-gas 2000000 --title "..." --description "..." --deposit 10stake`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			windowBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window blocks: %w", err)
+			}
+
+			var limits [4]uint64
+			for i, flag := range []string{flagConnectionMaxPackets, flagConnectionMaxGas, flagAccountMaxPackets, flagAccountMaxGas} {
+				if limits[i], err = cmd.Flags().GetUint64(flag); err != nil {
+					return err
+				}
+			}
+
+			quota := types.NewExecutionQuota(args[0], windowBlocks, types.NewQuotaLimits(limits[0], limits[1]), types.NewQuotaLimits(limits[2], limits[3]))
+
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewSetExecutionQuotaProposal(title, description, quota)
+			})
+		},
+	}
+
+	cmd.Flags().Uint64(flagConnectionMaxPackets, 0, "maximum number of packets of all interchain accounts of the connection within a window, zero is unlimited")
+	cmd.Flags().Uint64(flagConnectionMaxGas, 0, "maximum gas consumed by all interchain accounts of the connection within a window, zero is unlimited")
+	cmd.Flags().Uint64(flagAccountMaxPackets, 0, "maximum number of packets of each interchain account within a window, zero is unlimited")
+	cmd.Flags().Uint64(flagAccountMaxGas, 0, "maximum gas consumed by each interchain account within a window, zero is unlimited")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRemoveExecutionQuotaProposal implements a command handler for submitting a remove execution quota proposal transaction.
+func NewCmdSubmitRemoveExecutionQuotaProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-ica-execution-quota [connection-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a remove interchain accounts execution quota proposal",
+		Long:    "Submit a proposal to remove the execution quota of a host connection along with an initial deposit.",
+		Example: fmt.Sprintf(`%s tx gov submit-proposal remove-ica-execution-quota connection-0 --title "..." --description "..." --deposit 10stake`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, func(title, description string) govtypes.Content {
+				return types.NewRemoveExecutionQuotaProposal(title, description, args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// submitProposal builds the proposal content using the title and description flags and generates
+// or broadcasts a transaction submitting it along with the deposit flag.
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, contentFn func(title, description string) govtypes.Content) error {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	content := contentFn(title, description)
+
+	from := clientCtx.GetFromAddress()
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
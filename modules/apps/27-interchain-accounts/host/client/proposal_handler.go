@@ -0,0 +1,28 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/client/cli"
+)
+
+var (
+	SetMsgConstraintProposalHandler     = govclient.NewProposalHandler(cli.NewCmdSubmitSetMsgConstraintProposal, emptyRestHandler)
+	RemoveMsgConstraintProposalHandler  = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveMsgConstraintProposal, emptyRestHandler)
+	SetExecutionQuotaProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitSetExecutionQuotaProposal, emptyRestHandler)
+	RemoveExecutionQuotaProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveExecutionQuotaProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-icahost",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for interchain accounts host proposals")
+		},
+	}
+}
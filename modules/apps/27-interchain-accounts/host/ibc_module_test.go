@@ -3,6 +3,7 @@ package host_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -13,10 +14,16 @@ import (
 	tmprotostate "github.com/tendermint/tendermint/proto/tendermint/state"
 	tmstate "github.com/tendermint/tendermint/state"
 
+	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	fee "github.com/cosmos/ibc-go/v3/modules/apps/29-fee"
+	packetforward "github.com/cosmos/ibc-go/v3/modules/apps/packet-forward"
+	ratelimiting "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
@@ -157,7 +164,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, nil, 0))
 			}, false,
 		},
 		{
@@ -172,8 +179,13 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 			}, true,
 		},
 		{
-			"ICA callback fails - invalid channel order", func() {
+			"success: UNORDERED channel", func() {
 				channel.Ordering = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"ICA callback fails - invalid channel order", func() {
+				channel.Ordering = channeltypes.NONE
 			}, false,
 		},
 	}
@@ -279,7 +291,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, nil, 0))
 			}, false,
 		},
 		{
@@ -405,7 +417,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, nil, 0))
 			}, false,
 		},
 		{
@@ -474,7 +486,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 			expectedAck := channeltypes.NewResultAcknowledgement(expectedTxResponse)
 
-			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 			// malleate packetData for test cases
@@ -659,7 +671,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Data: data,
 	}
 
-	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
@@ -707,8 +719,191 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	suite.assertBalance(icaAddr, expBalAfterSecondSend)
 }
 
+// Test controlling an interchain account over a channel which negotiated the proto3 JSON encoding.
+// ChainA is the controller chain. ChainB is the host chain
+func (suite *InterchainAccountsTestSuite) TestControlAccountWithProto3JSONEncoding() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	metadata := icatypes.NewMetadata(icatypes.Version, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, "", icatypes.EncodingProto3JSON, icatypes.TxTypeSDKMultiMsg)
+	path.EndpointA.ChannelConfig.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	res, err := suite.chainA.SendMsgs(controllertypes.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, path.EndpointA.ChannelConfig.Version, channeltypes.ORDERED))
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelConfig.PortID, err = icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanOpenTry()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.ChanOpenAck()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanOpenConfirm()
+	suite.Require().NoError(err)
+
+	// the encoding is retained in the metadata of the host channel
+	var hostMetadata icatypes.Metadata
+	icatypes.ModuleCdc.MustUnmarshalJSON([]byte(path.EndpointB.GetChannel().Version), &hostMetadata)
+	suite.Require().Equal(icatypes.EncodingProto3JSON, hostMetadata.Encoding)
+
+	var (
+		startingBal = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000)))
+		tokenAmt    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)))
+	)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTxWithEncoding(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProto3JSON)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	res, err = suite.chainA.SendMsgs(controllertypes.NewMsgSendTx(owner, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), icaPacketData))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// relay the packet
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the ica balance is updated
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	suite.assertBalance(icaAddr, startingBal.Sub(tokenAmt))
+}
+
 // assertBalance asserts that the provided address has exactly the expected balance.
 // CONTRACT: the expected balance must only contain one coin denom.
+// TestExecutionQuotaUsageOfFailedPacket tests that the execution of a packet failing with an error acknowledgement
+// is recorded in the usage of the execution quota when the packet is received through core IBC.
+func (suite *InterchainAccountsTestSuite) TestExecutionQuotaUsageOfFailedPacket() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	startingBal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	// the transaction fails as the amount sent exceeds the balance of the interchain account
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	quota := types.NewExecutionQuota(path.EndpointB.ConnectionID, 10, types.NewQuotaLimits(5, 0), types.NewQuotaLimits(5, 0))
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.SetExecutionQuotaAndResetUsages(suite.chainB.GetContext(), quota))
+
+	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(ok)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	// receive the packet through the IBC core message server
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	suite.Require().False(ack.Success())
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+	suite.assertBalance(icaAddr, startingBal)
+
+	// the usage of the failed packet is recorded although the state changes of the callback are discarded
+	ctx := suite.chainB.GetContext()
+	for _, address := range []string{"", interchainAccountAddr} {
+		usage := suite.chainB.GetSimApp().ICAHostKeeper.GetCurrentQuotaUsage(ctx, quota, address)
+		suite.Require().Equal(uint64(1), usage.Packets)
+		suite.Require().NotZero(usage.Gas)
+	}
+}
+
+// TestExecutionQuotaUsageThroughMiddleware tests that the usage of the execution quota is recorded when the host is
+// wrapped by middleware, as middleware forward WrapRecvPacket to the underlying application.
+func (suite *InterchainAccountsTestSuite) TestExecutionQuotaUsageThroughMiddleware() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	quota := types.NewExecutionQuota(path.EndpointB.ConnectionID, 10, types.NewQuotaLimits(5, 0), types.NewQuotaLimits(5, 0))
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.SetExecutionQuotaAndResetUsages(suite.chainB.GetContext(), quota))
+
+	app := suite.chainB.GetSimApp()
+	var stack porttypes.Middleware = fee.NewIBCMiddleware(
+		packetforward.NewIBCMiddleware(ratelimiting.NewIBCMiddleware(icahost.NewIBCModule(app.ICAHostKeeper), app.RateLimitingKeeper), app.PacketForwardKeeper),
+		app.IBCFeeKeeper,
+	)
+
+	packet := channeltypes.NewPacket(nil, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+
+	ctx := suite.chainB.GetContext()
+	ack := stack.WrapRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress(), func(ctx sdk.Context) exported.Acknowledgement {
+		ctx.GasMeter().ConsumeGas(1000, "packet execution")
+		return channeltypes.NewErrorAcknowledgement("packet execution failed")
+	})
+	suite.Require().False(ack.Success())
+
+	for _, address := range []string{"", interchainAccountAddr} {
+		usage := app.ICAHostKeeper.GetCurrentQuotaUsage(ctx, quota, address)
+		suite.Require().Equal(uint64(1), usage.Packets)
+		suite.Require().GreaterOrEqual(usage.Gas, uint64(1000))
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) assertBalance(addr sdk.AccAddress, expBalance sdk.Coins) {
 	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), addr, sdk.DefaultBondDenom)
 	suite.Require().Equal(expBalance[0], balance)
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// GetMsgConstraint retrieves the constraint for the given sdk message type
+func (k Keeper) GetMsgConstraint(ctx sdk.Context, msgTypeURL string) (types.MsgConstraint, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMsgConstraint(msgTypeURL))
+	if bz == nil {
+		return types.MsgConstraint{}, false
+	}
+
+	var constraint types.MsgConstraint
+	k.cdc.MustUnmarshal(bz, &constraint)
+
+	return constraint, true
+}
+
+// GetAllMsgConstraints returns the constraints for all constrained sdk message types
+func (k Keeper) GetAllMsgConstraints(ctx sdk.Context) []types.MsgConstraint {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.MsgConstraintPrefix+"/"))
+	defer iterator.Close()
+
+	var constraints []types.MsgConstraint
+	for ; iterator.Valid(); iterator.Next() {
+		var constraint types.MsgConstraint
+		k.cdc.MustUnmarshal(iterator.Value(), &constraint)
+
+		constraints = append(constraints, constraint)
+	}
+
+	return constraints
+}
+
+// SetMsgConstraint stores the constraint, keyed by the sdk message type of its authorization
+func (k Keeper) SetMsgConstraint(ctx sdk.Context, constraint types.MsgConstraint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyMsgConstraint(constraint.MsgTypeURL()), k.cdc.MustMarshal(&constraint))
+}
+
+// DeleteMsgConstraint removes the constraint for the given sdk message type
+func (k Keeper) DeleteMsgConstraint(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMsgConstraint(msgTypeURL))
+}
+
+// GetAccountAllowance retrieves the allowance of the given interchain account for the given sdk message type
+func (k Keeper) GetAccountAllowance(ctx sdk.Context, msgTypeURL, address string) (types.AccountAllowance, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAccountAllowance(msgTypeURL, address))
+	if bz == nil {
+		return types.AccountAllowance{}, false
+	}
+
+	var allowance types.AccountAllowance
+	k.cdc.MustUnmarshal(bz, &allowance)
+
+	return allowance, true
+}
+
+// GetAllAccountAllowances returns the allowances of all interchain accounts for all constrained sdk message types
+func (k Keeper) GetAllAccountAllowances(ctx sdk.Context) []types.AccountAllowance {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.AccountAllowancePrefix+"/"))
+	defer iterator.Close()
+
+	var allowances []types.AccountAllowance
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.AccountAllowance
+		k.cdc.MustUnmarshal(iterator.Value(), &allowance)
+
+		allowances = append(allowances, allowance)
+	}
+
+	return allowances
+}
+
+// SetAccountAllowance stores the allowance, keyed by its sdk message type and interchain account address
+func (k Keeper) SetAccountAllowance(ctx sdk.Context, allowance types.AccountAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAccountAllowance(allowance.MsgTypeUrl, allowance.Address), k.cdc.MustMarshal(&allowance))
+}
+
+// DeleteAccountAllowances removes the allowances of all interchain accounts for the given sdk message type
+func (k Keeper) DeleteAccountAllowances(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyAccountAllowancePrefix(msgTypeURL))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetMsgConstraintAndResetAllowances stores the constraint and removes the allowances of all interchain accounts
+// for its sdk message type, thus the allowances restart from the new authorization
+func (k Keeper) SetMsgConstraintAndResetAllowances(ctx sdk.Context, constraint types.MsgConstraint) error {
+	if err := constraint.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.SetMsgConstraint(ctx, constraint)
+	k.DeleteAccountAllowances(ctx, constraint.MsgTypeURL())
+
+	return nil
+}
+
+// RemoveMsgConstraint removes the constraint for the given sdk message type and the allowances of all interchain
+// accounts for the message type. An error is returned if the message type is not constrained.
+func (k Keeper) RemoveMsgConstraint(ctx sdk.Context, msgTypeURL string) error {
+	if _, found := k.GetMsgConstraint(ctx, msgTypeURL); !found {
+		return sdkerrors.Wrapf(types.ErrMsgConstraintNotFound, "message type: %s", msgTypeURL)
+	}
+
+	k.DeleteMsgConstraint(ctx, msgTypeURL)
+	k.DeleteAccountAllowances(ctx, msgTypeURL)
+
+	return nil
+}
+
+// GetCurrentAllowance returns the allowance of the given interchain account for the current window of the given
+// constraint. The initial authorization of the constraint is returned if the interchain account has not used its
+// allowance or if the window of the stored allowance has ended, in which case the returned window start is zero.
+func (k Keeper) GetCurrentAllowance(ctx sdk.Context, constraint types.MsgConstraint, address string) types.AccountAllowance {
+	msgTypeURL := constraint.MsgTypeURL()
+
+	allowance, found := k.GetAccountAllowance(ctx, msgTypeURL, address)
+	if found {
+		windowEnd, ends := constraint.WindowEnd(allowance.WindowStart)
+		if !ends || ctx.BlockTime().Before(windowEnd) {
+			return allowance
+		}
+	}
+
+	return types.AccountAllowance{
+		Address:       address,
+		MsgTypeUrl:    msgTypeURL,
+		Authorization: constraint.Authorization,
+	}
+}
+
+// acceptMsg evaluates the constraint of the sdk message type, if any, for the interchain account signing the
+// message. The remaining allowance of the interchain account is updated if the message is accepted.
+func (k Keeper) acceptMsg(ctx sdk.Context, msg sdk.Msg) error {
+	msgTypeURL := sdk.MsgTypeURL(msg)
+
+	constraint, found := k.GetMsgConstraint(ctx, msgTypeURL)
+	if !found {
+		return nil
+	}
+
+	// the signers of the message are authenticated to be the interchain account
+	address := msg.GetSigners()[0].String()
+
+	allowance := k.GetCurrentAllowance(ctx, constraint, address)
+	if allowance.IsExhausted() {
+		return sdkerrors.Wrapf(types.ErrAllowanceExhausted, "message type: %s", msgTypeURL)
+	}
+
+	windowStart := allowance.WindowStart
+	if windowStart.IsZero() {
+		windowStart = ctx.BlockTime()
+	}
+
+	resp, err := acceptAuthorization(ctx, allowance.GetAuthorization(), msg)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message constraint not satisfied: %s", err)
+	}
+
+	if !resp.Accept {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message constraint not satisfied for message type: %s", msgTypeURL)
+	}
+
+	remaining := allowance.GetAuthorization()
+	switch {
+	case resp.Delete:
+		remaining = nil
+	case resp.Updated != nil:
+		remaining = resp.Updated
+	}
+
+	updated, err := types.NewAccountAllowance(address, msgTypeURL, remaining, windowStart)
+	if err != nil {
+		return err
+	}
+
+	k.SetAccountAllowance(ctx, updated)
+
+	return nil
+}
+
+// acceptAuthorization calls Accept on the authorization, returning panics other than out of gas as errors as some
+// authorizations panic on unexpected amounts
+func acceptAuthorization(ctx sdk.Context, authorization authz.Authorization, msg sdk.Msg) (_ authz.AcceptResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			err = fmt.Errorf("authorization rejected message: %v", r)
+		}
+	}()
+
+	if authorization == nil {
+		return authz.AcceptResponse{}, fmt.Errorf("invalid authorization")
+	}
+
+	return authorization.Accept(ctx, msg)
+}
+
+// windowEnd returns the end time of the current window of the allowance, false if the window has not started or
+// never ends
+func windowEnd(constraint types.MsgConstraint, allowance types.AccountAllowance) (time.Time, bool) {
+	if allowance.WindowStart.IsZero() {
+		return time.Time{}, false
+	}
+
+	return constraint.WindowEnd(allowance.WindowStart)
+}
@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketMsgConstraints() {
+	var (
+		path                  *ibctesting.Path
+		interchainAccountAddr string
+		msgs                  []sdk.Msg
+	)
+
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expErr       error
+		expExhausted bool
+		expRemaining authz.Authorization
+	}{
+		{
+			"success: message type is not constrained", func() {}, nil, false, nil,
+		},
+		{
+			"success: within spend limit", func() {
+				suite.setMsgConstraint(types.NewSendAuthorization(coins(1000), nil), 24)
+			}, nil, false, types.NewSendAuthorization(coins(700), nil),
+		},
+		{
+			"success: spend limit is used up", func() {
+				suite.setMsgConstraint(types.NewSendAuthorization(coins(300), nil), 24)
+			}, nil, true, nil,
+		},
+		{
+			"success: recipient is allowed", func() {
+				suite.setMsgConstraint(types.NewSendAuthorization(nil, []string{suite.chainB.SenderAccount.GetAddress().String()}), 24)
+			}, nil, false, nil,
+		},
+		{
+			"success: validator is allowed", func() {
+				validator := sdk.ValAddress(suite.chainB.Vals.Validators[0].Address)
+				stakeAuthorization, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{validator}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+				suite.Require().NoError(err)
+				suite.setMsgConstraint(stakeAuthorization, 0)
+
+				msgs = []sdk.Msg{
+					&stakingtypes.MsgDelegate{
+						DelegatorAddress: interchainAccountAddr,
+						ValidatorAddress: validator.String(),
+						Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+					},
+				}
+			}, nil, false, nil,
+		},
+		{
+			"failure: spend limit exceeded", func() {
+				suite.setMsgConstraint(types.NewSendAuthorization(coins(250), nil), 24)
+			}, sdkerrors.ErrUnauthorized, false, nil,
+		},
+		{
+			"failure: recipient is not allowed", func() {
+				suite.setMsgConstraint(types.NewSendAuthorization(coins(1000), []string{ibctesting.TestAccAddress}), 24)
+			}, sdkerrors.ErrUnauthorized, false, nil,
+		},
+		{
+			"failure: validator is not allowed", func() {
+				stakeAuthorization, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{sdk.ValAddress(ibctesting.TestAccAddress)}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+				suite.Require().NoError(err)
+				suite.setMsgConstraint(stakeAuthorization, 0)
+
+				msgs = []sdk.Msg{
+					&stakingtypes.MsgDelegate{
+						DelegatorAddress: interchainAccountAddr,
+						ValidatorAddress: sdk.ValAddress(suite.chainB.Vals.Validators[0].Address).String(),
+						Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+					},
+				}
+			}, sdkerrors.ErrUnauthorized, false, nil,
+		},
+		{
+			"failure: allowance is exhausted", func() {
+				suite.setMsgConstraint(types.NewSendAuthorization(coins(1000), nil), 24)
+
+				allowance, err := types.NewAccountAllowance(interchainAccountAddr, sdk.MsgTypeURL(&banktypes.MsgSend{}), nil, suite.chainB.GetContext().BlockTime())
+				suite.Require().NoError(err)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAccountAllowance(suite.chainB.GetContext(), allowance)
+			}, types.ErrAllowanceExhausted, false, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var found bool
+			interchainAccountAddr, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, coins(10000))
+
+			msgs = []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      coins(100),
+				},
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      coins(200),
+				},
+			}
+
+			params := types.NewParams(true, []string{"*"}, nil, nil, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate() // malleate mutates test data
+
+			_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), suite.newTxPacket(path, msgs))
+
+			msgTypeURL := sdk.MsgTypeURL(msgs[0])
+			allowance, found := suite.chainB.GetSimApp().ICAHostKeeper.GetAccountAllowance(suite.chainB.GetContext(), msgTypeURL, interchainAccountAddr)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			_, constrained := suite.chainB.GetSimApp().ICAHostKeeper.GetMsgConstraint(suite.chainB.GetContext(), msgTypeURL)
+			suite.Require().Equal(constrained, found)
+			if !constrained {
+				return
+			}
+
+			suite.Require().Equal(suite.chainB.GetContext().BlockTime(), allowance.WindowStart)
+			suite.Require().Equal(tc.expExhausted, allowance.IsExhausted())
+			if tc.expRemaining != nil {
+				suite.Require().Equal(tc.expRemaining, allowance.GetAuthorization())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgConstraintWindow() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)))
+
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.setMsgConstraint(types.NewSendAuthorization(spendLimit, nil), 1)
+
+	packet := suite.newTxPacket(path, []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: interchainAccountAddr,
+			ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+			Amount:      spendLimit,
+		},
+	})
+
+	req := &types.QueryAllowanceRequest{Address: interchainAccountAddr, MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	ctx := suite.chainB.GetContext()
+
+	// the allowance of an interchain account which has not used it is the authorization of the constraint
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.Allowance(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Exhausted)
+	suite.Require().Nil(res.WindowStart)
+	suite.Require().Nil(res.WindowEnd)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+	suite.Require().NoError(err)
+
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.Allowance(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Exhausted)
+	suite.Require().Nil(res.Remaining)
+	suite.Require().Equal(ctx.BlockTime(), *res.WindowStart)
+	suite.Require().Equal(ctx.BlockTime().Add(time.Hour), *res.WindowEnd)
+
+	// the allowance is exhausted until the end of the window
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour-time.Second)), packet)
+	suite.Require().ErrorIs(err, types.ErrAllowanceExhausted)
+
+	nextWindowCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.Allowance(sdk.WrapSDKContext(nextWindowCtx), req)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Exhausted)
+
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(nextWindowCtx, packet)
+	suite.Require().NoError(err)
+
+	allowance, found := suite.chainB.GetSimApp().ICAHostKeeper.GetAccountAllowance(ctx, req.MsgTypeUrl, interchainAccountAddr)
+	suite.Require().True(found)
+	suite.Require().True(allowance.IsExhausted())
+	suite.Require().Equal(nextWindowCtx.BlockTime(), allowance.WindowStart)
+
+	// the allowances are reset when the constraint is replaced
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.SetMsgConstraintAndResetAllowances(ctx, suite.chainB.GetSimApp().ICAHostKeeper.GetAllMsgConstraints(ctx)[0]))
+	_, found = suite.chainB.GetSimApp().ICAHostKeeper.GetAccountAllowance(ctx, req.MsgTypeUrl, interchainAccountAddr)
+	suite.Require().False(found)
+
+	// the allowance query fails for a message type without constraint
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.RemoveMsgConstraint(ctx, req.MsgTypeUrl))
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.Allowance(sdk.WrapSDKContext(ctx), req)
+	suite.Require().Error(err)
+	suite.Require().Error(suite.chainB.GetSimApp().ICAHostKeeper.RemoveMsgConstraint(ctx, req.MsgTypeUrl))
+}
+
+func (suite *KeeperTestSuite) setMsgConstraint(authorization authz.Authorization, durationHours uint64) {
+	constraint, err := types.NewMsgConstraint(authorization, durationHours)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.SetMsgConstraintAndResetAllowances(suite.chainB.GetContext(), constraint))
+}
+
+func (suite *KeeperTestSuite) newTxPacket(path *ibctesting.Path, msgs []sdk.Msg) channeltypes.Packet {
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	return channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		suite.chainA.SenderAccount.GetSequence(),
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
@@ -27,3 +29,32 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// EmitMsgExecutedEvent emits an event signalling the successful or failed execution of the message at the provided
+// index of an interchain accounts transaction, including the gas consumed by the message and the error details if any.
+func EmitMsgExecutedEvent(ctx sdk.Context, packet exported.PacketI, msgIndex int, msg sdk.Msg, gasUsed uint64, err error) {
+	var errorMsg string
+	if err != nil {
+		errorMsg = err.Error()
+	}
+
+	signers := make([]string, len(msg.GetSigners()))
+	for i, signer := range msg.GetSigners() {
+		signers[i] = signer.String()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMsgExecuted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyPacketSequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyMsgIndex, fmt.Sprintf("%d", msgIndex)),
+			sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(msg)),
+			sdk.NewAttribute(types.AttributeKeySigners, strings.Join(signers, ",")),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			sdk.NewAttribute(icatypes.AttributeKeyAckError, errorMsg),
+		),
+	)
+}
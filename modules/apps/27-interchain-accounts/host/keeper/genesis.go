@@ -5,12 +5,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	genesistypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // InitGenesis initializes the interchain accounts host application state from a provided genesis state
-func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.HostGenesisState) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisState) {
 	if !keeper.IsBound(ctx, state.Port) {
 		cap := keeper.BindPort(ctx, state.Port)
 		if err := keeper.ClaimCapability(ctx, cap, host.PortPath(state.Port)); err != nil {
@@ -27,14 +28,34 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.HostGenesisState
 	}
 
 	keeper.SetParams(ctx, state.Params)
+
+	for _, constraint := range state.MsgConstraints {
+		keeper.SetMsgConstraint(ctx, constraint)
+	}
+
+	for _, allowance := range state.AccountAllowances {
+		keeper.SetAccountAllowance(ctx, allowance)
+	}
+
+	for _, quota := range state.ExecutionQuotas {
+		keeper.SetExecutionQuota(ctx, quota)
+	}
+
+	for _, usage := range state.QuotaUsages {
+		keeper.SetQuotaUsage(ctx, usage)
+	}
 }
 
 // ExportGenesis returns the interchain accounts host exported genesis
-func ExportGenesis(ctx sdk.Context, keeper Keeper) icatypes.HostGenesisState {
-	return icatypes.NewHostGenesisState(
+func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.HostGenesisState {
+	return genesistypes.NewHostGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.PortID,
 		keeper.GetParams(ctx),
+		keeper.GetAllMsgConstraints(ctx),
+		keeper.GetAllAccountAllowances(ctx),
+		keeper.GetAllExecutionQuotas(ctx),
+		keeper.GetAllQuotaUsages(ctx),
 	)
 }
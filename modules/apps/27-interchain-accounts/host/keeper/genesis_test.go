@@ -1,6 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	genesistypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -11,22 +16,36 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest()
 
 	interchainAccAddr := icatypes.GenerateUniqueAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
-	genesisState := icatypes.HostGenesisState{
-		ActiveChannels: []icatypes.ActiveChannel{
+
+	constraint, err := types.NewMsgConstraint(types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), nil), 24)
+	suite.Require().NoError(err)
+
+	allowance, err := types.NewAccountAllowance(interchainAccAddr.String(), constraint.MsgTypeURL(), nil, time.Unix(1000, 0).UTC())
+	suite.Require().NoError(err)
+
+	quota := types.NewExecutionQuota(ibctesting.FirstConnectionID, 100, types.NewQuotaLimits(10, 0), types.NewQuotaLimits(0, 1000000))
+	usage := types.NewQuotaUsage(ibctesting.FirstConnectionID, interchainAccAddr.String(), 5, 1, 50000)
+
+	genesisState := genesistypes.HostGenesisState{
+		ActiveChannels: []genesistypes.ActiveChannel{
 			{
 				ConnectionId: ibctesting.FirstConnectionID,
 				PortId:       TestPortID,
 				ChannelId:    ibctesting.FirstChannelID,
 			},
 		},
-		InterchainAccounts: []icatypes.RegisteredInterchainAccount{
+		InterchainAccounts: []genesistypes.RegisteredInterchainAccount{
 			{
 				ConnectionId:   ibctesting.FirstConnectionID,
 				PortId:         TestPortID,
 				AccountAddress: interchainAccAddr.String(),
 			},
 		},
-		Port: icatypes.PortID,
+		Port:              icatypes.PortID,
+		MsgConstraints:    []types.MsgConstraint{constraint},
+		AccountAllowances: []types.AccountAllowance{allowance},
+		ExecutionQuotas:   []types.ExecutionQuota{quota},
+		QuotaUsages:       []types.QuotaUsage{usage},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -39,9 +58,25 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
-	expParams := types.NewParams(false, nil)
+	expParams := types.NewParams(false, nil, nil, nil, 0)
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
+
+	storedConstraint, found := suite.chainA.GetSimApp().ICAHostKeeper.GetMsgConstraint(suite.chainA.GetContext(), constraint.MsgTypeURL())
+	suite.Require().True(found)
+	suite.Require().Equal(constraint.GetAuthorization(), storedConstraint.GetAuthorization())
+
+	storedAllowance, found := suite.chainA.GetSimApp().ICAHostKeeper.GetAccountAllowance(suite.chainA.GetContext(), constraint.MsgTypeURL(), interchainAccAddr.String())
+	suite.Require().True(found)
+	suite.Require().Equal(allowance, storedAllowance)
+
+	storedQuota, found := suite.chainA.GetSimApp().ICAHostKeeper.GetExecutionQuota(suite.chainA.GetContext(), ibctesting.FirstConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(quota, storedQuota)
+
+	storedUsage, found := suite.chainA.GetSimApp().ICAHostKeeper.GetQuotaUsage(suite.chainA.GetContext(), ibctesting.FirstConnectionID, interchainAccAddr.String())
+	suite.Require().True(found)
+	suite.Require().Equal(usage, storedUsage)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
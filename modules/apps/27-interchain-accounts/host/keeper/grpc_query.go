@@ -4,8 +4,12 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +23,138 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(goCtx context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the active channels of interchain accounts are bound to the host port identifier
+	interchainAccounts, pageRes, err := icatypes.PaginateInterchainAccounts(
+		ctx, ctx.KVStore(q.storeKey), q.channelKeeper, func(string) string { return icatypes.PortID },
+		req.OwnerPrefix, req.ConnectionId, req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// AllowMessages implements the Query/AllowMessages gRPC method
+func (q Keeper) AllowMessages(c context.Context, req *types.QueryAllowMessagesRequest) (*types.QueryAllowMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowMsgs, connectionSpecific := q.GetAllowMessagesForConnection(ctx, req.ConnectionId)
+
+	return &types.QueryAllowMessagesResponse{
+		AllowMessages:      allowMsgs,
+		ConnectionSpecific: connectionSpecific,
+	}, nil
+}
+
+// MsgConstraints implements the Query/MsgConstraints gRPC method
+func (q Keeper) MsgConstraints(c context.Context, _ *types.QueryMsgConstraintsRequest) (*types.QueryMsgConstraintsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMsgConstraintsResponse{
+		MsgConstraints: q.GetAllMsgConstraints(ctx),
+	}, nil
+}
+
+// Allowance implements the Query/Allowance gRPC method
+func (q Keeper) Allowance(c context.Context, req *types.QueryAllowanceRequest) (*types.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	constraint, found := q.GetMsgConstraint(ctx, req.MsgTypeUrl)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "message constraint not found for message type: %s", req.MsgTypeUrl)
+	}
+
+	allowance := q.GetCurrentAllowance(ctx, constraint, req.Address)
+	res := &types.QueryAllowanceResponse{
+		MsgConstraint: constraint,
+		Remaining:     allowance.Authorization,
+		Exhausted:     allowance.IsExhausted(),
+	}
+
+	if !allowance.WindowStart.IsZero() {
+		res.WindowStart = &allowance.WindowStart
+	}
+
+	if end, ok := windowEnd(constraint, allowance); ok {
+		res.WindowEnd = &end
+	}
+
+	return res, nil
+}
+
+// ExecutionQuotas implements the Query/ExecutionQuotas gRPC method
+func (q Keeper) ExecutionQuotas(c context.Context, _ *types.QueryExecutionQuotasRequest) (*types.QueryExecutionQuotasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryExecutionQuotasResponse{
+		ExecutionQuotas: q.GetAllExecutionQuotas(ctx),
+	}, nil
+}
+
+// QuotaUsage implements the Query/QuotaUsage gRPC method
+func (q Keeper) QuotaUsage(c context.Context, req *types.QueryQuotaUsageRequest) (*types.QueryQuotaUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	quota, found := q.GetExecutionQuota(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "execution quota not found for connection: %s", req.ConnectionId)
+	}
+
+	res := &types.QueryQuotaUsageResponse{
+		ExecutionQuota:  quota,
+		ConnectionUsage: q.GetCurrentQuotaUsage(ctx, quota, ""),
+	}
+
+	if req.Address != "" {
+		accountUsage := q.GetCurrentQuotaUsage(ctx, quota, req.Address)
+		res.AccountUsage = &accountUsage
+	}
+
+	return res, nil
+}
@@ -2,8 +2,12 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +16,183 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryAllowMessages() {
+	var (
+		req          *types.QueryAllowMessagesRequest
+		expAllowMsgs []string
+		expSpecific  bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: allow messages param",
+			func() {
+				req = &types.QueryAllowMessagesRequest{ConnectionId: ibctesting.FirstConnectionID}
+				expAllowMsgs = []string{"/cosmos.bank.v1beta1.MsgSend"}
+			},
+			true,
+		},
+		{
+			"success: connection allowlist",
+			func() {
+				req = &types.QueryAllowMessagesRequest{ConnectionId: "connection-1"}
+				expAllowMsgs = []string{"/cosmos.gov.v1beta1.*", "/cosmos.staking.v1beta1.*"}
+				expSpecific = true
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req = &types.QueryAllowMessagesRequest{ConnectionId: ""}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expSpecific = false
+
+			params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, []types.ConnectionAllowMessages{
+				types.NewConnectionAllowMessages("connection-1", []string{"/cosmos.gov.v1beta1.*", "/cosmos.staking.v1beta1.*"}),
+			}, 0)
+			suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.AllowMessages(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAllowMsgs, res.AllowMessages)
+				suite.Require().Equal(expSpecific, res.ConnectionSpecific)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req         *types.QueryInterchainAccountsRequest
+		expAccounts int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expAccounts = 2
+			},
+			true,
+		},
+		{
+			"success: filter by connection",
+			func() {
+				req.ConnectionId = ibctesting.FirstConnectionID
+				expAccounts = 1
+			},
+			true,
+		},
+		{
+			"success: filter by owner prefix",
+			func() {
+				req.OwnerPrefix = TestOwnerAddress[:10]
+				expAccounts = 1
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expAccounts = 1
+			},
+			true,
+		},
+		{
+			"success: no matching accounts",
+			func() {
+				req.ConnectionId = "connection-100"
+				expAccounts = 0
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid|connection"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// an interchain account without an active channel registered for another owner on another connection
+			otherPortID, err := icatypes.NewControllerPortID("other-owner")
+			suite.Require().NoError(err)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), "connection-1", otherPortID, suite.chainB.SenderAccount.GetAddress().String())
+
+			req = &types.QueryInterchainAccountsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainB.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.InterchainAccounts, expAccounts)
+
+				for _, account := range res.InterchainAccounts {
+					if account.PortId == TestPortID {
+						suite.Require().Equal(TestOwnerAddress, account.Owner)
+						suite.Require().Equal(path.EndpointB.ChannelID, account.ActiveChannelId)
+						suite.Require().Equal(channeltypes.OPEN, account.ActiveChannelState)
+					} else {
+						suite.Require().Equal("other-owner", account.Owner)
+						suite.Require().Empty(account.ActiveChannelId)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
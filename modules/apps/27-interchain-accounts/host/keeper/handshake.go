@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -26,8 +27,8 @@ func (k Keeper) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
+	if err := icatypes.ValidateChannelOrdering(order); err != nil {
+		return "", err
 	}
 
 	if portID != icatypes.PortID {
@@ -54,6 +55,10 @@ func (k Keeper) OnChanOpenTry(
 			return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is already OPEN", activeChannelID, portID)
 		}
 
+		if channel.Ordering != order {
+			return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "previous active channel ordering does not match provided ordering: expected %s, got %s", channel.Ordering, order)
+		}
+
 		if !icatypes.IsPreviousMetadataEqual(channel.Version, metadata) {
 			return "", sdkerrors.Wrap(icatypes.ErrInvalidVersion, "previous active channel metadata does not match provided version")
 		}
@@ -114,6 +119,51 @@ func (k Keeper) OnChanOpenConfirm(
 	return nil
 }
 
+// OnChanUpgradeTry performs validation of the counterparty proposed interchain accounts channel upgrade.
+// The channel order may not change, the connection hops may not change and the interchain account
+// address included in the metadata must match the address registered for the channel.
+func (k Keeper) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	if portID != icatypes.PortID {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.PortID, portID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(counterpartyVersion), &metadata); err != nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	if order != channel.Ordering {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "interchain accounts channel ordering cannot be upgraded: expected %s, got %s", channel.Ordering, order)
+	}
+
+	if !reflect.DeepEqual(channel.ConnectionHops, connectionHops) {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidUpgrade, "interchain accounts channel connection hops cannot be upgraded: expected %s, got %s", channel.ConnectionHops, connectionHops)
+	}
+
+	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+		return "", err
+	}
+
+	interchainAccAddr, found := k.GetInterchainAccountAddress(ctx, connectionHops[0], channel.Counterparty.PortId)
+	if !found || interchainAccAddr != metadata.Address {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "interchain account address cannot be upgraded: expected %s, got %s", interchainAccAddr, metadata.Address)
+	}
+
+	return counterpartyVersion, nil
+}
+
 // OnChanCloseConfirm removes the active channel stored in state
 func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
@@ -158,10 +158,31 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			}, false,
 		},
 		{
-			"invalid order - UNORDERED",
+			"success: UNORDERED channel",
 			func() {
 				channel.Ordering = channeltypes.UNORDERED
 			},
+			true,
+		},
+		{
+			"invalid order - unspecified",
+			func() {
+				channel.Ordering = channeltypes.NONE
+			},
+			false,
+		},
+		{
+			"invalid order - previous active channel ordering is different",
+			func() {
+				// undo setup
+				path.EndpointB.ChannelID = ""
+				err := suite.chainB.App.GetScopedIBCKeeper().ReleaseCapability(suite.chainB.GetContext(), chanCap)
+				suite.Require().NoError(err)
+
+				suite.openAndCloseChannel(path)
+
+				channel.Ordering = channeltypes.UNORDERED
+			},
 			false,
 		},
 		{
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator. The keeper may be nil if the host submodule is not enabled
+// in the application, in which case the migrations are a no-op.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateParams sets the allowlist of query paths introduced to the host parameters to its default.
+// The existing host parameters are retained.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	m.keeper.paramSpace.Set(ctx, types.KeyAllowQueries, []string(nil))
+
+	m.keeper.Logger(ctx).Info("successfully migrated host params", "allow queries", []string(nil))
+	return nil
+}
+
+// MigrateAllowMessages sets the connection allowlists introduced to the host parameters to their default
+// and removes the entries of the allow messages parameter which are invalid under the wildcard semantics.
+// Prior to the introduction of wildcards such entries never matched a message type, thus the set of
+// allowed message types is unchanged.
+func (m Migrator) MigrateAllowMessages(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	allowMsgs := migrateAllowMessages(m.keeper.GetAllowMessages(ctx))
+
+	params := types.NewParams(m.keeper.IsHostEnabled(ctx), allowMsgs, m.keeper.GetAllowQueries(ctx), nil, types.DefaultMaxGasPerPacket)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+
+	m.keeper.Logger(ctx).Info("successfully migrated host allow messages", "allow messages", params.AllowMessages)
+	return nil
+}
+
+// MigrateMaxGasPerPacket sets the max gas per packet introduced to the host parameters to its default.
+// The existing host parameters are retained.
+func (m Migrator) MigrateMaxGasPerPacket(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxGasPerPacket, uint64(types.DefaultMaxGasPerPacket))
+
+	m.keeper.Logger(ctx).Info("successfully migrated host params", "max gas per packet", types.DefaultMaxGasPerPacket)
+	return nil
+}
+
+// migrateAllowMessages returns the valid and unique entries of the provided allowlist. The "*" wildcard
+// is only retained if it is the sole entry, as it previously only allowed all message types in that case.
+func migrateAllowMessages(allowMsgs []string) []string {
+	if len(allowMsgs) == 1 && allowMsgs[0] == types.AllowAllMessages {
+		return allowMsgs
+	}
+
+	var migrated []string
+	seen := make(map[string]bool)
+	for _, typeURL := range allowMsgs {
+		if strings.Contains(typeURL, types.AllowAllMessages) || types.ValidateAllowMessage(typeURL) != nil || seen[typeURL] {
+			continue
+		}
+
+		migrated = append(migrated, typeURL)
+		seen[typeURL] = true
+	}
+
+	return migrated
+}
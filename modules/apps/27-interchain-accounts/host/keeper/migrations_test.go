@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, types.NewParams(false, []string{"*"}, nil, nil, 0))
+
+	// remove the parameters introduced in consensus version 2 to mimic the previous parameter set
+	paramsStore := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+	paramsStore.Delete(types.KeyAllowQueries)
+
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx)
+	})
+
+	migrator := keeper.NewMigrator(&suite.chainA.GetSimApp().ICAHostKeeper)
+	suite.Require().NoError(migrator.MigrateParams(ctx))
+
+	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx)
+	suite.Require().Equal(types.NewParams(false, []string{"*"}, nil, nil, 0), params)
+
+	// migrations are a no-op if the host submodule is not enabled
+	suite.Require().NoError(keeper.NewMigrator(nil).MigrateParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrateAllowMessages() {
+	testCases := []struct {
+		name         string
+		allowMsgs    string
+		expAllowMsgs []string
+	}{
+		{
+			"sole wildcard is retained",
+			`["*"]`,
+			[]string{"*"},
+		},
+		{
+			"message types are retained",
+			`["/cosmos.bank.v1beta1.MsgSend","/cosmos.staking.v1beta1.MsgDelegate"]`,
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"},
+		},
+		{
+			"wildcard with message types is removed",
+			`["*","/cosmos.bank.v1beta1.MsgSend"]`,
+			[]string{"/cosmos.bank.v1beta1.MsgSend"},
+		},
+		{
+			"prefix wildcards, invalid and duplicate message types are removed",
+			`["/cosmos.bank.v1beta1.*","cosmos.bank.v1beta1.MsgSend","/cosmos.bank.v1beta1.MsgSend","/cosmos.bank.v1beta1.MsgSend"]`,
+			[]string{"/cosmos.bank.v1beta1.MsgSend"},
+		},
+		{
+			"empty allowlist",
+			`[]`,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+
+			// set the allow messages and remove the parameters introduced in consensus version 3 to mimic the previous parameter set
+			paramsStore := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+			paramsStore.Set(types.KeyAllowMessages, []byte(tc.allowMsgs))
+			paramsStore.Delete(types.KeyConnectionAllowMessages)
+
+			suite.Require().Panics(func() {
+				suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx)
+			})
+
+			migrator := keeper.NewMigrator(&suite.chainA.GetSimApp().ICAHostKeeper)
+			suite.Require().NoError(migrator.MigrateAllowMessages(ctx))
+
+			params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx)
+			suite.Require().Equal(types.NewParams(types.DefaultHostEnabled, tc.expAllowMsgs, nil, nil, 0), params)
+		})
+	}
+
+	// migrations are a no-op if the host submodule is not enabled
+	suite.Require().NoError(keeper.NewMigrator(nil).MigrateAllowMessages(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestMigrateMaxGasPerPacket() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(ctx, types.NewParams(false, []string{"*"}, nil, nil, 0))
+
+	// remove the parameters introduced in consensus version 4 to mimic the previous parameter set
+	paramsStore := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+	paramsStore.Delete(types.KeyMaxGasPerPacket)
+
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx)
+	})
+
+	migrator := keeper.NewMigrator(&suite.chainA.GetSimApp().ICAHostKeeper)
+	suite.Require().NoError(migrator.MigrateMaxGasPerPacket(ctx))
+
+	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx)
+	suite.Require().Equal(types.NewParams(false, []string{"*"}, nil, nil, types.DefaultMaxGasPerPacket), params)
+
+	// migrations are a no-op if the host submodule is not enabled
+	suite.Require().NoError(keeper.NewMigrator(nil).MigrateMaxGasPerPacket(ctx))
+}
@@ -21,9 +21,37 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetAllowQueries retrieves the gRPC query paths allowed to be executed on the host from the paramstore
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetConnectionAllowMessages retrieves the connection specific msg types allowlists from the paramstore
+func (k Keeper) GetConnectionAllowMessages(ctx sdk.Context) []types.ConnectionAllowMessages {
+	var res []types.ConnectionAllowMessages
+	k.paramSpace.Get(ctx, types.KeyConnectionAllowMessages, &res)
+	return res
+}
+
+// GetMaxGasPerPacket retrieves the maximum amount of gas which may be consumed by the execution of a single
+// interchain accounts packet from the paramstore. Zero is returned if the packet execution is not limited.
+func (k Keeper) GetMaxGasPerPacket(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxGasPerPacket, &res)
+	return res
+}
+
+// GetAllowMessagesForConnection retrieves the msg types allowed to be executed by interchain accounts controlled
+// through the provided connection. The returned boolean is true if the allowlist is connection specific.
+func (k Keeper) GetAllowMessagesForConnection(ctx sdk.Context, connectionID string) ([]string, bool) {
+	return k.GetParams(ctx).AllowMessagesForConnection(connectionID)
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx), k.GetAllowQueries(ctx), k.GetConnectionAllowMessages(ctx), k.GetMaxGasPerPacket(ctx))
 }
 
 // SetParams sets the total set of the host submodule parameters.
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// GetExecutionQuota retrieves the execution quota of the given connection
+func (k Keeper) GetExecutionQuota(ctx sdk.Context, connectionID string) (types.ExecutionQuota, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyExecutionQuota(connectionID))
+	if bz == nil {
+		return types.ExecutionQuota{}, false
+	}
+
+	var quota types.ExecutionQuota
+	k.cdc.MustUnmarshal(bz, &quota)
+
+	return quota, true
+}
+
+// GetAllExecutionQuotas returns the execution quotas of all connections
+func (k Keeper) GetAllExecutionQuotas(ctx sdk.Context) []types.ExecutionQuota {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.ExecutionQuotaPrefix+"/"))
+	defer iterator.Close()
+
+	var quotas []types.ExecutionQuota
+	for ; iterator.Valid(); iterator.Next() {
+		var quota types.ExecutionQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &quota)
+
+		quotas = append(quotas, quota)
+	}
+
+	return quotas
+}
+
+// SetExecutionQuota stores the execution quota, keyed by its connection identifier
+func (k Keeper) SetExecutionQuota(ctx sdk.Context, quota types.ExecutionQuota) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyExecutionQuota(quota.ConnectionId), k.cdc.MustMarshal(&quota))
+}
+
+// DeleteExecutionQuota removes the execution quota of the given connection
+func (k Keeper) DeleteExecutionQuota(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyExecutionQuota(connectionID))
+}
+
+// GetQuotaUsage retrieves the usage of the execution quota of the given connection by the given interchain account,
+// or by all interchain accounts controlled through the connection if the address is empty
+func (k Keeper) GetQuotaUsage(ctx sdk.Context, connectionID, address string) (types.QuotaUsage, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyQuotaUsage(connectionID, address))
+	if bz == nil {
+		return types.QuotaUsage{}, false
+	}
+
+	var usage types.QuotaUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+
+	return usage, true
+}
+
+// GetAllQuotaUsages returns the usages of the execution quotas of all connections
+func (k Keeper) GetAllQuotaUsages(ctx sdk.Context) []types.QuotaUsage {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.QuotaUsagePrefix+"/"))
+	defer iterator.Close()
+
+	var usages []types.QuotaUsage
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.QuotaUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
+
+// SetQuotaUsage stores the usage, keyed by its connection identifier and interchain account address
+func (k Keeper) SetQuotaUsage(ctx sdk.Context, usage types.QuotaUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyQuotaUsage(usage.ConnectionId, usage.Address), k.cdc.MustMarshal(&usage))
+}
+
+// DeleteQuotaUsages removes the usages of the execution quota of the given connection
+func (k Keeper) DeleteQuotaUsages(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyQuotaUsagePrefix(connectionID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetExecutionQuotaAndResetUsages stores the execution quota and removes the usages of the quota of its connection,
+// thus the usages restart from a new window
+func (k Keeper) SetExecutionQuotaAndResetUsages(ctx sdk.Context, quota types.ExecutionQuota) error {
+	if err := quota.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.SetExecutionQuota(ctx, quota)
+	k.DeleteQuotaUsages(ctx, quota.ConnectionId)
+
+	return nil
+}
+
+// RemoveExecutionQuota removes the execution quota of the given connection and its usages. An error is returned if
+// the connection has no execution quota.
+func (k Keeper) RemoveExecutionQuota(ctx sdk.Context, connectionID string) error {
+	if _, found := k.GetExecutionQuota(ctx, connectionID); !found {
+		return sdkerrors.Wrapf(types.ErrQuotaNotFound, "connection: %s", connectionID)
+	}
+
+	k.DeleteExecutionQuota(ctx, connectionID)
+	k.DeleteQuotaUsages(ctx, connectionID)
+
+	return nil
+}
+
+// GetCurrentQuotaUsage returns the usage of the execution quota by the given interchain account, or by all interchain
+// accounts controlled through the connection if the address is empty, within the current window. An empty usage with
+// a window start height of zero is returned if the quota has not been used or if the window of the stored usage has
+// ended.
+func (k Keeper) GetCurrentQuotaUsage(ctx sdk.Context, quota types.ExecutionQuota, address string) types.QuotaUsage {
+	usage, found := k.GetQuotaUsage(ctx, quota.ConnectionId, address)
+	if found && ctx.BlockHeight() < quota.WindowEnd(usage.WindowStartHeight) {
+		return usage
+	}
+
+	return types.NewQuotaUsage(quota.ConnectionId, address, 0, 0, 0)
+}
+
+// TrackQuotaUsage performs the receipt of the given packet using recvPacket and records its execution and the gas
+// consumed by its receipt in the usages of the execution quota of its connection, if any. It must be called with a
+// context whose state changes are written regardless of the acknowledgement, such that packets failing with an error
+// acknowledgement count towards the quota. The usage is not recorded if the quota does not allow the execution of
+// the packet, as OnRecvPacket rejects it without executing it.
+func (k Keeper) TrackQuotaUsage(ctx sdk.Context, packet channeltypes.Packet, recvPacket func(sdk.Context) ibcexported.Acknowledgement) ibcexported.Acknowledgement {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return recvPacket(ctx)
+	}
+
+	quota, found := k.GetExecutionQuota(ctx, channel.ConnectionHops[0])
+	if !found {
+		return recvPacket(ctx)
+	}
+
+	address, _ := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], packet.GetSourcePort())
+	if _, err := k.checkExecutionQuota(ctx, quota, address); err != nil {
+		return recvPacket(ctx)
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumedToLimit()
+	ack := recvPacket(ctx)
+	k.consumeExecutionQuota(ctx, quota, address, ctx.GasMeter().GasConsumedToLimit()-gasBefore)
+
+	return ack
+}
+
+// checkExecutionQuota returns an error if the execution quota does not allow the execution of another packet of the
+// given interchain account. The account limits are not evaluated if the address is empty. The gas remaining within
+// the quota is returned, zero if the gas is not limited by the quota.
+func (k Keeper) checkExecutionQuota(ctx sdk.Context, quota types.ExecutionQuota, address string) (uint64, error) {
+	usages := []types.QuotaUsage{k.GetCurrentQuotaUsage(ctx, quota, "")}
+	limits := []types.QuotaLimits{quota.ConnectionLimits}
+	if address != "" {
+		usages = append(usages, k.GetCurrentQuotaUsage(ctx, quota, address))
+		limits = append(limits, quota.AccountLimits)
+	}
+
+	var remainingGas uint64
+	for i, usage := range usages {
+		if err := limits[i].Check(usage); err != nil {
+			return 0, sdkerrors.Wrapf(err, "connection: %s, address: %s", quota.ConnectionId, usage.Address)
+		}
+
+		if remaining, limited := limits[i].RemainingGas(usage); limited && (remainingGas == 0 || remaining < remainingGas) {
+			remainingGas = remaining
+		}
+	}
+
+	return remainingGas, nil
+}
+
+// consumeExecutionQuota records the execution of a packet of the given interchain account consuming the given amount
+// of gas in the usages of the execution quota. The usage of the interchain account is not recorded if the address is
+// empty. A new window is started for each usage without a current window.
+func (k Keeper) consumeExecutionQuota(ctx sdk.Context, quota types.ExecutionQuota, address string, gas uint64) {
+	addresses := []string{""}
+	if address != "" {
+		addresses = append(addresses, address)
+	}
+
+	for _, addr := range addresses {
+		usage := k.GetCurrentQuotaUsage(ctx, quota, addr)
+		if usage.WindowStartHeight == 0 {
+			usage.WindowStartHeight = ctx.BlockHeight()
+		}
+
+		usage.Packets++
+		usage.Gas += gas
+
+		k.SetQuotaUsage(ctx, usage)
+	}
+}
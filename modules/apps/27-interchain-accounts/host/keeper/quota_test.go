@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketExecutionQuota() {
+	var interchainAccountAddr string
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: connection has no execution quota", func() {}, nil,
+		},
+		{
+			"success: within limits", func() {
+				suite.setExecutionQuota(types.NewExecutionQuota(ibctesting.FirstConnectionID, 10, types.NewQuotaLimits(2, 10000000), types.NewQuotaLimits(1, 10000000)))
+			}, nil,
+		},
+		{
+			"success: usage of the previous window is reset", func() {
+				suite.setExecutionQuota(types.NewExecutionQuota(ibctesting.FirstConnectionID, 10, types.NewQuotaLimits(1, 0), types.NewQuotaLimits(1, 0)))
+
+				height := suite.chainB.GetContext().BlockHeight()
+				suite.chainB.GetSimApp().ICAHostKeeper.SetQuotaUsage(suite.chainB.GetContext(), types.NewQuotaUsage(ibctesting.FirstConnectionID, "", height-10, 1, 1000))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetQuotaUsage(suite.chainB.GetContext(), types.NewQuotaUsage(ibctesting.FirstConnectionID, interchainAccountAddr, height-10, 1, 1000))
+			}, nil,
+		},
+		{
+			"failure: connection packet limit reached", func() {
+				suite.setExecutionQuota(types.NewExecutionQuota(ibctesting.FirstConnectionID, 10, types.NewQuotaLimits(2, 0), types.QuotaLimits{}))
+
+				height := suite.chainB.GetContext().BlockHeight()
+				suite.chainB.GetSimApp().ICAHostKeeper.SetQuotaUsage(suite.chainB.GetContext(), types.NewQuotaUsage(ibctesting.FirstConnectionID, "", height-9, 2, 1000))
+			}, types.ErrQuotaExceeded,
+		},
+		{
+			"failure: connection gas limit reached", func() {
+				suite.setExecutionQuota(types.NewExecutionQuota(ibctesting.FirstConnectionID, 10, types.NewQuotaLimits(0, 1000), types.QuotaLimits{}))
+
+				height := suite.chainB.GetContext().BlockHeight()
+				suite.chainB.GetSimApp().ICAHostKeeper.SetQuotaUsage(suite.chainB.GetContext(), types.NewQuotaUsage(ibctesting.FirstConnectionID, "", height, 1, 1000))
+			}, types.ErrQuotaExceeded,
+		},
+		{
+			"failure: account packet limit reached", func() {
+				suite.setExecutionQuota(types.NewExecutionQuota(ibctesting.FirstConnectionID, 10, types.QuotaLimits{}, types.NewQuotaLimits(1, 0)))
+
+				height := suite.chainB.GetContext().BlockHeight()
+				suite.chainB.GetSimApp().ICAHostKeeper.SetQuotaUsage(suite.chainB.GetContext(), types.NewQuotaUsage(ibctesting.FirstConnectionID, interchainAccountAddr, height, 1, 1000))
+			}, types.ErrQuotaExceeded,
+		},
+		{
+			"failure: packet exceeds the remaining account gas", func() {
+				suite.setExecutionQuota(types.NewExecutionQuota(ibctesting.FirstConnectionID, 10, types.QuotaLimits{}, types.NewQuotaLimits(0, 2000)))
+
+				height := suite.chainB.GetContext().BlockHeight()
+				suite.chainB.GetSimApp().ICAHostKeeper.SetQuotaUsage(suite.chainB.GetContext(), types.NewQuotaUsage(ibctesting.FirstConnectionID, interchainAccountAddr, height, 1, 1000))
+			}, sdkerrors.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var found bool
+			interchainAccountAddr, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, coins)
+
+			params := types.NewParams(true, []string{"*"}, nil, nil, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate() // malleate mutates test data
+
+			packet := suite.newTxPacket(path, []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      coins,
+				},
+			})
+
+			ctx := suite.chainB.GetContext()
+			err = suite.recvPacketTrackingQuota(ctx, packet)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			quota, found := suite.chainB.GetSimApp().ICAHostKeeper.GetExecutionQuota(ctx, ibctesting.FirstConnectionID)
+			if !found {
+				suite.Require().Empty(suite.chainB.GetSimApp().ICAHostKeeper.GetAllQuotaUsages(ctx))
+				return
+			}
+
+			connectionUsage := suite.chainB.GetSimApp().ICAHostKeeper.GetCurrentQuotaUsage(ctx, quota, "")
+			accountUsage := suite.chainB.GetSimApp().ICAHostKeeper.GetCurrentQuotaUsage(ctx, quota, interchainAccountAddr)
+			for _, usage := range []types.QuotaUsage{connectionUsage, accountUsage} {
+				suite.Require().Equal(ctx.BlockHeight(), usage.WindowStartHeight)
+				suite.Require().Equal(uint64(1), usage.Packets)
+				suite.Require().NotZero(usage.Gas)
+			}
+
+			suite.Require().Equal(connectionUsage.Gas, accountUsage.Gas)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestExecutionQuotaWindow() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil, 0)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	quota := types.NewExecutionQuota(ibctesting.FirstConnectionID, 5, types.QuotaLimits{}, types.NewQuotaLimits(2, 0))
+	suite.setExecutionQuota(quota)
+
+	packet := suite.newTxPacket(path, []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: interchainAccountAddr,
+			ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+			Amount:      coins,
+		},
+	})
+
+	req := &types.QueryQuotaUsageRequest{ConnectionId: ibctesting.FirstConnectionID, Address: interchainAccountAddr}
+	ctx := suite.chainB.GetContext()
+
+	// the usage of an interchain account which has not executed a packet is empty
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.QuotaUsage(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(quota, res.ExecutionQuota)
+	suite.Require().Equal(types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 0, 0, 0), res.ConnectionUsage)
+	suite.Require().Equal(types.NewQuotaUsage(ibctesting.FirstConnectionID, interchainAccountAddr, 0, 0, 0), *res.AccountUsage)
+
+	for i := 0; i < 2; i++ {
+		err = suite.recvPacketTrackingQuota(ctx, packet)
+		suite.Require().NoError(err)
+	}
+
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.QuotaUsage(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.ConnectionUsage.Packets)
+	suite.Require().Equal(uint64(2), res.AccountUsage.Packets)
+	suite.Require().Equal(ctx.BlockHeight(), res.AccountUsage.WindowStartHeight)
+
+	// the account packet limit is reached until the end of the window
+	err = suite.recvPacketTrackingQuota(ctx.WithBlockHeight(ctx.BlockHeight()+4), packet)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	nextWindowCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	err = suite.recvPacketTrackingQuota(nextWindowCtx, packet)
+	suite.Require().NoError(err)
+
+	res, err = suite.chainB.GetSimApp().ICAHostKeeper.QuotaUsage(sdk.WrapSDKContext(nextWindowCtx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.AccountUsage.Packets)
+	suite.Require().Equal(nextWindowCtx.BlockHeight(), res.AccountUsage.WindowStartHeight)
+
+	// the usages are reset when the quota is replaced
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.SetExecutionQuotaAndResetUsages(ctx, quota))
+	suite.Require().Empty(suite.chainB.GetSimApp().ICAHostKeeper.GetAllQuotaUsages(ctx))
+
+	quotasRes, err := suite.chainB.GetSimApp().ICAHostKeeper.ExecutionQuotas(sdk.WrapSDKContext(ctx), &types.QueryExecutionQuotasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ExecutionQuota{quota}, quotasRes.ExecutionQuotas)
+
+	// the usage query fails for a connection without execution quota
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.RemoveExecutionQuota(ctx, ibctesting.FirstConnectionID))
+	_, err = suite.chainB.GetSimApp().ICAHostKeeper.QuotaUsage(sdk.WrapSDKContext(ctx), req)
+	suite.Require().Error(err)
+	suite.Require().Error(suite.chainB.GetSimApp().ICAHostKeeper.RemoveExecutionQuota(ctx, ibctesting.FirstConnectionID))
+}
+
+func (suite *KeeperTestSuite) setExecutionQuota(quota types.ExecutionQuota) {
+	suite.Require().NoError(suite.chainB.GetSimApp().ICAHostKeeper.SetExecutionQuotaAndResetUsages(suite.chainB.GetContext(), quota))
+}
+
+// recvPacketTrackingQuota executes the packet on chainB, recording the usage of the execution quota, and returns the
+// error of the execution
+func (suite *KeeperTestSuite) recvPacketTrackingQuota(ctx sdk.Context, packet channeltypes.Packet) (err error) {
+	suite.chainB.GetSimApp().ICAHostKeeper.TrackQuotaUsage(ctx, packet, func(ctx sdk.Context) ibcexported.Acknowledgement {
+		_, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+		return nil
+	})
+
+	return err
+}
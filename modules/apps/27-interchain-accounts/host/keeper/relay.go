@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -12,7 +13,8 @@ import (
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+// If the queries are successfully executed, the query response bytes will be returned.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) (_ []byte, err error) {
 	var data icatypes.InterchainAccountPacketData
 
 	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
@@ -20,35 +22,135 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
 	}
 
+	// the packet data is decoded using the encoding negotiated in the channel metadata
+	metadata, err := k.getAppMetadata(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	// the execution quota of the connection, if any, is enforced before executing the packet, the account limits
+	// are only enforced if an interchain account is registered for the controller port
+	var quotaGas uint64
+	if quota, found := k.GetExecutionQuota(ctx, channel.ConnectionHops[0]); found {
+		address, _ := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], packet.GetSourcePort())
+		if quotaGas, err = k.checkExecutionQuota(ctx, quota, address); err != nil {
+			return nil, err
+		}
+	}
+
+	// the packet is executed using a child gas meter limited to the max gas per packet and the gas remaining within
+	// the execution quota, the gas consumed by the packet, including the gas consumed by failed executions, is charged
+	// to the transaction and recorded in the usage of the execution quota by TrackQuotaUsage
+	gasMeter, txBound := k.newPacketGasMeter(ctx, quotaGas)
+	defer func() {
+		// if the limit of the packet gas meter is the gas remaining in the transaction, running out of gas is not a
+		// property of the packet but of the gas limit chosen by the relayer. The gas consumed beyond the limit is
+		// charged to the transaction such that the transaction gas meter panics and the transaction is reverted,
+		// instead of committing an error acknowledgement, and the packet may be relayed again
+		consumed := gasMeter.GasConsumedToLimit()
+		if txBound {
+			consumed = gasMeter.GasConsumed()
+		}
+
+		ctx.GasMeter().ConsumeGas(consumed, "interchain account packet execution")
+	}()
+
+	packetCtx := ctx.WithGasMeter(gasMeter)
+	defer recoverOutOfGas(&err)
+
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+		msgs, err := icatypes.DeserializeCosmosTxWithEncoding(k.cdc, data.Data, metadata.Encoding)
 		if err != nil {
 			return nil, err
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		txResponse, err := k.executeTx(packetCtx, packet, msgs)
 		if err != nil {
 			return nil, err
 		}
 
 		return txResponse, nil
+	case icatypes.EXECUTE_QUERY:
+		requests, err := icatypes.DeserializeCosmosQueryWithEncoding(k.cdc, data.Data, metadata.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse, err := k.executeQuery(packetCtx, requests)
+		if err != nil {
+			return nil, err
+		}
+
+		return queryResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
 }
 
+// getAppMetadata retrieves the interchain accounts metadata of the channel with the given port and channel
+// identifiers. The application version is retrieved from the ics4Wrapper as middleware may wrap the channel version.
+func (k Keeper) getAppMetadata(ctx sdk.Context, portID, channelID string) (icatypes.Metadata, error) {
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return icatypes.Metadata{}, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(appVersion), &metadata); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return icatypes.Metadata{}, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	return metadata, nil
+}
+
+// newPacketGasMeter returns the gas meter used to execute a single interchain accounts packet. Its limit is the max
+// gas per packet, bounded by the given gas remaining within the execution quota and by the gas remaining in the
+// transaction gas meter. A quota gas of zero does not limit the execution. An infinite gas meter is returned if
+// neither the max gas per packet, the execution quota nor the transaction gas meter limit the execution. The returned
+// boolean is true if the limit is the gas remaining in the transaction gas meter.
+func (k Keeper) newPacketGasMeter(ctx sdk.Context, quotaGas uint64) (sdk.GasMeter, bool) {
+	limit := k.GetMaxGasPerPacket(ctx)
+	bounded := limit > 0
+
+	if quotaGas > 0 && (!bounded || quotaGas < limit) {
+		limit, bounded = quotaGas, true
+	}
+
+	// infinite gas meters report a limit of zero
+	var txBound bool
+	if txGasMeter := ctx.GasMeter(); txGasMeter.Limit() > 0 {
+		remaining := txGasMeter.Limit() - txGasMeter.GasConsumedToLimit()
+		if !bounded || remaining <= limit {
+			limit, bounded, txBound = remaining, true, true
+		}
+	}
+
+	if !bounded {
+		return sdk.NewInfiniteGasMeter(), false
+	}
+
+	return sdk.NewGasMeter(limit), txBound
+}
+
 // executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
-// execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
-	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+// execution of the transaction is atomic, all state changes are reverted if a single message fails. An event is
+// emitted for each executed message and the index of a failing message is included in the returned error.
+func (k Keeper) executeTx(ctx sdk.Context, packet channeltypes.Packet, msgs []sdk.Msg) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], packet.GetSourcePort()); err != nil {
 		return nil, err
 	}
 
@@ -60,20 +162,20 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
 	for i, msg := range msgs {
-		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
-		}
+		gasBefore := cacheCtx.GasMeter().GasConsumedToLimit()
 
 		msgResponse, err := k.executeMsg(cacheCtx, msg)
+
+		// the event is emitted on the parent context so that it is retained if the message fails
+		EmitMsgExecutedEvent(ctx, packet, i, msg, cacheCtx.GasMeter().GasConsumedToLimit()-gasBefore, err)
 		if err != nil {
-			return nil, err
+			return nil, types.NewMsgExecutionError(i, err)
 		}
 
 		txMsgData.Data[i] = &sdk.MsgData{
 			MsgType: sdk.MsgTypeURL(msg),
 			Data:    msgResponse,
 		}
-
 	}
 
 	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
@@ -96,15 +198,15 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
-	for _, msg := range msgs {
+	allowMsgs, _ := k.GetAllowMessagesForConnection(ctx, connectionID)
+	for i, msg := range msgs {
 		if !types.ContainsMsgType(allowMsgs, msg) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+			return types.NewMsgExecutionError(i, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg)))
 		}
 
 		for _, signer := range msg.GetSigners() {
 			if interchainAccountAddr != signer.String() {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
+				return types.NewMsgExecutionError(i, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String()))
 			}
 		}
 	}
@@ -112,9 +214,21 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 	return nil
 }
 
-// Attempts to get the message handler from the router and if found will then execute the message.
-// If the message execution is successful, the proto marshaled message response will be returned.
-func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]byte, error) {
+// Performs basic validation of the message and evaluates the constraint of its message type, if any, for the
+// interchain account. It then attempts to get the message handler from the router and if found will execute the
+// message. If the message execution is successful, the proto marshaled message response will be returned. If the
+// packet gas meter runs out of gas, the out of gas error is returned.
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (_ []byte, err error) {
+	defer recoverOutOfGas(&err)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.acceptMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
 	handler := k.msgRouter.Handler(msg)
 	if handler == nil {
 		return nil, icatypes.ErrInvalidRoute
@@ -130,3 +244,69 @@ func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) ([]byte, error) {
 
 	return res.Data, nil
 }
+
+// recoverOutOfGas recovers from an out of gas panic, returning it as an error. It must only be deferred while
+// executing with the packet gas meter, panics of the transaction gas meter must not be recovered. If the packet gas
+// meter is limited by the transaction gas meter, the transaction is reverted by OnRecvPacket.
+func recoverOutOfGas(err *error) {
+	if r := recover(); r != nil {
+		outOfGas, ok := r.(sdk.ErrorOutOfGas)
+		if !ok {
+			panic(r)
+		}
+
+		*err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "interchain account packet ran out of gas in location: %s", outOfGas.Descriptor)
+	}
+}
+
+// executeQuery attempts to execute the provided query requests using the gRPC query router. Each query path must
+// be present in the host allowlist of query paths. The queries are executed against a branched multi-store which
+// is never written, thus the execution of the queries does not change state. The proto marshaled responses are
+// returned in the order of the requests. If a single query fails, an error is returned.
+func (k Keeper) executeQuery(ctx sdk.Context, requests []icatypes.QueryRequest) ([]byte, error) {
+	if len(requests) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "query requests cannot be empty")
+	}
+
+	allowQueries := k.GetAllowQueries(ctx)
+	for _, request := range requests {
+		if !types.ContainsQueryPath(allowQueries, request.Path) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", request.Path)
+		}
+	}
+
+	queryResponse := &icatypes.CosmosQueryResponse{
+		Responses: make([]icatypes.QueryResponse, len(requests)),
+	}
+
+	// CacheContext returns a new context with the multi-store branched into a cached storage object
+	// writeCache is never called, any state changes made while executing the queries are discarded
+	cacheCtx, _ := ctx.CacheContext()
+	for i, request := range requests {
+		route := k.queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidRoute, "no route found for query path: %s", request.Path)
+		}
+
+		res, err := route(cacheCtx, abci.RequestQuery{
+			Path:   request.Path,
+			Data:   request.Data,
+			Height: ctx.BlockHeight(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse.Responses[i] = icatypes.QueryResponse{
+			Value:  res.Value,
+			Height: ctx.BlockHeight(),
+		}
+	}
+
+	bz, err := proto.Marshal(queryResponse)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal query response")
+	}
+
+	return bz, nil
+}
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -63,7 +66,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{"*"})
+				params := types.NewParams(true, []string{"*"}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -90,7 +93,63 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend using a package prefix wildcard",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.*"}, nil, nil, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"interchain account successfully executes banktypes.MsgSend using a connection allowlist",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, nil, nil, []types.ConnectionAllowMessages{
+					types.NewConnectionAllowMessages(ibctesting.FirstConnectionID, []string{sdk.MsgTypeURL(msg)}),
+				}, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -118,7 +177,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -152,7 +211,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -187,7 +246,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -226,7 +285,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -252,7 +311,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -278,7 +337,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -317,7 +376,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -396,6 +455,35 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			false,
 		},
+		{
+			"unauthorised: message type not allowed by connection allowlist",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, []types.ConnectionAllowMessages{
+					types.NewConnectionAllowMessages(ibctesting.FirstConnectionID, []string{"/cosmos.gov.v1beta1.*"}),
+				}, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"unauthorised: signer address is not the interchain account associated with the controller portID",
 			func() {
@@ -415,7 +503,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			false,
@@ -476,6 +564,307 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketMsgExecution() {
+	var (
+		path     *ibctesting.Path
+		msgs     []sdk.Msg
+		gasMeter sdk.GasMeter
+	)
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expErr      error
+		expMsgIndex int
+		expEvents   int
+		expPanic    bool
+	}{
+		{
+			"success", func() {}, nil, 0, 2, false,
+		},
+		{
+			"failure: second message fails", func() {
+				msgs[1].(*banktypes.MsgSend).Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+			}, sdkerrors.ErrInsufficientFunds, 1, 2, false,
+		},
+		{
+			"failure: second message is not allowed", func() {
+				msgs[1] = &banktypes.MsgMultiSend{}
+			}, sdkerrors.ErrUnauthorized, 1, 0, false,
+		},
+		{
+			"failure: max gas per packet exceeded", func() {
+				params := types.NewParams(true, []string{"*"}, nil, nil, 20000)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, sdkerrors.ErrOutOfGas, 0, 1, false,
+		},
+		{
+			"failure: transaction gas limit exceeded reverts the transaction", func() {
+				gasMeter = sdk.NewGasMeter(20000)
+			}, nil, 0, 0, true,
+		},
+		{
+			"failure: transaction gas limit lower than max gas per packet reverts the transaction", func() {
+				params := types.NewParams(true, []string{"*"}, nil, nil, 30000)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				gasMeter = sdk.NewGasMeter(20000)
+			}, nil, 0, 0, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			msgs = []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				},
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))),
+				},
+			}
+			gasMeter = sdk.NewGasMeter(10000000)
+
+			params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, nil, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate() // malleate mutates test data
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			ctx := suite.chainB.GetContext().WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+			if tc.expPanic {
+				suite.Require().PanicsWithValue(sdk.ErrorOutOfGas{Descriptor: "interchain account packet execution"}, func() {
+					_, _ = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+				})
+
+				return
+			}
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			var events []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeMsgExecuted {
+					events = append(events, event)
+				}
+			}
+			suite.Require().Len(events, tc.expEvents)
+
+			for i, event := range events {
+				attributes := make(map[string]string)
+				for _, attr := range event.Attributes {
+					attributes[string(attr.Key)] = string(attr.Value)
+				}
+
+				suite.Require().Equal(path.EndpointB.ChannelID, attributes[icatypes.AttributeKeyHostChannelID])
+				suite.Require().Equal(fmt.Sprintf("%d", i), attributes[types.AttributeKeyMsgIndex])
+				suite.Require().Equal(sdk.MsgTypeURL(msgs[i]), attributes[types.AttributeKeyMsgType])
+				suite.Require().Equal(interchainAccountAddr, attributes[types.AttributeKeySigners])
+				suite.Require().NotEqual("0", attributes[types.AttributeKeyGasUsed])
+
+				expSuccess := tc.expErr == nil || i != tc.expMsgIndex
+				suite.Require().Equal(fmt.Sprintf("%t", expSuccess), attributes[icatypes.AttributeKeyAckSuccess])
+			}
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				return
+			}
+
+			suite.Require().ErrorIs(err, tc.expErr)
+			suite.Require().Nil(txResponse)
+
+			if tc.expErr == sdkerrors.ErrOutOfGas {
+				// the gas consumed by the failed packet is charged to the transaction
+				suite.Require().GreaterOrEqual(gasMeter.GasConsumed(), uint64(20000))
+			}
+
+			var msgErr *types.MsgExecutionError
+			suite.Require().True(errors.As(err, &msgErr))
+			suite.Require().Equal(tc.expMsgIndex, msgErr.Index)
+
+			// the state changes of the successful messages are reverted
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(expBalance, balance)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketQuery() {
+	var (
+		path       *ibctesting.Path
+		requests   []icatypes.QueryRequest
+		expBalance sdk.Coin
+	)
+
+	balancePath := "/cosmos.bank.v1beta1.Query/Balance"
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with multiple queries",
+			func() {
+				requests = append(requests, requests[0])
+			},
+			true,
+		},
+		{
+			"query path is not allowed",
+			func() {
+				params := types.NewParams(true, nil, []string{"/cosmos.bank.v1beta1.Query/TotalSupply"}, nil, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"no route for allowed query path",
+			func() {
+				requests[0].Path = "/cosmos.bank.v1beta1.Query/Unknown"
+
+				params := types.NewParams(true, nil, []string{requests[0].Path}, nil, 0)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
+		{
+			"invalid query request data",
+			func() {
+				requests[0].Data = []byte("invalid")
+			},
+			false,
+		},
+		{
+			"empty query requests",
+			func() {
+				requests = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			expBalance = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(expBalance))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			balanceReq := &banktypes.QueryBalanceRequest{
+				Address: interchainAccountAddr,
+				Denom:   sdk.DefaultBondDenom,
+			}
+
+			requests = []icatypes.QueryRequest{
+				{
+					Path: balancePath,
+					Data: suite.chainB.GetSimApp().AppCodec().MustMarshal(balanceReq),
+				},
+			}
+
+			params := types.NewParams(true, nil, []string{balancePath}, nil, 0)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			tc.malleate() // malleate mutates test data
+
+			data, err := icatypes.SerializeCosmosQuery(suite.chainA.GetSimApp().AppCodec(), requests)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_QUERY,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			queryResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				var cosmosQueryResponse icatypes.CosmosQueryResponse
+				suite.Require().NoError(suite.chainA.GetSimApp().AppCodec().Unmarshal(queryResponse, &cosmosQueryResponse))
+				suite.Require().Len(cosmosQueryResponse.Responses, len(requests))
+
+				for _, response := range cosmosQueryResponse.Responses {
+					var balanceResp banktypes.QueryBalanceResponse
+					suite.Require().NoError(suite.chainA.GetSimApp().AppCodec().Unmarshal(response.Value, &balanceResp))
+					suite.Require().Equal(expBalance, *balanceResp.Balance)
+					suite.Require().Equal(suite.chainB.GetContext().BlockHeight(), response.Height)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(queryResponse)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
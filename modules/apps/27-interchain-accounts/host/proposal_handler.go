@@ -0,0 +1,30 @@
+package host
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+// NewProposalHandler defines the interchain accounts host proposal handler, handling the message constraint and
+// execution quota proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetMsgConstraintProposal:
+			return k.SetMsgConstraintAndResetAllowances(ctx, c.Constraint)
+		case *types.RemoveMsgConstraintProposal:
+			return k.RemoveMsgConstraint(ctx, c.MsgTypeUrl)
+		case *types.SetExecutionQuotaProposal:
+			return k.SetExecutionQuotaAndResetUsages(ctx, c.Quota)
+		case *types.RemoveExecutionQuotaProposal:
+			return k.RemoveExecutionQuota(ctx, c.ConnectionId)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchain accounts host proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package host_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *InterchainAccountsTestSuite) TestNewProposalHandler() {
+	handler := host.NewProposalHandler(suite.chainB.GetSimApp().ICAHostKeeper)
+	k := suite.chainB.GetSimApp().ICAHostKeeper
+	ctx := suite.chainB.GetContext()
+
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	constraint, err := types.NewMsgConstraint(types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), nil), 24)
+	suite.Require().NoError(err)
+
+	allowance, err := types.NewAccountAllowance(suite.chainB.SenderAccount.GetAddress().String(), msgTypeURL, nil, ctx.BlockTime())
+	suite.Require().NoError(err)
+	k.SetAccountAllowance(ctx, allowance)
+
+	suite.Require().NoError(handler(ctx, types.NewSetMsgConstraintProposal("title", "description", constraint)))
+
+	storedConstraint, found := k.GetMsgConstraint(ctx, msgTypeURL)
+	suite.Require().True(found)
+	suite.Require().Equal(constraint.DurationHours, storedConstraint.DurationHours)
+
+	// the allowances are reset by the new constraint
+	_, found = k.GetAccountAllowance(ctx, msgTypeURL, allowance.Address)
+	suite.Require().False(found)
+
+	suite.Require().Error(handler(ctx, types.NewSetMsgConstraintProposal("title", "description", types.MsgConstraint{})))
+
+	suite.Require().NoError(handler(ctx, types.NewRemoveMsgConstraintProposal("title", "description", msgTypeURL)))
+
+	_, found = k.GetMsgConstraint(ctx, msgTypeURL)
+	suite.Require().False(found)
+
+	suite.Require().Error(handler(ctx, types.NewRemoveMsgConstraintProposal("title", "description", msgTypeURL)))
+
+	quota := types.NewExecutionQuota(ibctesting.FirstConnectionID, 100, types.NewQuotaLimits(10, 0), types.QuotaLimits{})
+	k.SetQuotaUsage(ctx, types.NewQuotaUsage(ibctesting.FirstConnectionID, "", ctx.BlockHeight(), 1, 1000))
+
+	suite.Require().NoError(handler(ctx, types.NewSetExecutionQuotaProposal("title", "description", quota)))
+
+	storedQuota, found := k.GetExecutionQuota(ctx, ibctesting.FirstConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(quota, storedQuota)
+
+	// the usages are reset by the new quota
+	_, found = k.GetQuotaUsage(ctx, ibctesting.FirstConnectionID, "")
+	suite.Require().False(found)
+
+	suite.Require().Error(handler(ctx, types.NewSetExecutionQuotaProposal("title", "description", types.ExecutionQuota{})))
+
+	suite.Require().NoError(handler(ctx, types.NewRemoveExecutionQuotaProposal("title", "description", ibctesting.FirstConnectionID)))
+
+	_, found = k.GetExecutionQuota(ctx, ibctesting.FirstConnectionID)
+	suite.Require().False(found)
+
+	suite.Require().Error(handler(ctx, types.NewRemoveExecutionQuotaProposal("title", "description", ibctesting.FirstConnectionID)))
+
+	// unsupported proposal content
+	content := distrtypes.NewCommunityPoolSpendProposal("title", "description", suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins())
+	suite.Require().Error(handler(ctx, content))
+}
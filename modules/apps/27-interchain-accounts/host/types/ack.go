@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,8 +15,40 @@ const (
 	ackErrorString = "error handling packet on host chain: see events for details"
 )
 
+// MsgExecutionError defines the error returned when a message of an interchain accounts transaction
+// fails authentication or execution. The index of the failing message is included in the error
+// acknowledgement.
+type MsgExecutionError struct {
+	Index int
+	Err   error
+}
+
+// NewMsgExecutionError returns an error identifying the message at the provided index as the cause of the failure.
+func NewMsgExecutionError(index int, err error) error {
+	return &MsgExecutionError{
+		Index: index,
+		Err:   err,
+	}
+}
+
+// Error implements the error interface
+func (e *MsgExecutionError) Error() string {
+	return fmt.Sprintf("message %d: %s", e.Index, e.Err)
+}
+
+// Cause returns the underlying error, allowing the ABCI code of the underlying error to be retrieved
+func (e *MsgExecutionError) Cause() error {
+	return e.Err
+}
+
+// Unwrap implements the built-in errors.Unwrap
+func (e *MsgExecutionError) Unwrap() error {
+	return e.Err
+}
+
 // NewErrorAcknowledgement returns a deterministic error string which may be used in
-// the packet acknowledgement.
+// the packet acknowledgement. The index of the failing message is included if the
+// error is a MsgExecutionError.
 func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 	// the ABCI code is included in the abcitypes.ResponseDeliverTx hash
 	// constructed in Tendermint and is therefore determinstic
@@ -23,5 +56,10 @@ func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 
 	errorString := fmt.Sprintf("ABCI code: %d: %s", code, ackErrorString)
 
+	var msgErr *MsgExecutionError
+	if errors.As(err, &msgErr) {
+		errorString = fmt.Sprintf("ABCI code: %d: message index: %d: %s", code, msgErr.Index, ackErrorString)
+	}
+
 	return channeltypes.NewErrorAcknowledgement(errorString)
 }
@@ -98,3 +98,20 @@ func (suite *TypesTestSuite) TestAcknowledgementError() {
 	suite.Require().Equal(ack, ackSameABCICode)
 	suite.Require().NotEqual(ack, ackDifferentABCICode)
 }
+
+// TestMsgExecutionErrorAcknowledgement will verify that the index of the failing message
+// is included in the acknowledgement error string with the ABCI error code of the cause
+func (suite *TypesTestSuite) TestMsgExecutionErrorAcknowledgement() {
+	err := types.NewMsgExecutionError(1, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "error string 1"))
+	errSameIndex := sdkerrors.Wrap(types.NewMsgExecutionError(1, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "error string 2")), "wrapped")
+	errDifferentIndex := types.NewMsgExecutionError(2, sdkerrors.ErrUnauthorized)
+
+	ack := types.NewErrorAcknowledgement(err)
+	ackSameIndex := types.NewErrorAcknowledgement(errSameIndex)
+	ackDifferentIndex := types.NewErrorAcknowledgement(errDifferentIndex)
+
+	suite.Require().Equal("ABCI code: 4: message index: 1: error handling packet on host chain: see events for details", ack.GetError())
+	suite.Require().Equal(ack, ackSameIndex)
+	suite.Require().NotEqual(ack, ackDifferentIndex)
+	suite.Require().NotEqual(ack, types.NewErrorAcknowledgement(sdkerrors.ErrUnauthorized))
+}
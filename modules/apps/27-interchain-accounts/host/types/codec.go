@@ -0,0 +1,23 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterInterfaces registers the interchain accounts host authorizations and governance proposals to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&SendAuthorization{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetMsgConstraintProposal{},
+		&RemoveMsgConstraintProposal{},
+		&SetExecutionQuotaProposal{},
+		&RemoveExecutionQuotaProposal{},
+	)
+}
@@ -0,0 +1,266 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = MsgConstraint{}
+	_ codectypes.UnpackInterfacesMessage = AccountAllowance{}
+	_ codectypes.UnpackInterfacesMessage = QueryMsgConstraintsResponse{}
+	_ codectypes.UnpackInterfacesMessage = QueryAllowanceResponse{}
+)
+
+// NewMsgConstraint creates a new MsgConstraint instance for the sdk message type of the given authorization
+func NewMsgConstraint(authorization authz.Authorization, durationHours uint64) (MsgConstraint, error) {
+	any, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return MsgConstraint{}, err
+	}
+
+	return MsgConstraint{
+		Authorization: any,
+		DurationHours: durationHours,
+	}, nil
+}
+
+// GetAuthorization returns the cached authorization of the constraint, nil if it is not set or not an authorization
+func (c MsgConstraint) GetAuthorization() authz.Authorization {
+	return getCachedAuthorization(c.Authorization)
+}
+
+// MsgTypeURL returns the sdk message type url constrained by the authorization of the constraint
+func (c MsgConstraint) MsgTypeURL() string {
+	authorization := c.GetAuthorization()
+	if authorization == nil {
+		return ""
+	}
+
+	return authorization.MsgTypeURL()
+}
+
+// WindowEnd returns the end time of a window of the constraint started at the given time. False is returned
+// if the window never ends.
+func (c MsgConstraint) WindowEnd(windowStart time.Time) (time.Time, bool) {
+	if c.DurationHours == 0 {
+		return time.Time{}, false
+	}
+
+	return windowStart.Add(time.Duration(c.DurationHours) * time.Hour), true
+}
+
+// ValidateBasic performs a basic validation of the MsgConstraint fields
+func (c MsgConstraint) ValidateBasic() error {
+	authorization := c.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidMsgConstraint, "authorization must be set")
+	}
+
+	if err := authorization.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMsgConstraint, "invalid authorization: %s", err)
+	}
+
+	if !strings.HasPrefix(authorization.MsgTypeURL(), "/") {
+		return sdkerrors.Wrapf(ErrInvalidMsgConstraint, "invalid message type url: %s", authorization.MsgTypeURL())
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c MsgConstraint) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authorization authz.Authorization
+	return unpacker.UnpackAny(c.Authorization, &authorization)
+}
+
+// NewAccountAllowance creates a new AccountAllowance instance. A nil authorization marks the allowance as exhausted.
+func NewAccountAllowance(address, msgTypeURL string, authorization authz.Authorization, windowStart time.Time) (AccountAllowance, error) {
+	allowance := AccountAllowance{
+		Address:     address,
+		MsgTypeUrl:  msgTypeURL,
+		WindowStart: windowStart,
+	}
+
+	if authorization != nil {
+		any, err := codectypes.NewAnyWithValue(authorization)
+		if err != nil {
+			return AccountAllowance{}, err
+		}
+
+		allowance.Authorization = any
+	}
+
+	return allowance, nil
+}
+
+// GetAuthorization returns the cached remaining authorization, nil if the allowance is exhausted
+func (a AccountAllowance) GetAuthorization() authz.Authorization {
+	return getCachedAuthorization(a.Authorization)
+}
+
+// IsExhausted returns true if no authorization remains for the current window
+func (a AccountAllowance) IsExhausted() bool {
+	return a.Authorization == nil
+}
+
+// Validate performs a basic validation of the AccountAllowance fields
+func (a AccountAllowance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid interchain account address: %s", err)
+	}
+
+	if !strings.HasPrefix(a.MsgTypeUrl, "/") {
+		return sdkerrors.Wrapf(ErrInvalidMsgConstraint, "invalid message type url: %s", a.MsgTypeUrl)
+	}
+
+	if a.IsExhausted() {
+		return nil
+	}
+
+	authorization := a.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidMsgConstraint, "remaining allowance is not an authorization")
+	}
+
+	if authorization.MsgTypeURL() != a.MsgTypeUrl {
+		return sdkerrors.Wrapf(ErrInvalidMsgConstraint, "remaining allowance message type url %s does not match %s", authorization.MsgTypeURL(), a.MsgTypeUrl)
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a AccountAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if a.Authorization == nil {
+		return nil
+	}
+
+	var authorization authz.Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryMsgConstraintsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, constraint := range r.MsgConstraints {
+		if err := constraint.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryAllowanceResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if err := r.MsgConstraint.UnpackInterfaces(unpacker); err != nil {
+		return err
+	}
+
+	if r.Remaining == nil {
+		return nil
+	}
+
+	var authorization authz.Authorization
+	return unpacker.UnpackAny(r.Remaining, &authorization)
+}
+
+// NewSendAuthorization creates a new SendAuthorization instance
+func NewSendAuthorization(spendLimit sdk.Coins, allowList []string) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+		AllowList:  allowList,
+	}
+}
+
+// MsgTypeURL implements authz.Authorization.MsgTypeURL
+func (a SendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements authz.Authorization.Accept. The recipient must be present in the allow list, if set, and
+// the amount sent is deducted from the spend limit, if set.
+func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgSend, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &banktypes.MsgSend{}, msg)
+	}
+
+	if len(a.AllowList) > 0 && !containsString(a.AllowList, msgSend.ToAddress) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "recipient is not allowed: %s", msgSend.ToAddress)
+	}
+
+	if a.SpendLimit.Empty() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount %s is more than the spend limit %s", msgSend.Amount, a.SpendLimit)
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: NewSendAuthorization(limitLeft, a.AllowList)}, nil
+}
+
+// ValidateBasic implements authz.Authorization.ValidateBasic
+func (a SendAuthorization) ValidateBasic() error {
+	if a.SpendLimit.Empty() && len(a.AllowList) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "spend limit and allow list cannot both be empty")
+	}
+
+	if !a.SpendLimit.Empty() && !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit must be positive: %s", a.SpendLimit)
+	}
+
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	seen := make(map[string]bool)
+	for _, recipient := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+		}
+
+		if seen[recipient] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate recipient address: %s", recipient)
+		}
+
+		seen[recipient] = true
+	}
+
+	return nil
+}
+
+func getCachedAuthorization(any *codectypes.Any) authz.Authorization {
+	if any == nil {
+		return nil
+	}
+
+	authorization, ok := any.GetCachedValue().(authz.Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
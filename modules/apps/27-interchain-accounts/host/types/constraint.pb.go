@@ -0,0 +1,937 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/host/v1/constraint.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgConstraint constrains the execution of an sdk message type by interchain accounts using an authz
+// authorization. The authorization is evaluated separately for each interchain account and its remaining
+// allowance is reset at the start of each window.
+type MsgConstraint struct {
+	// authorization evaluated for each message of the type given by its msg type url
+	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// the duration of the window in hours, a value of zero never resets the allowance of an interchain account
+	DurationHours uint64 `protobuf:"varint,2,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty" yaml:"duration_hours"`
+}
+
+func (m *MsgConstraint) Reset()         { *m = MsgConstraint{} }
+func (m *MsgConstraint) String() string { return proto.CompactTextString(m) }
+func (*MsgConstraint) ProtoMessage()    {}
+func (*MsgConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e92a1cabcdaf34a, []int{0}
+}
+func (m *MsgConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConstraint.Merge(m, src)
+}
+func (m *MsgConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConstraint proto.InternalMessageInfo
+
+// AccountAllowance tracks the remaining allowance of an interchain account for a constrained sdk message type
+// within the current window.
+type AccountAllowance struct {
+	// interchain account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the sdk message type url of the constraint
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// the remaining authorization of the interchain account, empty if the allowance is exhausted
+	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// the start time of the current window
+	WindowStart time.Time `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start" yaml:"window_start"`
+}
+
+func (m *AccountAllowance) Reset()         { *m = AccountAllowance{} }
+func (m *AccountAllowance) String() string { return proto.CompactTextString(m) }
+func (*AccountAllowance) ProtoMessage()    {}
+func (*AccountAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e92a1cabcdaf34a, []int{1}
+}
+func (m *AccountAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAllowance.Merge(m, src)
+}
+func (m *AccountAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAllowance proto.InternalMessageInfo
+
+// SendAuthorization allows an interchain account to send coins using MsgSend up to a spend limit, optionally
+// restricted to a list of recipients.
+type SendAuthorization struct {
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit" yaml:"spend_limit"`
+	// the recipient addresses allowed to receive coins, any recipient is allowed if empty
+	AllowList []string `protobuf:"bytes,2,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty" yaml:"allow_list"`
+}
+
+func (m *SendAuthorization) Reset()         { *m = SendAuthorization{} }
+func (m *SendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SendAuthorization) ProtoMessage()    {}
+func (*SendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e92a1cabcdaf34a, []int{2}
+}
+func (m *SendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendAuthorization.Merge(m, src)
+}
+func (m *SendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendAuthorization proto.InternalMessageInfo
+
+func (m *SendAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *SendAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgConstraint)(nil), "ibc.applications.interchain_accounts.host.v1.MsgConstraint")
+	proto.RegisterType((*AccountAllowance)(nil), "ibc.applications.interchain_accounts.host.v1.AccountAllowance")
+	proto.RegisterType((*SendAuthorization)(nil), "ibc.applications.interchain_accounts.host.v1.SendAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/constraint.proto", fileDescriptor_4e92a1cabcdaf34a)
+}
+
+var fileDescriptor_4e92a1cabcdaf34a = []byte{
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0xdb, 0x0a, 0x94, 0x4b, 0x8b, 0xa8, 0x69, 0x85, 0xdb, 0xc1, 0x8e, 0x3c, 0x54, 0x19,
+	0xc8, 0x9d, 0xda, 0x22, 0x21, 0x2a, 0x21, 0x11, 0x57, 0x42, 0x08, 0x95, 0xc5, 0x2d, 0x0b, 0x03,
+	0xd6, 0xd9, 0x3e, 0x9c, 0x13, 0xf6, 0x9d, 0xe5, 0x3b, 0x27, 0x4a, 0x47, 0x26, 0xc6, 0xfe, 0x04,
+	0x66, 0x36, 0x24, 0x36, 0xfe, 0x40, 0xc5, 0x54, 0x31, 0x31, 0xa5, 0x28, 0xf9, 0x07, 0x19, 0x98,
+	0x91, 0xcf, 0x4e, 0x93, 0x10, 0x06, 0x06, 0xa6, 0xdc, 0x77, 0x2f, 0xef, 0x7b, 0xdf, 0x7b, 0x77,
+	0x3e, 0xf0, 0x84, 0xfa, 0x01, 0xc2, 0x69, 0x1a, 0xd3, 0x00, 0x4b, 0xca, 0x99, 0x40, 0x94, 0x49,
+	0x92, 0x05, 0x5d, 0x4c, 0x99, 0x87, 0x83, 0x80, 0xe7, 0x4c, 0x0a, 0xd4, 0xe5, 0x42, 0xa2, 0xde,
+	0x3e, 0x0a, 0x38, 0x13, 0x32, 0xc3, 0x94, 0x49, 0x98, 0x66, 0x5c, 0x72, 0xfd, 0x01, 0xf5, 0x03,
+	0x38, 0x4f, 0x87, 0x7f, 0xa1, 0xc3, 0x82, 0x0e, 0x7b, 0xfb, 0xbb, 0x5b, 0x11, 0x8f, 0xb8, 0x22,
+	0xa2, 0x62, 0x55, 0xf6, 0xd8, 0xdd, 0x09, 0xb8, 0x48, 0xb8, 0xf0, 0x4a, 0xa0, 0x2c, 0xa6, 0x50,
+	0xc4, 0x79, 0x14, 0x13, 0xa4, 0x2a, 0x3f, 0x7f, 0x8b, 0x30, 0x1b, 0x54, 0x90, 0xf5, 0x27, 0x24,
+	0x69, 0x42, 0x84, 0xc4, 0x49, 0x5a, 0xfd, 0xc1, 0x2c, 0x3b, 0x21, 0x1f, 0x0b, 0x82, 0x7a, 0xfb,
+	0x3e, 0x91, 0xb8, 0x30, 0x40, 0x59, 0x89, 0xdb, 0x5f, 0x35, 0xb0, 0xf1, 0x52, 0x44, 0xc7, 0x37,
+	0x96, 0x74, 0x1f, 0x6c, 0xe0, 0x5c, 0x76, 0x79, 0x46, 0xcf, 0x95, 0x19, 0x43, 0x6b, 0x6a, 0xad,
+	0xc6, 0xc1, 0x16, 0x2c, 0xa5, 0xe0, 0x54, 0x0a, 0x76, 0xd8, 0xc0, 0xd9, 0xfb, 0xf6, 0xa5, 0x6d,
+	0x57, 0xc3, 0x16, 0xac, 0x73, 0x58, 0x69, 0xc0, 0xce, 0x7c, 0x0f, 0x77, 0xb1, 0xa5, 0xfe, 0x14,
+	0xdc, 0x09, 0xf3, 0x4c, 0xad, 0xbd, 0x2e, 0xcf, 0x33, 0x61, 0xac, 0x34, 0xb5, 0xd6, 0x9a, 0xb3,
+	0x33, 0x19, 0x5a, 0xdb, 0x03, 0x9c, 0xc4, 0x47, 0xf6, 0x22, 0x6e, 0xbb, 0x1b, 0xd3, 0x8d, 0xe7,
+	0x45, 0x7d, 0xb4, 0xf6, 0xe1, 0xa3, 0x55, 0xb3, 0x3f, 0xaf, 0x80, 0xbb, 0x9d, 0x32, 0xdf, 0x4e,
+	0x1c, 0xf3, 0x3e, 0x66, 0x01, 0xd1, 0x0d, 0x70, 0x1b, 0x87, 0x61, 0x46, 0x84, 0x50, 0xa3, 0xd7,
+	0xdd, 0x69, 0xa9, 0x3f, 0x06, 0xeb, 0x89, 0x88, 0x3c, 0x39, 0x48, 0x89, 0x97, 0x67, 0xb1, 0x12,
+	0xad, 0x3b, 0xf7, 0x27, 0x43, 0xeb, 0x5e, 0x29, 0x3a, 0x8f, 0xda, 0x2e, 0x48, 0x44, 0x74, 0x36,
+	0x48, 0xc9, 0xab, 0x2c, 0x5e, 0x4e, 0x65, 0xf5, 0xff, 0xa7, 0xf2, 0x06, 0xac, 0xf7, 0x29, 0x0b,
+	0x79, 0xdf, 0x13, 0x12, 0x67, 0xd2, 0x58, 0x53, 0x12, 0xbb, 0x4b, 0x12, 0x67, 0xd3, 0x33, 0x76,
+	0xac, 0xcb, 0xa1, 0x55, 0x9b, 0x8d, 0x3f, 0xcf, 0xb6, 0x2f, 0xae, 0x2d, 0xcd, 0x6d, 0x94, 0x5b,
+	0xa7, 0xc5, 0x4e, 0x95, 0xd9, 0x2f, 0x0d, 0x6c, 0x9e, 0x12, 0x16, 0x2e, 0x8c, 0xa2, 0xbf, 0xd7,
+	0x40, 0x43, 0xa4, 0x84, 0x85, 0x5e, 0x4c, 0x13, 0x2a, 0x0d, 0xad, 0xb9, 0xda, 0x6a, 0x1c, 0xec,
+	0xc0, 0xca, 0x45, 0x71, 0x7d, 0x6e, 0x4c, 0x1c, 0x73, 0xca, 0x9c, 0x67, 0x95, 0xb4, 0x5e, 0x4a,
+	0xcf, 0x71, 0xed, 0x4f, 0xd7, 0x56, 0x2b, 0xa2, 0xb2, 0x9b, 0xfb, 0x30, 0xe0, 0x49, 0x75, 0x97,
+	0xab, 0x9f, 0xb6, 0x08, 0xdf, 0xa1, 0x22, 0x62, 0xa1, 0xda, 0x08, 0x17, 0x28, 0xe6, 0x49, 0x41,
+	0xd4, 0x1f, 0x02, 0x80, 0x8b, 0x63, 0xf4, 0x62, 0x2a, 0xa4, 0xb1, 0xd2, 0x5c, 0x6d, 0xd5, 0x9d,
+	0xed, 0xc9, 0xd0, 0xda, 0x2c, 0x35, 0x66, 0x98, 0xed, 0xd6, 0x55, 0x71, 0x42, 0x85, 0x3c, 0xda,
+	0xfb, 0xfe, 0x4f, 0x69, 0x3b, 0xe1, 0xe5, 0xc8, 0xd4, 0xae, 0x46, 0xa6, 0xf6, 0x73, 0x64, 0x6a,
+	0x17, 0x63, 0xb3, 0x76, 0x35, 0x36, 0x6b, 0x3f, 0xc6, 0x66, 0xed, 0xf5, 0x8b, 0xe5, 0x69, 0xa9,
+	0x1f, 0xb4, 0x23, 0x8e, 0x7a, 0x87, 0x28, 0xe1, 0x61, 0x1e, 0x13, 0x51, 0x3c, 0x0f, 0x02, 0x1d,
+	0x3c, 0x6a, 0xcf, 0x3e, 0xed, 0xf6, 0xe2, 0xcb, 0xa0, 0x5c, 0xf9, 0xb7, 0xd4, 0x31, 0x1d, 0xfe,
+	0x1e, 0x00, 0x4b, 0x0c, 0x47, 0x80, 0x53, 0x04, 0x00, 0x00,
+}
+
+func (m *MsgConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DurationHours != 0 {
+		i = encodeVarintConstraint(dAtA, i, uint64(m.DurationHours))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConstraint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintConstraint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConstraint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintConstraint(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintConstraint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintConstraint(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConstraint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConstraint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConstraint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovConstraint(uint64(l))
+	}
+	if m.DurationHours != 0 {
+		n += 1 + sovConstraint(uint64(m.DurationHours))
+	}
+	return n
+}
+
+func (m *AccountAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovConstraint(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovConstraint(uint64(l))
+	}
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovConstraint(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovConstraint(uint64(l))
+	return n
+}
+
+func (m *SendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovConstraint(uint64(l))
+		}
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovConstraint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovConstraint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConstraint(x uint64) (n int) {
+	return sovConstraint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConstraint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConstraint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConstraint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConstraint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConstraint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types1.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConstraint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConstraint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConstraint(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConstraint
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConstraint
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConstraint
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConstraint
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConstraint
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConstraint        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConstraint          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConstraint = fmt.Errorf("proto: unexpected end of group")
+)
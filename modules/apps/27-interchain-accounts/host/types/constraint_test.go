@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestSendAuthorizationAccept(t *testing.T) {
+	recipient := ibctesting.TestAccAddress
+	otherRecipient := sdk.AccAddress([]byte("other-recipient-addr")).String()
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+	msgSend := func(to string, amount sdk.Coins) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: recipient, ToAddress: to, Amount: amount}
+	}
+
+	testCases := []struct {
+		name          string
+		authorization *types.SendAuthorization
+		msg           sdk.Msg
+		expDelete     bool
+		expUpdated    *types.SendAuthorization
+		expPass       bool
+	}{
+		{"within spend limit", types.NewSendAuthorization(coins(100), nil), msgSend(recipient, coins(40)), false, types.NewSendAuthorization(coins(60), nil), true},
+		{"spend limit used up", types.NewSendAuthorization(coins(100), nil), msgSend(recipient, coins(100)), true, nil, true},
+		{"spend limit exceeded", types.NewSendAuthorization(coins(100), nil), msgSend(recipient, coins(101)), false, nil, false},
+		{"denom without spend limit", types.NewSendAuthorization(coins(100), nil), msgSend(recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), false, nil, false},
+		{"allowed recipient without spend limit", types.NewSendAuthorization(nil, []string{recipient}), msgSend(recipient, coins(1000)), false, nil, true},
+		{"recipient not allowed", types.NewSendAuthorization(coins(100), []string{recipient}), msgSend(otherRecipient, coins(1)), false, nil, false},
+		{"unexpected message type", types.NewSendAuthorization(coins(100), nil), &stakingtypes.MsgDelegate{}, false, nil, false},
+	}
+
+	for _, tc := range testCases {
+		resp, err := tc.authorization.Accept(sdk.Context{}, tc.msg)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.True(t, resp.Accept, tc.name)
+			require.Equal(t, tc.expDelete, resp.Delete, tc.name)
+			if tc.expUpdated != nil {
+				require.Equal(t, tc.expUpdated, resp.Updated, tc.name)
+			}
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSendAuthorizationValidateBasic(t *testing.T) {
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	require.NoError(t, types.NewSendAuthorization(spendLimit, nil).ValidateBasic())
+	require.NoError(t, types.NewSendAuthorization(nil, []string{ibctesting.TestAccAddress}).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(nil, nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.ZeroInt()}}, nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(spendLimit, []string{"invalid"}).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(spendLimit, []string{ibctesting.TestAccAddress, ibctesting.TestAccAddress}).ValidateBasic())
+}
+
+func TestMsgConstraint(t *testing.T) {
+	constraint, err := types.NewMsgConstraint(types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), nil), 24)
+	require.NoError(t, err)
+	require.NoError(t, constraint.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(&banktypes.MsgSend{}), constraint.MsgTypeURL())
+
+	windowStart := time.Unix(1000, 0)
+	windowEnd, ends := constraint.WindowEnd(windowStart)
+	require.True(t, ends)
+	require.Equal(t, windowStart.Add(24*time.Hour), windowEnd)
+
+	constraint.DurationHours = 0
+	_, ends = constraint.WindowEnd(windowStart)
+	require.False(t, ends)
+
+	invalid, err := types.NewMsgConstraint(types.NewSendAuthorization(nil, nil), 24)
+	require.NoError(t, err)
+	require.Error(t, invalid.ValidateBasic())
+	require.Error(t, types.MsgConstraint{}.ValidateBasic())
+}
@@ -7,4 +7,10 @@ import (
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrInvalidMsgConstraint  = sdkerrors.Register(SubModuleName, 3, "invalid message constraint")
+	ErrMsgConstraintNotFound = sdkerrors.Register(SubModuleName, 4, "message constraint not found")
+	ErrAllowanceExhausted    = sdkerrors.Register(SubModuleName, 5, "interchain account allowance exhausted")
+	ErrInvalidExecutionQuota = sdkerrors.Register(SubModuleName, 6, "invalid execution quota")
+	ErrQuotaNotFound         = sdkerrors.Register(SubModuleName, 7, "execution quota not found")
+	ErrQuotaExceeded         = sdkerrors.Register(SubModuleName, 8, "execution quota exceeded")
 )
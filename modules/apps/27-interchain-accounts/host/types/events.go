@@ -0,0 +1,12 @@
+package types
+
+// ICS27 host events
+const (
+	EventTypeMsgExecuted = "ics27_msg_executed"
+
+	AttributeKeyPacketSequence = "packet_sequence"
+	AttributeKeyMsgIndex       = "msg_index"
+	AttributeKeyMsgType        = "msg_type"
+	AttributeKeySigners        = "signers"
+	AttributeKeyGasUsed        = "gas_used"
+)
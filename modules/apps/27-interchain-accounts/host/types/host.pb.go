@@ -29,7 +29,18 @@ type Params struct {
 	// host_enabled enables or disables the host submodule.
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	// The wildcard "*" allows all message types and an entry ending with ".*", e.g. "/cosmos.bank.v1beta1.*",
+	// allows all message types with the given prefix.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// allow_queries defines a list of gRPC query paths allowed to be executed on a host chain.
+	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// connection_allow_messages defines the lists of sdk message typeURLs allowed to be executed on a host chain
+	// for interchain accounts controlled through a given connection. A connection allowlist replaces allow_messages
+	// for its connection.
+	ConnectionAllowMessages []ConnectionAllowMessages `protobuf:"bytes,4,rep,name=connection_allow_messages,json=connectionAllowMessages,proto3" json:"connection_allow_messages" yaml:"connection_allow_messages"`
+	// max_gas_per_packet defines the maximum amount of gas which may be consumed by the execution of a single
+	// interchain accounts packet. A value of zero only limits the execution by the gas remaining in the transaction.
+	MaxGasPerPacket uint64 `protobuf:"varint,5,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +90,87 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetConnectionAllowMessages() []ConnectionAllowMessages {
+	if m != nil {
+		return m.ConnectionAllowMessages
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+// ConnectionAllowMessages defines the list of sdk message typeURLs allowed to be executed on a host chain for
+// interchain accounts controlled through the given connection.
+type ConnectionAllowMessages struct {
+	// connection_id defines the host connection identifier.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// allow_messages defines a list of sdk message typeURLs, with the same wildcard semantics as the
+	// allow_messages parameter.
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *ConnectionAllowMessages) Reset()         { *m = ConnectionAllowMessages{} }
+func (m *ConnectionAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*ConnectionAllowMessages) ProtoMessage()    {}
+func (*ConnectionAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *ConnectionAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionAllowMessages.Merge(m, src)
+}
+func (m *ConnectionAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionAllowMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionAllowMessages proto.InternalMessageInfo
+
+func (m *ConnectionAllowMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionAllowMessages) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*ConnectionAllowMessages)(nil), "ibc.applications.interchain_accounts.host.v1.ConnectionAllowMessages")
 }
 
 func init() {
@@ -88,26 +178,35 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd6, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xc9, 0x05, 0x61, 0x74, 0x01,
-	0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6b, 0xd4, 0x40,
+	0x18, 0xdd, 0xb8, 0xb5, 0xe8, 0x74, 0x55, 0x88, 0x95, 0xcd, 0x0a, 0x26, 0x61, 0x4e, 0x39, 0xb8,
+	0x19, 0xda, 0x1e, 0x0a, 0x05, 0x41, 0x23, 0x45, 0x2c, 0x08, 0x6b, 0x8e, 0x5e, 0x86, 0xc9, 0xec,
+	0x90, 0x1d, 0xcc, 0x64, 0x62, 0x66, 0x76, 0x6d, 0xff, 0x85, 0x67, 0xff, 0x82, 0x3f, 0xc2, 0x6b,
+	0x8f, 0x3d, 0x7a, 0x0a, 0xb2, 0xfb, 0x0f, 0xf2, 0x0b, 0x24, 0x99, 0x62, 0x13, 0xed, 0x1e, 0x04,
+	0x4f, 0xc9, 0xfb, 0xde, 0xbc, 0x97, 0xc7, 0xfb, 0x32, 0xe0, 0x98, 0x27, 0x14, 0x91, 0xa2, 0xc8,
+	0x38, 0x25, 0x9a, 0xcb, 0x5c, 0x21, 0x9e, 0x6b, 0x56, 0xd2, 0x05, 0xe1, 0x39, 0x26, 0x94, 0xca,
+	0x65, 0xae, 0x15, 0x5a, 0x48, 0xa5, 0xd1, 0xea, 0xa0, 0x7d, 0x86, 0x45, 0x29, 0xb5, 0xb4, 0x9f,
+	0xf3, 0x84, 0x86, 0x5d, 0x61, 0x78, 0x8b, 0x30, 0x6c, 0x05, 0xab, 0x83, 0xa7, 0xfb, 0xa9, 0x4c,
+	0x65, 0x2b, 0x44, 0xcd, 0x9b, 0xf1, 0x80, 0xdf, 0x87, 0x60, 0x77, 0x46, 0x4a, 0x22, 0x94, 0x7d,
+	0x02, 0x46, 0xcd, 0x59, 0xcc, 0x72, 0x92, 0x64, 0x6c, 0xee, 0x58, 0xbe, 0x15, 0xdc, 0x8b, 0xc6,
+	0x75, 0xe5, 0x3d, 0xbe, 0x20, 0x22, 0x3b, 0x81, 0x5d, 0x16, 0xc6, 0x7b, 0x0d, 0x3c, 0x35, 0xc8,
+	0x7e, 0x09, 0x1e, 0x92, 0x2c, 0x93, 0x9f, 0xb1, 0x60, 0x4a, 0x91, 0x94, 0x29, 0xe7, 0x8e, 0x3f,
+	0x0c, 0xee, 0x47, 0x93, 0xba, 0xf2, 0x9e, 0x18, 0x75, 0x9f, 0x87, 0xf1, 0x83, 0x76, 0xf0, 0xee,
+	0x1a, 0xdb, 0x2f, 0x80, 0x19, 0xe0, 0x4f, 0x4b, 0x56, 0x72, 0xa6, 0x9c, 0x61, 0x6b, 0xe0, 0xd4,
+	0x95, 0xb7, 0xdf, 0x35, 0xb8, 0xa6, 0x61, 0x3c, 0x6a, 0xf1, 0x7b, 0x03, 0xed, 0x6f, 0x16, 0x98,
+	0x50, 0x99, 0xe7, 0x8c, 0x36, 0x4d, 0xe0, 0x3f, 0xc2, 0xec, 0xf8, 0xc3, 0x60, 0xef, 0xf0, 0x34,
+	0xfc, 0x97, 0xc2, 0xc2, 0xd7, 0xbf, 0xed, 0x5e, 0x75, 0x93, 0x46, 0xc1, 0x65, 0xe5, 0x0d, 0xea,
+	0xca, 0xf3, 0x4d, 0xac, 0xad, 0x5f, 0x85, 0xf1, 0x98, 0xde, 0x6e, 0x61, 0x9f, 0x01, 0x5b, 0x90,
+	0x73, 0x9c, 0x12, 0x85, 0x0b, 0x56, 0xe2, 0x82, 0xd0, 0x8f, 0x4c, 0x3b, 0x77, 0x7d, 0x2b, 0xd8,
+	0x89, 0x9e, 0xd5, 0x95, 0x37, 0x31, 0xd6, 0x7f, 0x9f, 0x81, 0xf1, 0x23, 0x41, 0xce, 0xdf, 0x10,
+	0x35, 0x63, 0xe5, 0xcc, 0x4c, 0xbe, 0x5a, 0x60, 0xbc, 0x25, 0x6a, 0x53, 0x6a, 0x27, 0x1e, 0x37,
+	0x3b, 0xed, 0x95, 0xda, 0xa3, 0x61, 0x3c, 0xba, 0xc1, 0x6f, 0xff, 0xc3, 0x56, 0xa3, 0xf9, 0xe5,
+	0xda, 0xb5, 0xae, 0xd6, 0xae, 0xf5, 0x73, 0xed, 0x5a, 0x5f, 0x36, 0xee, 0xe0, 0x6a, 0xe3, 0x0e,
+	0x7e, 0x6c, 0xdc, 0xc1, 0x87, 0xb3, 0x94, 0xeb, 0xc5, 0x32, 0x09, 0xa9, 0x14, 0x88, 0x4a, 0x25,
+	0xa4, 0x42, 0x3c, 0xa1, 0xd3, 0x54, 0xa2, 0xd5, 0x11, 0x12, 0x72, 0xbe, 0xcc, 0x98, 0x6a, 0x6e,
+	0x85, 0x42, 0x87, 0xc7, 0xd3, 0x9b, 0x35, 0x4d, 0xfb, 0x17, 0x42, 0x5f, 0x14, 0x4c, 0x25, 0xbb,
+	0xed, 0xbf, 0x7c, 0xf4, 0x6b, 0x00, 0x94, 0x27, 0x14, 0xa5, 0x4a, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +229,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConnectionAllowMessages) > 0 {
+		for iNdEx := len(m.ConnectionAllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionAllowMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -152,6 +279,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -178,6 +344,40 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.ConnectionAllowMessages) > 0 {
+		for _, e := range m.ConnectionAllowMessages {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasPerPacket))
+	}
+	return n
+}
+
+func (m *ConnectionAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -268,6 +468,205 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionAllowMessages = append(m.ConnectionAllowMessages, ConnectionAllowMessages{})
+			if err := m.ConnectionAllowMessages[len(m.ConnectionAllowMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
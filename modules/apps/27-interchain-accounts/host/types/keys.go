@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,20 +13,89 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// RouterKey is the governance proposal routing key for the interchain accounts host module
+	RouterKey = SubModuleName
 )
 
-// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
+// ContainsMsgType returns true if the sdk.Msg TypeURL is allowed by allowMsgs, otherwise false.
+// The TypeURL is allowed if allowMsgs contains the "*" wildcard, the TypeURL itself, or a
+// prefix of the TypeURL followed by the ".*" wildcard suffix.
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
-	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
-	if len(allowMsgs) == 1 && allowMsgs[0] == "*" {
-		return true
-	}
+	typeURL := sdk.MsgTypeURL(msg)
 
 	for _, v := range allowMsgs {
-		if v == sdk.MsgTypeURL(msg) {
+		switch {
+		case v == AllowAllMessages, v == typeURL:
 			return true
+		case strings.HasSuffix(v, MessagePrefixWildcardSuffix):
+			// the "." of the suffix is retained to match whole package or message name segments only
+			if strings.HasPrefix(typeURL, strings.TrimSuffix(v, AllowAllMessages)) {
+				return true
+			}
 		}
 	}
 
 	return false
 }
+
+// ContainsQueryPath returns true if the gRPC query path is present in allowQueries, otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	for _, v := range allowQueries {
+		if v == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	// MsgConstraintPrefix defines the key prefix used to store message constraints
+	MsgConstraintPrefix = "msgConstraint"
+
+	// AccountAllowancePrefix defines the key prefix used to store the allowances of interchain accounts
+	AccountAllowancePrefix = "accountAllowance"
+)
+
+// KeyMsgConstraint creates and returns a new key used for the constraint of the given sdk message type
+func KeyMsgConstraint(msgTypeURL string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", MsgConstraintPrefix, msgTypeURL))
+}
+
+// KeyAccountAllowancePrefix creates and returns a new key prefix used for the allowances of all interchain
+// accounts for the given sdk message type
+func KeyAccountAllowancePrefix(msgTypeURL string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", AccountAllowancePrefix, msgTypeURL))
+}
+
+// KeyAccountAllowance creates and returns a new key used for the allowance of the given interchain account
+// for the given sdk message type
+func KeyAccountAllowance(msgTypeURL, address string) []byte {
+	return append(KeyAccountAllowancePrefix(msgTypeURL), []byte(address)...)
+}
+
+const (
+	// ExecutionQuotaPrefix defines the key prefix used to store execution quotas
+	ExecutionQuotaPrefix = "executionQuota"
+
+	// QuotaUsagePrefix defines the key prefix used to store the usage of execution quotas
+	QuotaUsagePrefix = "quotaUsage"
+)
+
+// KeyExecutionQuota creates and returns a new key used for the execution quota of the given connection
+func KeyExecutionQuota(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ExecutionQuotaPrefix, connectionID))
+}
+
+// KeyQuotaUsagePrefix creates and returns a new key prefix used for the usages of the execution quota of the given
+// connection. The usage of all interchain accounts controlled through the connection is stored at the prefix itself.
+func KeyQuotaUsagePrefix(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", QuotaUsagePrefix, connectionID))
+}
+
+// KeyQuotaUsage creates and returns a new key used for the usage of the execution quota of the given connection by
+// the given interchain account, or by all interchain accounts controlled through the connection if the address is empty
+func KeyQuotaUsage(connectionID, address string) []byte {
+	return append(KeyQuotaUsagePrefix(connectionID), []byte(address)...)
+}
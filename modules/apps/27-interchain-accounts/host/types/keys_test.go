@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestContainsMsgType(t *testing.T) {
+	testCases := []struct {
+		name      string
+		allowMsgs []string
+		msg       sdk.Msg
+		expPass   bool
+	}{
+		{"wildcard", []string{"*"}, &banktypes.MsgSend{}, true},
+		{"wildcard with message types", []string{"/cosmos.staking.v1beta1.MsgDelegate", "*"}, &banktypes.MsgSend{}, true},
+		{"message type", []string{"/cosmos.bank.v1beta1.MsgSend"}, &banktypes.MsgSend{}, true},
+		{"package prefix wildcard", []string{"/cosmos.bank.v1beta1.*"}, &banktypes.MsgSend{}, true},
+		{"namespace prefix wildcard", []string{"/cosmos.*"}, &stakingtypes.MsgDelegate{}, true},
+		{"empty allowlist", nil, &banktypes.MsgSend{}, false},
+		{"message type not allowed", []string{"/cosmos.bank.v1beta1.MsgMultiSend"}, &banktypes.MsgSend{}, false},
+		{"package prefix wildcard not matching", []string{"/cosmos.bank.v1beta1.*"}, &stakingtypes.MsgDelegate{}, false},
+		{"partial segment prefix wildcard not matching", []string{"/cosmos.bank.v1.*"}, &banktypes.MsgSend{}, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPass, types.ContainsMsgType(tc.allowMsgs, tc.msg), tc.name)
+	}
+}
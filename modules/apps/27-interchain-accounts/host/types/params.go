@@ -5,11 +5,22 @@ import (
 	"strings"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+
+	// AllowAllMessages is the wildcard allowing all message types to be executed on the host
+	AllowAllMessages = "*"
+	// MessagePrefixWildcardSuffix is the suffix of an allowlist entry allowing all message types with the preceding prefix
+	MessagePrefixWildcardSuffix = ".*"
+
+	// DefaultMaxGasPerPacket is the default value for the max gas per packet param (set to 0, no limit other than
+	// the gas remaining in the transaction)
+	DefaultMaxGasPerPacket = 0
 )
 
 var (
@@ -17,6 +28,12 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyAllowQueries is the store key for the AllowQueries Params
+	KeyAllowQueries = []byte("AllowQueries")
+	// KeyConnectionAllowMessages is the store key for the ConnectionAllowMessages Params
+	KeyConnectionAllowMessages = []byte("ConnectionAllowMessages")
+	// KeyMaxGasPerPacket is the store key for the MaxGasPerPacket Params
+	KeyMaxGasPerPacket = []byte("MaxGasPerPacket")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -25,16 +42,27 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(enableHost bool, allowMsgs, allowQueries []string, connectionAllowMsgs []ConnectionAllowMessages, maxGasPerPacket uint64) Params {
 	return Params{
-		HostEnabled:   enableHost,
+		HostEnabled:             enableHost,
+		AllowMessages:           allowMsgs,
+		AllowQueries:            allowQueries,
+		ConnectionAllowMessages: connectionAllowMsgs,
+		MaxGasPerPacket:         maxGasPerPacket,
+	}
+}
+
+// NewConnectionAllowMessages creates a new allowlist of message types for the provided connection
+func NewConnectionAllowMessages(connectionID string, allowMsgs []string) ConnectionAllowMessages {
+	return ConnectionAllowMessages{
+		ConnectionId:  connectionID,
 		AllowMessages: allowMsgs,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	return NewParams(DefaultHostEnabled, nil, nil, nil, DefaultMaxGasPerPacket)
 }
 
 // Validate validates all host submodule parameters
@@ -43,18 +71,42 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	if err := validateAllowlist(p.AllowMessages); err != nil {
+	if err := validateAllowMessages(p.AllowMessages); err != nil {
 		return err
 	}
 
-	return nil
+	if err := validateAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateConnectionAllowMessages(p.ConnectionAllowMessages); err != nil {
+		return err
+	}
+
+	return validateMaxGasPerPacket(p.MaxGasPerPacket)
+}
+
+// AllowMessagesForConnection returns the message types allowed to be executed by interchain accounts controlled
+// through the provided connection. The connection allowlist is returned if it exists, otherwise the
+// AllowMessages parameter is returned. The returned boolean is true if the allowlist is connection specific.
+func (p Params) AllowMessagesForConnection(connectionID string) ([]string, bool) {
+	for _, connectionAllowMsgs := range p.ConnectionAllowMessages {
+		if connectionAllowMsgs.ConnectionId == connectionID {
+			return connectionAllowMsgs.AllowMessages, true
+		}
+	}
+
+	return p.AllowMessages, false
 }
 
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
-		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowMessages),
+		paramtypes.NewParamSetPair(KeyAllowQueries, p.AllowQueries, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyConnectionAllowMessages, &p.ConnectionAllowMessages, validateConnectionAllowMessages),
+		paramtypes.NewParamSetPair(KeyMaxGasPerPacket, p.MaxGasPerPacket, validateMaxGasPerPacket),
 	}
 }
 
@@ -67,17 +119,99 @@ func validateEnabled(i interface{}) error {
 	return nil
 }
 
+func validateMaxGasPerPacket(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateAllowlist(i interface{}) error {
+	allowlist, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, entry := range allowlist {
+		if strings.TrimSpace(entry) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", allowlist)
+		}
+	}
+
+	return nil
+}
+
+func validateAllowMessages(i interface{}) error {
 	allowMsgs, ok := i.([]string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	seen := make(map[string]bool)
 	for _, typeURL := range allowMsgs {
-		if strings.TrimSpace(typeURL) == "" {
-			return fmt.Errorf("parameter must not contain empty strings: %s", allowMsgs)
+		if err := ValidateAllowMessage(typeURL); err != nil {
+			return err
+		}
+
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate allowed message type %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+func validateConnectionAllowMessages(i interface{}) error {
+	connectionAllowMsgs, ok := i.([]ConnectionAllowMessages)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, allowlist := range connectionAllowMsgs {
+		if err := host.ConnectionIdentifierValidator(allowlist.ConnectionId); err != nil {
+			return fmt.Errorf("invalid connection allowlist: %w", err)
+		}
+
+		if seen[allowlist.ConnectionId] {
+			return fmt.Errorf("duplicate allowlist for connection %s", allowlist.ConnectionId)
+		}
+		seen[allowlist.ConnectionId] = true
+
+		if err := validateAllowMessages(allowlist.AllowMessages); err != nil {
+			return fmt.Errorf("invalid allowlist for connection %s: %w", allowlist.ConnectionId, err)
 		}
 	}
 
 	return nil
 }
+
+// ValidateAllowMessage validates an allowlist entry. An entry is either the "*" wildcard, a message typeURL
+// or a message typeURL prefix followed by the ".*" wildcard suffix, e.g. "/cosmos.bank.v1beta1.*".
+func ValidateAllowMessage(typeURL string) error {
+	if strings.TrimSpace(typeURL) == "" {
+		return fmt.Errorf("allowed message type cannot be blank")
+	}
+
+	if typeURL == AllowAllMessages {
+		return nil
+	}
+
+	if !strings.HasPrefix(typeURL, "/") {
+		return fmt.Errorf("allowed message type %s must start with '/'", typeURL)
+	}
+
+	prefix := strings.TrimSuffix(typeURL, MessagePrefixWildcardSuffix)
+	if strings.Contains(prefix, AllowAllMessages) {
+		return fmt.Errorf("allowed message type %s may only contain a wildcard as the %s suffix", typeURL, MessagePrefixWildcardSuffix)
+	}
+
+	if prefix == "/" {
+		return fmt.Errorf("allowed message type %s must contain a prefix before the %s suffix", typeURL, MessagePrefixWildcardSuffix)
+	}
+
+	return nil
+}
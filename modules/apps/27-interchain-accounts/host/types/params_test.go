@@ -10,5 +10,65 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(false, []string{}, []string{}, nil, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, []string{"/cosmos.bank.v1beta1.Query/Balance"}, nil, 0).Validate())
+	require.NoError(t, types.NewParams(true, nil, nil, nil, 1000000).Validate())
+	require.Error(t, types.NewParams(true, nil, []string{" "}, nil, 0).Validate())
+}
+
+func TestValidateAllowMessages(t *testing.T) {
+	testCases := []struct {
+		name                string
+		allowMsgs           []string
+		connectionAllowMsgs []types.ConnectionAllowMessages
+		expPass             bool
+	}{
+		{"wildcard", []string{"*"}, nil, true},
+		{"wildcard with message types", []string{"/cosmos.bank.v1beta1.MsgSend", "*"}, nil, true},
+		{"message type", []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, true},
+		{"package prefix wildcard", []string{"/cosmos.staking.v1beta1.*", "/cosmos.gov.*"}, nil, true},
+		{"connection allowlists", []string{"/cosmos.bank.v1beta1.MsgSend"}, []types.ConnectionAllowMessages{
+			types.NewConnectionAllowMessages("connection-0", []string{"/cosmos.gov.v1beta1.*", "/cosmos.staking.v1beta1.*"}),
+			types.NewConnectionAllowMessages("connection-1", nil),
+		}, true},
+		{"blank message type", []string{" "}, nil, false},
+		{"message type without leading slash", []string{"cosmos.bank.v1beta1.MsgSend"}, nil, false},
+		{"duplicate message type", []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}, nil, false},
+		{"wildcard without leading dot", []string{"/cosmos.bank.v1beta1*"}, nil, false},
+		{"wildcard in the middle", []string{"/cosmos.*.MsgSend"}, nil, false},
+		{"wildcard without prefix", []string{"/.*"}, nil, false},
+		{"invalid connection identifier", nil, []types.ConnectionAllowMessages{
+			types.NewConnectionAllowMessages("", []string{"*"}),
+		}, false},
+		{"duplicate connection allowlist", nil, []types.ConnectionAllowMessages{
+			types.NewConnectionAllowMessages("connection-0", []string{"*"}),
+			types.NewConnectionAllowMessages("connection-0", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+		}, false},
+		{"invalid connection allowlist", nil, []types.ConnectionAllowMessages{
+			types.NewConnectionAllowMessages("connection-0", []string{"cosmos.bank.v1beta1.MsgSend"}),
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.NewParams(true, tc.allowMsgs, nil, tc.connectionAllowMsgs, 0).Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAllowMessagesForConnection(t *testing.T) {
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil, []types.ConnectionAllowMessages{
+		types.NewConnectionAllowMessages("connection-0", []string{"/cosmos.gov.v1beta1.*"}),
+	}, 0)
+
+	allowMsgs, connectionSpecific := params.AllowMessagesForConnection("connection-0")
+	require.True(t, connectionSpecific)
+	require.Equal(t, []string{"/cosmos.gov.v1beta1.*"}, allowMsgs)
+
+	allowMsgs, connectionSpecific = params.AllowMessagesForConnection("connection-1")
+	require.False(t, connectionSpecific)
+	require.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, allowMsgs)
 }
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeSetMsgConstraint defines the type for a SetMsgConstraintProposal
+	ProposalTypeSetMsgConstraint = "SetMsgConstraint"
+	// ProposalTypeRemoveMsgConstraint defines the type for a RemoveMsgConstraintProposal
+	ProposalTypeRemoveMsgConstraint = "RemoveMsgConstraint"
+	// ProposalTypeSetExecutionQuota defines the type for a SetExecutionQuotaProposal
+	ProposalTypeSetExecutionQuota = "SetExecutionQuota"
+	// ProposalTypeRemoveExecutionQuota defines the type for a RemoveExecutionQuotaProposal
+	ProposalTypeRemoveExecutionQuota = "RemoveExecutionQuota"
+)
+
+var (
+	_ govtypes.Content = &SetMsgConstraintProposal{}
+	_ govtypes.Content = &RemoveMsgConstraintProposal{}
+	_ govtypes.Content = &SetExecutionQuotaProposal{}
+	_ govtypes.Content = &RemoveExecutionQuotaProposal{}
+
+	_ codectypes.UnpackInterfacesMessage = &SetMsgConstraintProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetMsgConstraint)
+	govtypes.RegisterProposalType(ProposalTypeRemoveMsgConstraint)
+	govtypes.RegisterProposalType(ProposalTypeSetExecutionQuota)
+	govtypes.RegisterProposalType(ProposalTypeRemoveExecutionQuota)
+}
+
+// NewSetMsgConstraintProposal creates a new set message constraint proposal.
+func NewSetMsgConstraintProposal(title, description string, constraint MsgConstraint) govtypes.Content {
+	return &SetMsgConstraintProposal{
+		Title:       title,
+		Description: description,
+		Constraint:  constraint,
+	}
+}
+
+// GetTitle returns the title of a set message constraint proposal.
+func (p *SetMsgConstraintProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set message constraint proposal.
+func (p *SetMsgConstraintProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set message constraint proposal.
+func (p *SetMsgConstraintProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set message constraint proposal.
+func (p *SetMsgConstraintProposal) ProposalType() string { return ProposalTypeSetMsgConstraint }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetMsgConstraintProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Constraint.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p *SetMsgConstraintProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return p.Constraint.UnpackInterfaces(unpacker)
+}
+
+// NewRemoveMsgConstraintProposal creates a new remove message constraint proposal.
+func NewRemoveMsgConstraintProposal(title, description, msgTypeURL string) govtypes.Content {
+	return &RemoveMsgConstraintProposal{
+		Title:       title,
+		Description: description,
+		MsgTypeUrl:  msgTypeURL,
+	}
+}
+
+// GetTitle returns the title of a remove message constraint proposal.
+func (p *RemoveMsgConstraintProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove message constraint proposal.
+func (p *RemoveMsgConstraintProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove message constraint proposal.
+func (p *RemoveMsgConstraintProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove message constraint proposal.
+func (p *RemoveMsgConstraintProposal) ProposalType() string { return ProposalTypeRemoveMsgConstraint }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveMsgConstraintProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(p.MsgTypeUrl, "/") {
+		return sdkerrors.Wrapf(ErrInvalidMsgConstraint, "invalid message type url: %s", p.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+// NewSetExecutionQuotaProposal creates a new set execution quota proposal.
+func NewSetExecutionQuotaProposal(title, description string, quota ExecutionQuota) govtypes.Content {
+	return &SetExecutionQuotaProposal{
+		Title:       title,
+		Description: description,
+		Quota:       quota,
+	}
+}
+
+// GetTitle returns the title of a set execution quota proposal.
+func (p *SetExecutionQuotaProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set execution quota proposal.
+func (p *SetExecutionQuotaProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set execution quota proposal.
+func (p *SetExecutionQuotaProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set execution quota proposal.
+func (p *SetExecutionQuotaProposal) ProposalType() string { return ProposalTypeSetExecutionQuota }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetExecutionQuotaProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Quota.ValidateBasic()
+}
+
+// NewRemoveExecutionQuotaProposal creates a new remove execution quota proposal.
+func NewRemoveExecutionQuotaProposal(title, description, connectionID string) govtypes.Content {
+	return &RemoveExecutionQuotaProposal{
+		Title:        title,
+		Description:  description,
+		ConnectionId: connectionID,
+	}
+}
+
+// GetTitle returns the title of a remove execution quota proposal.
+func (p *RemoveExecutionQuotaProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove execution quota proposal.
+func (p *RemoveExecutionQuotaProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove execution quota proposal.
+func (p *RemoveExecutionQuotaProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove execution quota proposal.
+func (p *RemoveExecutionQuotaProposal) ProposalType() string { return ProposalTypeRemoveExecutionQuota }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveExecutionQuotaProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := host.ConnectionIdentifierValidator(p.ConnectionId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidExecutionQuota, "invalid connection identifier: %s", err)
+	}
+
+	return nil
+}
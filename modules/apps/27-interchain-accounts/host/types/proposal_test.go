@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestProposalValidateBasic(t *testing.T) {
+	constraint, err := types.NewMsgConstraint(types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), nil), 24)
+	require.NoError(t, err)
+
+	invalidConstraint, err := types.NewMsgConstraint(types.NewSendAuthorization(nil, nil), 24)
+	require.NoError(t, err)
+
+	quota := types.NewExecutionQuota("connection-0", 100, types.NewQuotaLimits(10, 0), types.NewQuotaLimits(0, 1000000))
+
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{"valid set proposal", types.NewSetMsgConstraintProposal("title", "description", constraint), true},
+		{"set proposal with invalid constraint", types.NewSetMsgConstraintProposal("title", "description", invalidConstraint), false},
+		{"set proposal without authorization", types.NewSetMsgConstraintProposal("title", "description", types.MsgConstraint{}), false},
+		{"set proposal without title", types.NewSetMsgConstraintProposal("", "description", constraint), false},
+		{"valid remove proposal", types.NewRemoveMsgConstraintProposal("title", "description", "/cosmos.bank.v1beta1.MsgSend"), true},
+		{"remove proposal with invalid message type", types.NewRemoveMsgConstraintProposal("title", "description", "cosmos.bank.v1beta1.MsgSend"), false},
+		{"remove proposal without description", types.NewRemoveMsgConstraintProposal("title", "", "/cosmos.bank.v1beta1.MsgSend"), false},
+		{"valid set quota proposal", types.NewSetExecutionQuotaProposal("title", "description", quota), true},
+		{"set quota proposal with invalid quota", types.NewSetExecutionQuotaProposal("title", "description", types.ExecutionQuota{}), false},
+		{"set quota proposal without title", types.NewSetExecutionQuotaProposal("", "description", quota), false},
+		{"valid remove quota proposal", types.NewRemoveExecutionQuotaProposal("title", "description", "connection-0"), true},
+		{"remove quota proposal with invalid connection", types.NewRemoveExecutionQuotaProposal("title", "description", "invalid|connection"), false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute(), tc.name)
+
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewExecutionQuota creates a new ExecutionQuota instance
+func NewExecutionQuota(connectionID string, windowBlocks uint64, connectionLimits, accountLimits QuotaLimits) ExecutionQuota {
+	return ExecutionQuota{
+		ConnectionId:     connectionID,
+		WindowBlocks:     windowBlocks,
+		ConnectionLimits: connectionLimits,
+		AccountLimits:    accountLimits,
+	}
+}
+
+// NewQuotaLimits creates a new QuotaLimits instance
+func NewQuotaLimits(maxPackets, maxGas uint64) QuotaLimits {
+	return QuotaLimits{
+		MaxPackets: maxPackets,
+		MaxGas:     maxGas,
+	}
+}
+
+// ValidateBasic performs a basic validation of the ExecutionQuota fields
+func (q ExecutionQuota) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(q.ConnectionId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidExecutionQuota, "invalid connection identifier: %s", err)
+	}
+
+	if q.WindowBlocks == 0 {
+		return sdkerrors.Wrap(ErrInvalidExecutionQuota, "window blocks cannot be zero")
+	}
+
+	if q.ConnectionLimits.IsUnlimited() && q.AccountLimits.IsUnlimited() {
+		return sdkerrors.Wrap(ErrInvalidExecutionQuota, "connection and account limits cannot both be unlimited")
+	}
+
+	return nil
+}
+
+// WindowEnd returns the block height at which a window of the quota started at the given height ends
+func (q ExecutionQuota) WindowEnd(windowStartHeight int64) int64 {
+	return windowStartHeight + int64(q.WindowBlocks)
+}
+
+// IsUnlimited returns true if neither the number of packets nor the gas is limited
+func (l QuotaLimits) IsUnlimited() bool {
+	return l.MaxPackets == 0 && l.MaxGas == 0
+}
+
+// Check returns an error if the usage has reached the limits, such that another packet may not be executed
+func (l QuotaLimits) Check(usage QuotaUsage) error {
+	if l.MaxPackets != 0 && usage.Packets >= l.MaxPackets {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "packet limit of %d reached", l.MaxPackets)
+	}
+
+	if l.MaxGas != 0 && usage.Gas >= l.MaxGas {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "gas limit of %d reached", l.MaxGas)
+	}
+
+	return nil
+}
+
+// RemainingGas returns the gas which may still be consumed given the usage, false if the gas is not limited
+func (l QuotaLimits) RemainingGas(usage QuotaUsage) (uint64, bool) {
+	if l.MaxGas == 0 {
+		return 0, false
+	}
+
+	if usage.Gas >= l.MaxGas {
+		return 0, true
+	}
+
+	return l.MaxGas - usage.Gas, true
+}
+
+// NewQuotaUsage creates a new QuotaUsage instance. An empty address is used for the usage of all interchain
+// accounts controlled through the connection.
+func NewQuotaUsage(connectionID, address string, windowStartHeight int64, packets, gas uint64) QuotaUsage {
+	return QuotaUsage{
+		ConnectionId:      connectionID,
+		Address:           address,
+		WindowStartHeight: windowStartHeight,
+		Packets:           packets,
+		Gas:               gas,
+	}
+}
+
+// Validate performs a basic validation of the QuotaUsage fields
+func (u QuotaUsage) Validate() error {
+	if err := host.ConnectionIdentifierValidator(u.ConnectionId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidExecutionQuota, "invalid connection identifier: %s", err)
+	}
+
+	if u.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(u.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid interchain account address: %s", err)
+		}
+	}
+
+	if u.WindowStartHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidExecutionQuota, "window start height must be positive")
+	}
+
+	return nil
+}
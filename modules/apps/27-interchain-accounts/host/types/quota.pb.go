@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/host/v1/quota.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExecutionQuota limits the number of packets executed and the gas consumed by the execution of interchain accounts
+// packets received through a connection within a window of blocks. The limits apply to the packets of all interchain
+// accounts controlled through the connection together, as well as to the packets of each interchain account.
+type ExecutionQuota struct {
+	// connection_id defines the host connection identifier.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the number of blocks after which the usage of the quota is reset
+	WindowBlocks uint64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+	// the limits for all interchain accounts controlled through the connection
+	ConnectionLimits QuotaLimits `protobuf:"bytes,3,opt,name=connection_limits,json=connectionLimits,proto3" json:"connection_limits" yaml:"connection_limits"`
+	// the limits for each interchain account controlled through the connection
+	AccountLimits QuotaLimits `protobuf:"bytes,4,opt,name=account_limits,json=accountLimits,proto3" json:"account_limits" yaml:"account_limits"`
+}
+
+func (m *ExecutionQuota) Reset()         { *m = ExecutionQuota{} }
+func (m *ExecutionQuota) String() string { return proto.CompactTextString(m) }
+func (*ExecutionQuota) ProtoMessage()    {}
+func (*ExecutionQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de83b9bf39cf9af6, []int{0}
+}
+func (m *ExecutionQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionQuota.Merge(m, src)
+}
+func (m *ExecutionQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionQuota proto.InternalMessageInfo
+
+func (m *ExecutionQuota) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ExecutionQuota) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *ExecutionQuota) GetConnectionLimits() QuotaLimits {
+	if m != nil {
+		return m.ConnectionLimits
+	}
+	return QuotaLimits{}
+}
+
+func (m *ExecutionQuota) GetAccountLimits() QuotaLimits {
+	if m != nil {
+		return m.AccountLimits
+	}
+	return QuotaLimits{}
+}
+
+// QuotaLimits defines the maximum number of packets and the maximum amount of gas within a window of an execution
+// quota. A value of zero does not limit the number of packets or the gas respectively.
+type QuotaLimits struct {
+	MaxPackets uint64 `protobuf:"varint,1,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty" yaml:"max_packets"`
+	MaxGas     uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+}
+
+func (m *QuotaLimits) Reset()         { *m = QuotaLimits{} }
+func (m *QuotaLimits) String() string { return proto.CompactTextString(m) }
+func (*QuotaLimits) ProtoMessage()    {}
+func (*QuotaLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de83b9bf39cf9af6, []int{1}
+}
+func (m *QuotaLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaLimits.Merge(m, src)
+}
+func (m *QuotaLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaLimits proto.InternalMessageInfo
+
+func (m *QuotaLimits) GetMaxPackets() uint64 {
+	if m != nil {
+		return m.MaxPackets
+	}
+	return 0
+}
+
+func (m *QuotaLimits) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+// QuotaUsage tracks the packets executed and the gas consumed within the current window of an execution quota, either
+// by all interchain accounts controlled through the connection or by a single interchain account.
+type QuotaUsage struct {
+	// connection_id defines the host connection identifier.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// interchain account address, empty for the usage of all interchain accounts controlled through the connection
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the block height at which the current window started
+	WindowStartHeight int64 `protobuf:"varint,3,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty" yaml:"window_start_height"`
+	// the number of packets executed within the current window
+	Packets uint64 `protobuf:"varint,4,opt,name=packets,proto3" json:"packets,omitempty"`
+	// the gas consumed within the current window
+	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de83b9bf39cf9af6, []int{2}
+}
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func (m *QuotaUsage) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetPackets() uint64 {
+	if m != nil {
+		return m.Packets
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ExecutionQuota)(nil), "ibc.applications.interchain_accounts.host.v1.ExecutionQuota")
+	proto.RegisterType((*QuotaLimits)(nil), "ibc.applications.interchain_accounts.host.v1.QuotaLimits")
+	proto.RegisterType((*QuotaUsage)(nil), "ibc.applications.interchain_accounts.host.v1.QuotaUsage")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/host/v1/quota.proto", fileDescriptor_de83b9bf39cf9af6)
+}
+
+var fileDescriptor_de83b9bf39cf9af6 = []byte{
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x49, 0x68, 0xd5, 0x6d, 0x1b, 0xb5, 0x4b, 0x41, 0x56, 0x25, 0xec, 0x68, 0x4f, 0x91,
+	0x20, 0x5e, 0xb5, 0x3d, 0x14, 0x90, 0xb8, 0x58, 0x42, 0x7c, 0x08, 0x21, 0x30, 0xe2, 0xc2, 0x25,
+	0x5a, 0xaf, 0x2d, 0x67, 0xd5, 0xd8, 0x6b, 0xb2, 0xeb, 0x34, 0x3d, 0x71, 0xe5, 0xc8, 0x99, 0x5f,
+	0xd4, 0x63, 0x8f, 0x9c, 0x2c, 0x48, 0xfe, 0x81, 0x7f, 0x01, 0xda, 0xdd, 0x58, 0xb1, 0x05, 0x17,
+	0xd4, 0x9b, 0xdf, 0x9b, 0x7d, 0x6f, 0x66, 0x3c, 0x33, 0xe0, 0x09, 0x0b, 0x29, 0x26, 0x79, 0x3e,
+	0x65, 0x94, 0x48, 0xc6, 0x33, 0x81, 0x59, 0x26, 0xe3, 0x19, 0x9d, 0x10, 0x96, 0x8d, 0x09, 0xa5,
+	0xbc, 0xc8, 0xa4, 0xc0, 0x13, 0x2e, 0x24, 0x9e, 0x9f, 0xe0, 0x2f, 0x05, 0x97, 0xc4, 0xcb, 0x67,
+	0x5c, 0x72, 0xf8, 0x98, 0x85, 0xd4, 0x6b, 0x2a, 0xbd, 0x7f, 0x28, 0x3d, 0xa5, 0xf4, 0xe6, 0x27,
+	0xc7, 0x47, 0x09, 0x4f, 0xb8, 0x16, 0x62, 0xf5, 0x65, 0x3c, 0xd0, 0x8f, 0x2e, 0xe8, 0xbf, 0x58,
+	0xc4, 0xb4, 0x50, 0x06, 0x1f, 0x94, 0x39, 0x7c, 0x0e, 0xf6, 0x29, 0xcf, 0xb2, 0x98, 0x2a, 0x6a,
+	0xcc, 0x22, 0xdb, 0x1a, 0x58, 0xc3, 0x1d, 0xdf, 0xae, 0x4a, 0xf7, 0xe8, 0x8a, 0xa4, 0xd3, 0x67,
+	0xa8, 0x15, 0x46, 0xc1, 0xde, 0x06, 0xbf, 0x8e, 0x94, 0xfc, 0x92, 0x65, 0x11, 0xbf, 0x1c, 0x87,
+	0x53, 0x4e, 0x2f, 0x84, 0x7d, 0x67, 0x60, 0x0d, 0x7b, 0x4d, 0x79, 0x2b, 0x8c, 0x82, 0x3d, 0x83,
+	0x7d, 0x0d, 0xe1, 0x37, 0x0b, 0x1c, 0x36, 0xfc, 0xa7, 0x2c, 0x65, 0x52, 0xd8, 0xdd, 0x81, 0x35,
+	0xdc, 0x3d, 0x7d, 0xea, 0xfd, 0x4f, 0xc7, 0x9e, 0x6e, 0xe7, 0xad, 0x36, 0xf0, 0x07, 0xd7, 0xa5,
+	0xdb, 0xa9, 0x4a, 0xd7, 0xfe, 0xab, 0x03, 0x93, 0x01, 0x05, 0x07, 0x1b, 0xce, 0x68, 0xe0, 0x57,
+	0xd0, 0x5f, 0x7b, 0xd6, 0x65, 0xf4, 0x6e, 0x5b, 0xc6, 0xc3, 0x75, 0x19, 0xf7, 0x4d, 0x19, 0x6d,
+	0x7b, 0x14, 0xec, 0xaf, 0x09, 0xf3, 0x1a, 0x09, 0xb0, 0xdb, 0x10, 0xc3, 0x73, 0xb0, 0x9b, 0x92,
+	0xc5, 0x38, 0x27, 0xf4, 0x22, 0x96, 0x42, 0x8f, 0xa5, 0xe7, 0x3f, 0xa8, 0x4a, 0x17, 0x1a, 0xb7,
+	0x46, 0x10, 0x05, 0x20, 0x25, 0x8b, 0xf7, 0x06, 0xc0, 0x47, 0x60, 0x5b, 0xc5, 0x12, 0x52, 0x0f,
+	0x03, 0x56, 0xa5, 0xdb, 0xdf, 0x88, 0x12, 0x22, 0x50, 0xb0, 0x95, 0x92, 0xc5, 0x4b, 0x22, 0xd0,
+	0x6f, 0x0b, 0x00, 0x9d, 0xf5, 0x93, 0x20, 0x49, 0x7c, 0xdb, 0x6d, 0xb0, 0xc1, 0x36, 0x89, 0xa2,
+	0x59, 0x2c, 0x4c, 0xea, 0x9d, 0xa0, 0x86, 0xf0, 0x1d, 0xb8, 0xb7, 0x5e, 0x04, 0x21, 0xc9, 0x4c,
+	0x8e, 0x27, 0x31, 0x4b, 0x26, 0x52, 0x4f, 0xba, 0xeb, 0x3b, 0x55, 0xe9, 0x1e, 0xb7, 0xb6, 0xa5,
+	0xf9, 0x08, 0x05, 0x87, 0x86, 0xfd, 0xa8, 0xc8, 0x57, 0x9a, 0x53, 0x99, 0xea, 0x3f, 0xa3, 0xc6,
+	0xd4, 0x0b, 0x6a, 0x08, 0x0f, 0x40, 0x57, 0xb5, 0x7e, 0x57, 0xb3, 0xea, 0xd3, 0x8f, 0xae, 0x97,
+	0x8e, 0x75, 0xb3, 0x74, 0xac, 0x5f, 0x4b, 0xc7, 0xfa, 0xbe, 0x72, 0x3a, 0x37, 0x2b, 0xa7, 0xf3,
+	0x73, 0xe5, 0x74, 0x3e, 0xbf, 0x49, 0x98, 0x9c, 0x14, 0xa1, 0x47, 0x79, 0x8a, 0x29, 0x17, 0x29,
+	0x17, 0x98, 0x85, 0x74, 0x94, 0x70, 0x3c, 0x3f, 0xc3, 0x29, 0x8f, 0x8a, 0x69, 0x2c, 0xd4, 0xb5,
+	0x0a, 0x7c, 0x7a, 0x3e, 0xda, 0x4c, 0x7d, 0xd4, 0x3e, 0x54, 0x79, 0x95, 0xc7, 0x22, 0xdc, 0xd2,
+	0x27, 0x76, 0xf6, 0x67, 0x00, 0x40, 0x13, 0xa6, 0xe5, 0xe2, 0x03, 0x00, 0x00,
+}
+
+func (m *ExecutionQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AccountLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ConnectionLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxPackets != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.MaxPackets))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Packets != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.Packets))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintQuota(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExecutionQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovQuota(uint64(m.WindowBlocks))
+	}
+	l = m.ConnectionLimits.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	l = m.AccountLimits.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	return n
+}
+
+func (m *QuotaLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPackets != 0 {
+		n += 1 + sovQuota(uint64(m.MaxPackets))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovQuota(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *QuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovQuota(uint64(m.WindowStartHeight))
+	}
+	if m.Packets != 0 {
+		n += 1 + sovQuota(uint64(m.Packets))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuota(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuota(x uint64) (n int) {
+	return sovQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExecutionQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+			}
+			m.MaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			m.Packets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Packets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuota = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestExecutionQuotaValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.ExecutionQuota
+		expPass bool
+	}{
+		{"success", types.NewExecutionQuota(ibctesting.FirstConnectionID, 100, types.NewQuotaLimits(10, 1000000), types.NewQuotaLimits(1, 0)), true},
+		{"success with connection limits only", types.NewExecutionQuota(ibctesting.FirstConnectionID, 1, types.NewQuotaLimits(10, 0), types.QuotaLimits{}), true},
+		{"success with account limits only", types.NewExecutionQuota(ibctesting.FirstConnectionID, 1, types.QuotaLimits{}, types.NewQuotaLimits(0, 1000000)), true},
+		{"invalid connection identifier", types.NewExecutionQuota("invalid|connection", 100, types.NewQuotaLimits(10, 0), types.QuotaLimits{}), false},
+		{"zero window blocks", types.NewExecutionQuota(ibctesting.FirstConnectionID, 0, types.NewQuotaLimits(10, 0), types.QuotaLimits{}), false},
+		{"unlimited", types.NewExecutionQuota(ibctesting.FirstConnectionID, 100, types.QuotaLimits{}, types.QuotaLimits{}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.quota.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestQuotaLimitsCheck(t *testing.T) {
+	limits := types.NewQuotaLimits(2, 1000)
+
+	require.NoError(t, limits.Check(types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 1, 1, 999)))
+	require.ErrorIs(t, limits.Check(types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 1, 2, 0)), types.ErrQuotaExceeded)
+	require.ErrorIs(t, limits.Check(types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 1, 0, 1000)), types.ErrQuotaExceeded)
+	require.NoError(t, types.QuotaLimits{}.Check(types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 1, 100, 100000)))
+
+	remaining, limited := limits.RemainingGas(types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 1, 1, 400))
+	require.True(t, limited)
+	require.Equal(t, uint64(600), remaining)
+
+	remaining, limited = limits.RemainingGas(types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 1, 1, 1200))
+	require.True(t, limited)
+	require.Zero(t, remaining)
+
+	_, limited = types.NewQuotaLimits(2, 0).RemainingGas(types.QuotaUsage{})
+	require.False(t, limited)
+}
+
+func TestQuotaUsageValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		usage   types.QuotaUsage
+		expPass bool
+	}{
+		{"success", types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 1, 2, 1000), true},
+		{"success with address", types.NewQuotaUsage(ibctesting.FirstConnectionID, ibctesting.TestAccAddress, 1, 2, 1000), true},
+		{"invalid connection identifier", types.NewQuotaUsage("invalid|connection", "", 1, 2, 1000), false},
+		{"invalid address", types.NewQuotaUsage(ibctesting.FirstConnectionID, "invalid", 1, 2, 1000), false},
+		{"zero window start height", types.NewQuotaUsage(ibctesting.FirstConnectionID, "", 0, 2, 1000), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.usage.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+-------------------------- MODULE lifecycle ----------------------------
+(**
+ * A primitive model of the ICS27 Interchain Accounts packet lifecycle
+ * between a single controller owner and its interchain account on the host.
+ * We abstract away identifiers, proofs and relayers, and want to focus
+ * on a minimal spec useful for testing:
+ *
+ *  - registration and the channel handshake, including channel reopening
+ *  - SendTx on the controller and transaction execution on the host,
+ *    where the executed transaction sends `amount` tokens from the account
+ *  - acknowledgements, timeouts and the closure of ORDERED channels
+ *
+ * Every channel opened for the owner is identified by its generation,
+ * 1 being the channel opened on registration and each reopened channel
+ * incrementing it. Only packets of the latest generation are relayed.
+ *)
+
+EXTENDS Integers, FiniteSets
+
+CONSTANT
+  MaxAmount
+
+VARIABLE
+  error,
+  handler,
+  params,
+  gen,              \* the generation of the latest channel
+  ordering,
+  controller,       \* generation -> controller channel state
+  host,             \* generation -> host channel state
+  nextSequenceSend,
+  nextSequenceRecv,
+  nextSequenceAck,
+  account,          \* whether the interchain account is registered on the host
+  balance,          \* the balance of the interchain account
+  packets,          \* packets sent but neither received nor timed out
+  acks,             \* acknowledgements written but not relayed to the controller
+  history,
+  count
+
+Orderings == {"ORDERED", "UNORDERED"}
+Amounts == 1..MaxAmount
+
+Packets == [ sequence: Nat, amount: Amounts, expired: BOOLEAN ]
+Acks == [ sequence: Nat, success: BOOLEAN ]
+
+NoParams == [ ordering |-> "", amount |-> 0, expired |-> FALSE, sequence |-> 0, channel |-> 0 ]
+
+state == <<gen, ordering, controller, host, nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+           account, balance, packets, acks>>
+
+CurrentController == IF gen = 0 THEN "NONE" ELSE controller[gen]
+CurrentHost == IF gen = 0 THEN "NONE" ELSE host[gen]
+
+\* Appends the state of a new channel generation
+Extend(channels, s) == [ g \in 1..(gen + 1) |-> IF g = gen + 1 THEN s ELSE channels[g] ]
+
+\* A handler which returns an error leaves the state untouched
+Fail ==
+  /\ error' = TRUE
+  /\ UNCHANGED state
+
+\* Starts the channel handshake of a new channel generation, dropping the packets of the previous one
+OpenChannel(o) ==
+  /\ error' = FALSE
+  /\ gen' = gen + 1
+  /\ ordering' = o
+  /\ controller' = Extend(controller, "INIT")
+  /\ host' = Extend(host, "NONE")
+  /\ nextSequenceSend' = 1
+  /\ nextSequenceRecv' = 1
+  /\ nextSequenceAck' = 1
+  /\ packets' = {}
+  /\ acks' = {}
+  /\ UNCHANGED <<account, balance>>
+
+\* MsgRegisterInterchainAccount fails if an OPEN active channel exists
+RegisterInterchainAccountNext(o) ==
+  /\ CurrentController \in {"NONE", "OPEN"}
+  /\ IF CurrentController = "OPEN" THEN Fail ELSE OpenChannel(o)
+
+\* MsgReopenInterchainAccountChannel requires the active channel to be CLOSED
+ReopenChannelNext ==
+  /\ CurrentController \in {"NONE", "OPEN", "CLOSED"}
+  /\ IF CurrentController # "CLOSED" THEN Fail ELSE OpenChannel(ordering)
+
+\* The host refuses a new channel while the previous active channel is still OPEN
+ChanOpenTryNext ==
+  /\ CurrentController = "INIT"
+  /\ CurrentHost = "NONE"
+  /\ IF \E g \in 1..(gen - 1) : host[g] = "OPEN" THEN Fail
+     ELSE
+       /\ error' = FALSE
+       /\ host' = [ host EXCEPT ![gen] = "TRYOPEN" ]
+       /\ account' = TRUE
+       /\ UNCHANGED <<gen, ordering, controller, nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+                      balance, packets, acks>>
+
+ChanOpenAckNext ==
+  /\ CurrentController = "INIT"
+  /\ CurrentHost = "TRYOPEN"
+  /\ error' = FALSE
+  /\ controller' = [ controller EXCEPT ![gen] = "OPEN" ]
+  /\ UNCHANGED <<gen, ordering, host, nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+                 account, balance, packets, acks>>
+
+\* The controller channel may have been closed by a timeout before the handshake completes
+ChanOpenConfirmNext ==
+  /\ CurrentController \in {"OPEN", "CLOSED"}
+  /\ CurrentHost = "TRYOPEN"
+  /\ IF CurrentController # "OPEN" THEN Fail
+     ELSE
+       /\ error' = FALSE
+       /\ host' = [ host EXCEPT ![gen] = "OPEN" ]
+       /\ UNCHANGED <<gen, ordering, controller, nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+                      account, balance, packets, acks>>
+
+\* Tokens are sent to the interchain account on the host by a third party
+FundAccountNext(a) ==
+  /\ account
+  /\ error' = FALSE
+  /\ balance' = balance + a
+  /\ UNCHANGED <<gen, ordering, controller, host, nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+                 account, packets, acks>>
+
+\* An expired packet is sent with a timeout which has already passed on the host when it is relayed
+SendTxNext(a, x) ==
+  /\ gen > 0
+  /\ IF CurrentController # "OPEN" THEN Fail
+     ELSE
+       /\ error' = FALSE
+       /\ packets' = packets \union {[ sequence |-> nextSequenceSend, amount |-> a, expired |-> x ]}
+       /\ nextSequenceSend' = nextSequenceSend + 1
+       /\ UNCHANGED <<gen, ordering, controller, host, nextSequenceRecv, nextSequenceAck,
+                      account, balance, acks>>
+
+\* The host executes the transaction, writing an error acknowledgement if the account lacks the funds
+RecvPacketNext(p) ==
+  IF \/ CurrentHost # "OPEN"
+     \/ p.expired
+     \/ ordering = "ORDERED" /\ p.sequence # nextSequenceRecv
+  THEN Fail
+  ELSE
+    LET success == balance >= p.amount IN
+    /\ error' = FALSE
+    /\ packets' = packets \ {p}
+    /\ acks' = acks \union {[ sequence |-> p.sequence, success |-> success ]}
+    /\ balance' = IF success THEN balance - p.amount ELSE balance
+    /\ nextSequenceRecv' = IF ordering = "ORDERED" THEN nextSequenceRecv + 1 ELSE nextSequenceRecv
+    /\ UNCHANGED <<gen, ordering, controller, host, nextSequenceSend, nextSequenceAck, account>>
+
+AcknowledgePacketNext(k) ==
+  IF \/ CurrentController # "OPEN"
+     \/ ordering = "ORDERED" /\ k.sequence # nextSequenceAck
+  THEN Fail
+  ELSE
+    /\ error' = FALSE
+    /\ acks' = acks \ {k}
+    /\ nextSequenceAck' = IF ordering = "ORDERED" THEN nextSequenceAck + 1 ELSE nextSequenceAck
+    /\ UNCHANGED <<gen, ordering, controller, host, nextSequenceSend, nextSequenceRecv,
+                   account, balance, packets>>
+
+\* A timeout closes an ORDERED channel on the controller, UNORDERED channels remain OPEN
+TimeoutPacketNext(p) ==
+  IF ~p.expired \/ CurrentController # "OPEN" THEN Fail
+  ELSE
+    /\ error' = FALSE
+    /\ packets' = packets \ {p}
+    /\ controller' = IF ordering = "ORDERED" THEN [ controller EXCEPT ![gen] = "CLOSED" ] ELSE controller
+    /\ UNCHANGED <<gen, ordering, host, nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+                   account, balance, acks>>
+
+\* The host channel of any generation can be closed once its controller channel is CLOSED
+ChanCloseConfirmNext(g) ==
+  /\ host[g] \in {"OPEN", "CLOSED"}
+  /\ IF controller[g] # "CLOSED" \/ host[g] = "CLOSED" THEN Fail
+     ELSE
+       /\ error' = FALSE
+       /\ host' = [ host EXCEPT ![g] = "CLOSED" ]
+       /\ UNCHANGED <<gen, ordering, controller, nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+                      account, balance, packets, acks>>
+
+\* The observable state which the test driver compares against the chains
+Observe(ctrl, hst, acc, bal, pkts, acknowledgements) == [
+  controllerChannel |-> ctrl,
+  hostChannel |-> hst,
+  account |-> acc,
+  balance |-> bal,
+  commitments |-> Cardinality(pkts) + Cardinality(acknowledgements),
+  acks |-> acknowledgements
+]
+
+Init ==
+  /\ error = FALSE
+  /\ handler = ""
+  /\ params = NoParams
+  /\ gen = 0
+  /\ ordering = ""
+  /\ controller = [ g \in 1..0 |-> "NONE" ]
+  /\ host = [ g \in 1..0 |-> "NONE" ]
+  /\ nextSequenceSend = 1
+  /\ nextSequenceRecv = 1
+  /\ nextSequenceAck = 1
+  /\ account = FALSE
+  /\ balance = 0
+  /\ packets = {}
+  /\ acks = {}
+  /\ count = 0
+  /\ history = [
+       n \in {0} |-> [
+         handler |-> "",
+         params |-> NoParams,
+         error |-> FALSE,
+         stateAfter |-> Observe("NONE", "NONE", FALSE, 0, {}, {})
+       ]
+     ]
+
+Next ==
+  /\ count' = count + 1
+  /\
+     \/ \E o \in Orderings :
+          /\ RegisterInterchainAccountNext(o)
+          /\ handler' = "RegisterInterchainAccount"
+          /\ params' = [ NoParams EXCEPT !.ordering = o ]
+     \/ /\ ChanOpenTryNext
+        /\ handler' = "ChanOpenTry"
+        /\ params' = NoParams
+     \/ /\ ChanOpenAckNext
+        /\ handler' = "ChanOpenAck"
+        /\ params' = NoParams
+     \/ /\ ChanOpenConfirmNext
+        /\ handler' = "ChanOpenConfirm"
+        /\ params' = NoParams
+     \/ \E a \in Amounts :
+          /\ FundAccountNext(a)
+          /\ handler' = "FundAccount"
+          /\ params' = [ NoParams EXCEPT !.amount = a ]
+     \/ \E a \in Amounts, x \in BOOLEAN :
+          /\ SendTxNext(a, x)
+          /\ handler' = "SendTx"
+          /\ params' = [ NoParams EXCEPT !.amount = a, !.expired = x ]
+     \/ \E p \in packets :
+          /\ RecvPacketNext(p)
+          /\ handler' = "RecvPacket"
+          /\ params' = [ NoParams EXCEPT !.sequence = p.sequence ]
+     \/ \E k \in acks :
+          /\ AcknowledgePacketNext(k)
+          /\ handler' = "AcknowledgePacket"
+          /\ params' = [ NoParams EXCEPT !.sequence = k.sequence ]
+     \/ \E p \in packets :
+          /\ TimeoutPacketNext(p)
+          /\ handler' = "TimeoutPacket"
+          /\ params' = [ NoParams EXCEPT !.sequence = p.sequence ]
+     \/ \E g \in 1..gen :
+          /\ ChanCloseConfirmNext(g)
+          /\ handler' = "ChanCloseConfirm"
+          /\ params' = [ NoParams EXCEPT !.channel = g ]
+     \/ /\ ReopenChannelNext
+        /\ handler' = "ReopenChannel"
+        /\ params' = NoParams
+  /\ history' = [ n \in DOMAIN history \union {count'} |->
+       IF n = count' THEN
+         [ handler |-> handler', params |-> params', error |-> error',
+           stateAfter |-> Observe(IF gen' = 0 THEN "NONE" ELSE controller'[gen'],
+                                  IF gen' = 0 THEN "NONE" ELSE host'[gen'],
+                                  account', balance', packets', acks') ]
+       ELSE history[n]
+     ]
+
+=============================================================================
@@ -0,0 +1,92 @@
+-------------------------- MODULE lifecycle_tests ----------------------------
+
+EXTENDS Integers, FiniteSets
+
+MaxAmount == 5
+
+VARIABLES error, handler, params, gen, ordering, controller, host,
+          nextSequenceSend, nextSequenceRecv, nextSequenceAck,
+          account, balance, packets, acks, history, count
+
+INSTANCE lifecycle
+
+\************************** Tests ******************************
+
+\* Generic test for handler pass
+TestHandlerPass(handlerName) ==
+  \E s \in DOMAIN history :
+    /\ history[s].handler = handlerName
+    /\ history[s].error = FALSE
+
+\* Generic test for handler fail
+TestHandlerFail(handlerName) ==
+  \E s \in DOMAIN history :
+    /\ history[s].handler = handlerName
+    /\ history[s].error = TRUE
+
+\* A transaction is executed by the host and its successful acknowledgement is relayed back
+TestHappyPath ==
+  \E s1, s2 \in DOMAIN history :
+    /\ s1 < s2
+    /\ history[s1].handler = "RecvPacket"
+    /\ history[s1].error = FALSE
+    /\ \A k \in history[s1].stateAfter.acks : k.success
+    /\ history[s2].handler = "AcknowledgePacket"
+    /\ history[s2].error = FALSE
+    /\ history[s2].stateAfter.commitments = 0
+TestHappyPathInv == ~TestHappyPath
+
+\* The host writes an error acknowledgement for a transaction the account cannot fund
+TestInsufficientFunds ==
+  \E s1, s2 \in DOMAIN history :
+    /\ s1 < s2
+    /\ history[s1].handler = "FundAccount"
+    /\ history[s2].handler = "RecvPacket"
+    /\ history[s2].error = FALSE
+    /\ \E k \in history[s2].stateAfter.acks : ~k.success
+TestInsufficientFundsInv == ~TestInsufficientFunds
+
+\* An ORDERED channel is closed by a timeout and the account is used again over a reopened channel
+TestOrderedTimeoutReopen ==
+  \E s1, s2, s3 \in DOMAIN history :
+    /\ s1 < s2 /\ s2 < s3
+    /\ history[s1].handler = "TimeoutPacket"
+    /\ history[s1].error = FALSE
+    /\ history[s1].stateAfter.controllerChannel = "CLOSED"
+    /\ history[s2].handler = "ChanOpenTry"
+    /\ history[s2].error = TRUE
+    /\ history[s3].handler = "RecvPacket"
+    /\ history[s3].error = FALSE
+TestOrderedTimeoutReopenInv == ~TestOrderedTimeoutReopen
+
+\* A timeout on an UNORDERED channel does not prevent later packets from being executed
+TestUnorderedTimeout ==
+  \E s1, s2 \in DOMAIN history :
+    /\ s1 < s2
+    /\ history[s1].handler = "TimeoutPacket"
+    /\ history[s1].error = FALSE
+    /\ history[s1].stateAfter.controllerChannel = "OPEN"
+    /\ history[s2].handler = "RecvPacket"
+    /\ history[s2].error = FALSE
+TestUnorderedTimeoutInv == ~TestUnorderedTimeout
+
+\* Packets and acknowledgements on an ORDERED channel are only accepted in sequence
+TestOrderedSequencing ==
+  /\ TestHandlerFail("RecvPacket")
+  /\ TestHandlerFail("AcknowledgePacket")
+  /\ \E s \in DOMAIN history :
+       /\ history[s].handler = "AcknowledgePacket"
+       /\ history[s].error = FALSE
+       /\ history[s].stateAfter.commitments = 0
+TestOrderedSequencingInv == ~TestOrderedSequencing
+
+\* Handlers invoked in the wrong channel states are rejected
+TestHandlerErrors ==
+  /\ TestHandlerFail("SendTx")
+  /\ TestHandlerFail("RegisterInterchainAccount")
+  /\ TestHandlerFail("ReopenChannel")
+  /\ TestHandlerFail("TimeoutPacket")
+  /\ TestHandlerFail("ChanCloseConfirm")
+TestHandlerErrorsInv == ~TestHandlerErrors
+
+=============================================================================
@@ -0,0 +1,498 @@
+package ica_test
+
+/// This file is a test driver for model-based tests generated from the TLA+ model of the interchain accounts
+/// packet lifecycle, see lifecycle_model/lifecycle.tla and MBT_README.md.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+const (
+	// relative timeout of packets which the model does not expect to expire
+	mbtPacketTimeout = 7 * 24 * time.Hour
+	// relative timeout of packets which the model expects to expire, and the time by which
+	// the chains are advanced after sending them
+	mbtExpiringPacketTimeout = time.Hour
+	mbtExpiryDelay           = 2 * time.Hour
+)
+
+type TlaLifecycleParams struct {
+	Ordering string `json:"ordering"`
+	Amount   int64  `json:"amount"`
+	Expired  bool   `json:"expired"`
+	Sequence uint64 `json:"sequence"`
+	Channel  int    `json:"channel"`
+}
+
+type TlaAcknowledgement struct {
+	Sequence uint64 `json:"sequence"`
+	Success  bool   `json:"success"`
+}
+
+type TlaLifecycleState struct {
+	ControllerChannel string               `json:"controllerChannel"`
+	HostChannel       string               `json:"hostChannel"`
+	Account           bool                 `json:"account"`
+	Balance           int64                `json:"balance"`
+	Commitments       int                  `json:"commitments"`
+	Acks              []TlaAcknowledgement `json:"acks"`
+}
+
+type TlaLifecycleStep = struct {
+	// The handler to call
+	Handler string `json:"handler"`
+	// The handler arguments, only those relevant to the handler are set
+	Params TlaLifecycleParams `json:"params"`
+	// Whether the handler should fail or not
+	Error bool `json:"error"`
+	// The expected observable state after the handler is called
+	StateAfter TlaLifecycleState `json:"stateAfter"`
+}
+
+// lifecycleChannel is a channel generation of the model: the controller and host ends of one channel handshake
+type lifecycleChannel struct {
+	controllerChannelID string
+	hostChannelID       string
+}
+
+type lifecycleAck struct {
+	packet channeltypes.Packet
+	ack    []byte
+}
+
+// lifecycleDriver replays the steps of a model trace against a controller (chainA) and host (chainB)
+// connected by the testing coordinator. Messages are built and relayed as a relayer would.
+type lifecycleDriver struct {
+	suite  *InterchainAccountsTestSuite
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+
+	owner    string
+	portID   string
+	channels []lifecycleChannel
+	// packets and acknowledgements of the latest channel generation which have not been relayed yet
+	packets map[uint64]channeltypes.Packet
+	acks    map[uint64]lifecycleAck
+}
+
+func newLifecycleDriver(suite *InterchainAccountsTestSuite) *lifecycleDriver {
+	chainA := suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	suite.coordinator.SetupConnections(path)
+
+	hostParams := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, nil, hosttypes.DefaultMaxGasPerPacket)
+	chainB.GetSimApp().ICAHostKeeper.SetParams(chainB.GetContext(), hostParams)
+
+	owner := chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	return &lifecycleDriver{
+		suite:   suite,
+		chainA:  chainA,
+		chainB:  chainB,
+		path:    path,
+		owner:   owner,
+		portID:  portID,
+		packets: make(map[uint64]channeltypes.Packet),
+		acks:    make(map[uint64]lifecycleAck),
+	}
+}
+
+// deliver executes the msg on the provided chain and commits a block if it succeeds. The msg handler is
+// executed in a cached context such that a failing msg leaves no state behind, as a failed transaction.
+func (d *lifecycleDriver) deliver(chain *ibctesting.TestChain, msg sdk.Msg) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	d.suite.coordinator.UpdateTimeForChain(chain)
+
+	cacheCtx, writeCache := chain.GetContext().CacheContext()
+	handler := chain.App.GetBaseApp().MsgServiceRouter().Handler(msg)
+	d.suite.Require().NotNil(handler, "no handler registered for %s", sdk.MsgTypeURL(msg))
+
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	d.suite.coordinator.CommitBlock(chain)
+
+	return res, nil
+}
+
+// queryProof updates the client of the counterparty on the endpoint's chain and returns the proof of the
+// provided key on the counterparty chain
+func (d *lifecycleDriver) queryProof(endpoint *ibctesting.Endpoint, key []byte) ([]byte, clienttypes.Height) {
+	d.suite.Require().NoError(endpoint.UpdateClient())
+
+	return endpoint.Counterparty.QueryProof(key)
+}
+
+func (d *lifecycleDriver) currentChannel() *lifecycleChannel {
+	d.suite.Require().NotEmpty(d.channels, "no channel has been opened by the model")
+
+	return &d.channels[len(d.channels)-1]
+}
+
+// openChannel records a new channel generation, the packets of the previous generation are no longer relayed
+func (d *lifecycleDriver) openChannel(res *sdk.Result) {
+	channelID, err := ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+	d.suite.Require().NoError(err)
+
+	d.channels = append(d.channels, lifecycleChannel{controllerChannelID: channelID})
+	d.packets = make(map[uint64]channeltypes.Packet)
+	d.acks = make(map[uint64]lifecycleAck)
+}
+
+// execute calls the handler of the step, returning the error of the handler if any
+func (d *lifecycleDriver) execute(step TlaLifecycleStep) error {
+	connectionID := d.path.EndpointA.ConnectionID
+	signerA := d.chainA.SenderAccount.GetAddress().String()
+	signerB := d.chainB.SenderAccount.GetAddress().String()
+
+	switch step.Handler {
+	case "RegisterInterchainAccount":
+		ordering, ok := channeltypes.Order_value["ORDER_"+step.Params.Ordering]
+		d.suite.Require().True(ok, "unknown channel ordering %s", step.Params.Ordering)
+
+		res, err := d.deliver(d.chainA, controllertypes.NewMsgRegisterInterchainAccount(connectionID, d.owner, "", channeltypes.Order(ordering)))
+		if err != nil {
+			return err
+		}
+
+		d.openChannel(res)
+
+	case "ReopenChannel":
+		res, err := d.deliver(d.chainA, controllertypes.NewMsgReopenInterchainAccountChannel(connectionID, d.owner))
+		if err != nil {
+			return err
+		}
+
+		d.openChannel(res)
+
+	case "ChanOpenTry":
+		channel := d.currentChannel()
+		controllerChannel, found := d.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(d.chainA.GetContext(), d.portID, channel.controllerChannelID)
+		d.suite.Require().True(found)
+
+		proof, proofHeight := d.queryProof(d.path.EndpointB, host.ChannelKey(d.portID, channel.controllerChannelID))
+		msg := channeltypes.NewMsgChannelOpenTry(
+			icatypes.PortID, "", controllerChannel.Version, controllerChannel.Ordering, []string{d.path.EndpointB.ConnectionID},
+			d.portID, channel.controllerChannelID, controllerChannel.Version,
+			proof, proofHeight, signerB,
+		)
+
+		res, err := d.deliver(d.chainB, msg)
+		if err != nil {
+			return err
+		}
+
+		channel.hostChannelID, err = ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+		d.suite.Require().NoError(err)
+
+	case "ChanOpenAck":
+		channel := d.currentChannel()
+		hostChannel, found := d.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannel(d.chainB.GetContext(), icatypes.PortID, channel.hostChannelID)
+		d.suite.Require().True(found)
+
+		proof, proofHeight := d.queryProof(d.path.EndpointA, host.ChannelKey(icatypes.PortID, channel.hostChannelID))
+		msg := channeltypes.NewMsgChannelOpenAck(d.portID, channel.controllerChannelID, channel.hostChannelID, hostChannel.Version, proof, proofHeight, signerA)
+
+		if _, err := d.deliver(d.chainA, msg); err != nil {
+			return err
+		}
+
+	case "ChanOpenConfirm":
+		channel := d.currentChannel()
+
+		proof, proofHeight := d.queryProof(d.path.EndpointB, host.ChannelKey(d.portID, channel.controllerChannelID))
+		msg := channeltypes.NewMsgChannelOpenConfirm(icatypes.PortID, channel.hostChannelID, proof, proofHeight, signerB)
+
+		if _, err := d.deliver(d.chainB, msg); err != nil {
+			return err
+		}
+
+	case "FundAccount":
+		address, found := d.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(d.chainB.GetContext(), d.path.EndpointB.ConnectionID, d.portID)
+		d.suite.Require().True(found)
+
+		msg := &banktypes.MsgSend{
+			FromAddress: signerB,
+			ToAddress:   address,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, step.Params.Amount)),
+		}
+
+		if _, err := d.deliver(d.chainB, msg); err != nil {
+			return err
+		}
+
+	case "SendTx":
+		// the account is only registered once the host has accepted the channel, if it is not the msg fails regardless
+		address, found := d.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(d.chainB.GetContext(), d.path.EndpointB.ConnectionID, d.portID)
+		if !found {
+			address = signerB
+		}
+
+		icaMsg := &banktypes.MsgSend{
+			FromAddress: address,
+			ToAddress:   signerB,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, step.Params.Amount)),
+		}
+
+		data, err := icatypes.SerializeCosmosTx(d.chainB.GetSimApp().AppCodec(), []sdk.Msg{icaMsg})
+		d.suite.Require().NoError(err)
+
+		packetData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		}
+
+		timeout := mbtPacketTimeout
+		if step.Params.Expired {
+			timeout = mbtExpiringPacketTimeout
+		}
+
+		res, err := d.deliver(d.chainA, controllertypes.NewMsgSendTx(d.owner, connectionID, uint64(timeout.Nanoseconds()), packetData))
+		if err != nil {
+			return err
+		}
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		d.suite.Require().NoError(err)
+
+		d.packets[packet.GetSequence()] = packet
+
+		if step.Params.Expired {
+			d.suite.coordinator.IncrementTimeBy(mbtExpiryDelay)
+		}
+
+	case "RecvPacket":
+		packet, ok := d.packets[step.Params.Sequence]
+		d.suite.Require().True(ok, "no packet with sequence %d to relay", step.Params.Sequence)
+
+		proof, proofHeight := d.queryProof(d.path.EndpointB, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+		res, err := d.deliver(d.chainB, channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, signerB))
+		if err != nil {
+			return err
+		}
+
+		ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+		d.suite.Require().NoError(err)
+
+		delete(d.packets, packet.GetSequence())
+		d.acks[packet.GetSequence()] = lifecycleAck{packet: packet, ack: ack}
+
+	case "AcknowledgePacket":
+		ack, ok := d.acks[step.Params.Sequence]
+		d.suite.Require().True(ok, "no acknowledgement for sequence %d to relay", step.Params.Sequence)
+
+		packet := ack.packet
+		proof, proofHeight := d.queryProof(d.path.EndpointA, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+		if _, err := d.deliver(d.chainA, channeltypes.NewMsgAcknowledgement(packet, ack.ack, proof, proofHeight, signerA)); err != nil {
+			return err
+		}
+
+		delete(d.acks, packet.GetSequence())
+
+	case "TimeoutPacket":
+		packet, ok := d.packets[step.Params.Sequence]
+		d.suite.Require().True(ok, "no packet with sequence %d to time out", step.Params.Sequence)
+
+		// ensure the latest header of the host carries the current time
+		d.suite.coordinator.CommitBlock(d.chainB)
+
+		channel, found := d.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(d.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
+		d.suite.Require().True(found)
+
+		var key []byte
+		switch channel.Ordering {
+		case channeltypes.ORDERED:
+			key = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+		default:
+			key = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		}
+
+		nextSequenceRecv, found := d.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(d.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+		d.suite.Require().True(found)
+
+		proof, proofHeight := d.queryProof(d.path.EndpointA, key)
+
+		if _, err := d.deliver(d.chainA, channeltypes.NewMsgTimeout(packet, nextSequenceRecv, proof, proofHeight, signerA)); err != nil {
+			return err
+		}
+
+		delete(d.packets, packet.GetSequence())
+
+	case "ChanCloseConfirm":
+		d.suite.Require().True(step.Params.Channel > 0 && step.Params.Channel <= len(d.channels), "unknown channel generation %d", step.Params.Channel)
+		channel := d.channels[step.Params.Channel-1]
+
+		proof, proofHeight := d.queryProof(d.path.EndpointB, host.ChannelKey(d.portID, channel.controllerChannelID))
+		msg := channeltypes.NewMsgChannelCloseConfirm(icatypes.PortID, channel.hostChannelID, proof, proofHeight, signerB, 0)
+
+		if _, err := d.deliver(d.chainB, msg); err != nil {
+			return err
+		}
+
+	default:
+		d.suite.FailNow(fmt.Sprintf("unknown handler: %s", step.Handler))
+	}
+
+	return nil
+}
+
+// channelState returns the state of the channel as named by the model
+func (d *lifecycleDriver) channelState(chain *ibctesting.TestChain, portID, channelID string) string {
+	if channelID == "" {
+		return "NONE"
+	}
+
+	channel, found := chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(chain.GetContext(), portID, channelID)
+	d.suite.Require().True(found)
+
+	return strings.TrimPrefix(channel.State.String(), "STATE_")
+}
+
+// observe returns the observable state of the model as found on the chains
+func (d *lifecycleDriver) observe() TlaLifecycleState {
+	state := TlaLifecycleState{
+		ControllerChannel: "NONE",
+		HostChannel:       "NONE",
+		Acks:              []TlaAcknowledgement{},
+	}
+
+	address, found := d.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(d.chainB.GetContext(), d.path.EndpointB.ConnectionID, d.portID)
+	if found {
+		state.Account = true
+		state.Balance = d.chainB.GetSimApp().BankKeeper.GetBalance(d.chainB.GetContext(), sdk.MustAccAddressFromBech32(address), sdk.DefaultBondDenom).Amount.Int64()
+	}
+
+	if len(d.channels) == 0 {
+		return state
+	}
+
+	channel := d.currentChannel()
+	state.ControllerChannel = d.channelState(d.chainA, d.portID, channel.controllerChannelID)
+	state.HostChannel = d.channelState(d.chainB, icatypes.PortID, channel.hostChannelID)
+
+	commitments := d.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(d.chainA.GetContext(), d.portID, channel.controllerChannelID)
+	state.Commitments = len(commitments)
+
+	// acknowledgements written on the host for packets which are still committed on the controller
+	for _, commitment := range commitments {
+		if channel.hostChannelID == "" {
+			break
+		}
+
+		if _, found := d.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(d.chainB.GetContext(), icatypes.PortID, channel.hostChannelID, commitment.Sequence); !found {
+			continue
+		}
+
+		ack, ok := d.acks[commitment.Sequence]
+		d.suite.Require().True(ok, "acknowledgement for sequence %d was not relayed by the driver", commitment.Sequence)
+
+		var acknowledgement channeltypes.Acknowledgement
+		d.suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack.ack, &acknowledgement))
+
+		state.Acks = append(state.Acks, TlaAcknowledgement{Sequence: commitment.Sequence, Success: acknowledgement.Success()})
+	}
+
+	sort.Slice(state.Acks, func(i, j int) bool { return state.Acks[i].Sequence < state.Acks[j].Sequence })
+
+	return state
+}
+
+// divergences compares the outcome of a step with the model, returning a description of every mismatch
+func divergences(step TlaLifecycleStep, err error, actual TlaLifecycleState) []string {
+	var diffs []string
+	diverge := func(field string, expected, got interface{}) {
+		diffs = append(diffs, fmt.Sprintf("%s: expected %v, got %v", field, expected, got))
+	}
+
+	if step.Error != (err != nil) {
+		diverge("error", step.Error, err)
+	}
+
+	expected := step.StateAfter
+	if expected.ControllerChannel != actual.ControllerChannel {
+		diverge("controllerChannel", expected.ControllerChannel, actual.ControllerChannel)
+	}
+
+	if expected.HostChannel != actual.HostChannel {
+		diverge("hostChannel", expected.HostChannel, actual.HostChannel)
+	}
+
+	if expected.Account != actual.Account {
+		diverge("account", expected.Account, actual.Account)
+	}
+
+	if expected.Balance != actual.Balance {
+		diverge("balance", expected.Balance, actual.Balance)
+	}
+
+	if expected.Commitments != actual.Commitments {
+		diverge("commitments", expected.Commitments, actual.Commitments)
+	}
+
+	if fmt.Sprint(expected.Acks) != fmt.Sprint(actual.Acks) {
+		diverge("acks", expected.Acks, actual.Acks)
+	}
+
+	return diffs
+}
+
+func (suite *InterchainAccountsTestSuite) TestModelBasedLifecycle() {
+	dirname := "model_based_tests/"
+	files, err := ioutil.ReadDir(dirname)
+	suite.Require().NoError(err, "failed to read model-based test files")
+
+	for _, fileInfo := range files {
+		if !strings.HasSuffix(fileInfo.Name(), ".json") {
+			continue
+		}
+
+		jsonBlob, err := ioutil.ReadFile(dirname + fileInfo.Name())
+		suite.Require().NoError(err, "failed to read JSON test fixture")
+
+		var steps []TlaLifecycleStep
+		suite.Require().NoError(json.Unmarshal(jsonBlob, &steps), "failed to parse JSON test fixture")
+
+		suite.Run(fileInfo.Name(), func() {
+			suite.SetupTest()
+			driver := newLifecycleDriver(suite)
+
+			// the trace is aborted at the first divergence as the remaining steps may no longer be enabled
+			for i, step := range steps {
+				err := driver.execute(step)
+				diffs := divergences(step, err, driver.observe())
+
+				suite.Require().Empty(diffs, "model and implementation diverge at step %d (%s %+v):\n%s",
+					i+1, step.Handler, step.Params, strings.Join(diffs, "\n"))
+			}
+		})
+	}
+}
@@ -56,7 +56,7 @@ func (suite *InterchainAccountsTestSuite) TestInitModule() {
 	controllerParams.ControllerEnabled = true
 
 	hostParams := hosttypes.DefaultParams()
-	expAllowMessages := []string{"sdk.Msg"}
+	expAllowMessages := []string{"/cosmos.bank.v1beta1.MsgSend"}
 	hostParams.HostEnabled = true
 	hostParams.AllowMessages = expAllowMessages
 	suite.Require().False(app.IBCKeeper.PortKeeper.IsBound(ctx, types.PortID))
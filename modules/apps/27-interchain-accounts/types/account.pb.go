@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types1 "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -63,8 +64,100 @@ func (m *InterchainAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterchainAccount proto.InternalMessageInfo
 
+// InterchainAccountRecord contains an interchain account address together with its owner, connection, port and
+// active channel
+type InterchainAccountRecord struct {
+	// owner address, empty if the port identifier is not prefixed with the interchain accounts controller port prefix
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection identifier of the interchain account on the querying chain
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// controller port identifier
+	PortId         string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	AccountAddress string `protobuf:"bytes,4,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty" yaml:"account_address"`
+	// active channel identifier, empty if no active channel is set for the interchain account
+	ActiveChannelId string `protobuf:"bytes,5,opt,name=active_channel_id,json=activeChannelId,proto3" json:"active_channel_id,omitempty" yaml:"active_channel_id"`
+	// state of the active channel
+	ActiveChannelState types1.State `protobuf:"varint,6,opt,name=active_channel_state,json=activeChannelState,proto3,enum=ibc.core.channel.v1.State" json:"active_channel_state,omitempty" yaml:"active_channel_state"`
+}
+
+func (m *InterchainAccountRecord) Reset()         { *m = InterchainAccountRecord{} }
+func (m *InterchainAccountRecord) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountRecord) ProtoMessage()    {}
+func (*InterchainAccountRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5561bd92625bf7da, []int{1}
+}
+func (m *InterchainAccountRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountRecord.Merge(m, src)
+}
+func (m *InterchainAccountRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountRecord proto.InternalMessageInfo
+
+func (m *InterchainAccountRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *InterchainAccountRecord) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *InterchainAccountRecord) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccountRecord) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *InterchainAccountRecord) GetActiveChannelId() string {
+	if m != nil {
+		return m.ActiveChannelId
+	}
+	return ""
+}
+
+func (m *InterchainAccountRecord) GetActiveChannelState() types1.State {
+	if m != nil {
+		return m.ActiveChannelState
+	}
+	return types1.UNINITIALIZED
+}
+
 func init() {
 	proto.RegisterType((*InterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccount")
+	proto.RegisterType((*InterchainAccountRecord)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountRecord")
 }
 
 func init() {
@@ -72,29 +165,41 @@ func init() {
 }
 
 var fileDescriptor_5561bd92625bf7da = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcd, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0x2f, 0x33, 0xd4, 0x87, 0xb2, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xd4, 0x33, 0x93,
-	0x92, 0xf5, 0x90, 0xb5, 0xe9, 0x61, 0xd1, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x99, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0x1c, 0x0f, 0xd6, 0xa6, 0x0f, 0xe1, 0x40, 0xcc, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x87, 0x88, 0x83, 0x58, 0x50, 0x51, 0x39, 0x88, 0x1a, 0xfd, 0xc4, 0xd2, 0x92, 0x0c, 0xfd, 0x32,
-	0xc3, 0xa4, 0xd4, 0x92, 0x44, 0x43, 0x30, 0x07, 0x22, 0xaf, 0x74, 0x85, 0x91, 0x4b, 0xd0, 0x13,
-	0x6e, 0x97, 0x23, 0xc4, 0x2a, 0xa1, 0x04, 0x2e, 0x9e, 0xa4, 0xc4, 0xe2, 0x54, 0x98, 0xd5, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x0a, 0x7a, 0x50, 0x0b, 0xc1, 0xfa, 0xa1, 0x86, 0xe9, 0x39,
-	0x25, 0x16, 0xa7, 0x42, 0xf5, 0x39, 0x49, 0x5f, 0xb8, 0x27, 0xcf, 0xf8, 0xe9, 0x9e, 0xbc, 0x70,
-	0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0xb2, 0x19, 0x4a, 0x41, 0xdc, 0x49, 0x08, 0x95, 0x42, 0xb6,
-	0x5c, 0xbc, 0x50, 0x89, 0xf8, 0xfc, 0xf2, 0xbc, 0xd4, 0x22, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x4e,
-	0x27, 0x89, 0x4f, 0xf7, 0xe4, 0x45, 0x20, 0x9a, 0x51, 0xa4, 0x95, 0x82, 0x78, 0xa0, 0x7c, 0x7f,
-	0x10, 0xd7, 0x4a, 0xae, 0x63, 0x81, 0x3c, 0xc3, 0x8c, 0x05, 0xf2, 0x0c, 0x97, 0xb6, 0xe8, 0x0a,
-	0x61, 0xb8, 0xdf, 0xd3, 0x29, 0xfe, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x5c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0xe1, 0xa7, 0x9f, 0x99,
-	0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66, 0xac, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c,
-	0x8a, 0xc1, 0x62, 0x7d, 0x23, 0x73, 0x5d, 0x44, 0x2c, 0xe8, 0xc2, 0x23, 0xaf, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x7c, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0x07, 0x08,
-	0x0e, 0xf1, 0x01, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x29, 0x0d, 0xaa, 0x1b, 0x52, 0x75, 0x89, 0xc0, 0xa4, 0xc8, 0x0e, 0xbe, 0x10, 0x09,
+	0x65, 0x57, 0x49, 0x85, 0x90, 0x2a, 0x71, 0x68, 0x2a, 0x24, 0x72, 0x42, 0x32, 0x37, 0x2e, 0x66,
+	0xbd, 0x5e, 0x25, 0x2b, 0x25, 0x5e, 0xcb, 0xbb, 0x31, 0xea, 0x1f, 0x70, 0xe4, 0x08, 0xb7, 0x7e,
+	0x04, 0x1f, 0xc1, 0x31, 0x42, 0x1c, 0x38, 0x59, 0x28, 0xf9, 0x03, 0x7f, 0x41, 0xb5, 0xde, 0x4d,
+	0xd3, 0x34, 0xbd, 0xcd, 0xbc, 0x79, 0x6f, 0xe6, 0xad, 0x76, 0xc6, 0x7e, 0xc3, 0x22, 0x82, 0x70,
+	0x9a, 0x4e, 0x19, 0xc1, 0x92, 0xf1, 0x44, 0x20, 0x96, 0x48, 0x9a, 0x91, 0x09, 0x66, 0x49, 0x88,
+	0x09, 0xe1, 0xf3, 0x44, 0x0a, 0x94, 0xf7, 0x91, 0x89, 0x61, 0x9a, 0x71, 0xc9, 0xc1, 0x2b, 0x16,
+	0x11, 0x78, 0x5b, 0x06, 0xef, 0x91, 0xc1, 0xbc, 0xdf, 0x7e, 0x4e, 0xb8, 0x98, 0x71, 0x11, 0x56,
+	0x32, 0xa4, 0x13, 0xdd, 0xa3, 0xdd, 0x1a, 0xf3, 0x31, 0xd7, 0xb8, 0x8a, 0x0c, 0xea, 0x6a, 0x0e,
+	0xc2, 0x73, 0x39, 0x41, 0x79, 0x3f, 0xa2, 0x12, 0xf7, 0xab, 0xc4, 0xd4, 0x5f, 0x2a, 0xc3, 0x84,
+	0x67, 0x14, 0x91, 0x09, 0x4e, 0x12, 0x3a, 0x55, 0xe6, 0x4c, 0xa8, 0x29, 0xfe, 0x5f, 0xcb, 0x3e,
+	0x1e, 0xdd, 0xd8, 0x39, 0xd7, 0x6e, 0xc0, 0x17, 0xbb, 0x11, 0x61, 0x41, 0xd7, 0xee, 0x1c, 0xab,
+	0x63, 0x75, 0x0f, 0x07, 0x1d, 0x68, 0x3c, 0x55, 0x23, 0xcc, 0x3c, 0x38, 0xc4, 0x82, 0x1a, 0xdd,
+	0xf0, 0x64, 0x51, 0x78, 0x56, 0x59, 0x78, 0x4f, 0x2e, 0xf1, 0x6c, 0x7a, 0xe6, 0xdf, 0xee, 0xe1,
+	0x07, 0x87, 0xd1, 0x86, 0x09, 0xde, 0xd9, 0x8f, 0x4d, 0x21, 0xe4, 0x5f, 0x13, 0x9a, 0x39, 0x0f,
+	0x3a, 0x56, 0xf7, 0x60, 0xe8, 0x94, 0x85, 0xd7, 0xd2, 0xe2, 0xad, 0xb2, 0x1f, 0x34, 0x4c, 0xfe,
+	0x51, 0xa5, 0x67, 0xee, 0xb7, 0x2b, 0xaf, 0xf6, 0xe3, 0xca, 0xab, 0xfd, 0xf9, 0xd5, 0x03, 0x3b,
+	0xfe, 0x47, 0xfe, 0xcf, 0x3d, 0xfb, 0xd9, 0x0e, 0x1c, 0x50, 0xc2, 0xb3, 0x18, 0xb4, 0xec, 0x7d,
+	0x3d, 0x52, 0xbd, 0xea, 0x20, 0xd0, 0x89, 0x32, 0x44, 0x78, 0x92, 0x50, 0xa2, 0xbe, 0x28, 0x64,
+	0xf1, 0xae, 0xa1, 0xad, 0xb2, 0x1f, 0x34, 0x36, 0xf9, 0x28, 0x06, 0xaf, 0xed, 0x47, 0x29, 0xcf,
+	0xa4, 0x12, 0xee, 0x55, 0x42, 0x50, 0x16, 0x5e, 0x53, 0x0b, 0x4d, 0xc1, 0x0f, 0xea, 0x2a, 0x1a,
+	0xc5, 0xe0, 0xc2, 0x3e, 0x5a, 0xbf, 0x0e, 0xc7, 0x71, 0x46, 0x85, 0x70, 0x1e, 0x56, 0xa2, 0x76,
+	0x59, 0x78, 0x4f, 0xb7, 0x9f, 0x6f, 0x08, 0x7e, 0xd0, 0x34, 0xc8, 0xb9, 0x06, 0xc0, 0x07, 0xfb,
+	0x18, 0x13, 0xc9, 0x72, 0x1a, 0x9a, 0x1f, 0x55, 0xb3, 0xf7, 0xab, 0x36, 0x2f, 0xca, 0xc2, 0x73,
+	0xd6, 0x6d, 0xee, 0x50, 0xfc, 0xe0, 0x48, 0x63, 0x17, 0x1a, 0x1a, 0xc5, 0x60, 0x66, 0xb7, 0xee,
+	0xd0, 0x84, 0xc4, 0x92, 0x3a, 0xf5, 0x8e, 0xd5, 0x6d, 0x0e, 0xda, 0x50, 0xed, 0xaf, 0xda, 0x22,
+	0xb8, 0x5e, 0x9d, 0xbc, 0x0f, 0x3f, 0x29, 0xc6, 0xd0, 0x2b, 0x0b, 0xef, 0xe4, 0xde, 0x41, 0x55,
+	0x07, 0x3f, 0x00, 0x5b, 0xb3, 0xb4, 0x28, 0xfc, 0xbd, 0x74, 0xad, 0xc5, 0xd2, 0xb5, 0xfe, 0x2f,
+	0x5d, 0xeb, 0xfb, 0xca, 0xad, 0x2d, 0x56, 0x6e, 0xed, 0xdf, 0xca, 0xad, 0x7d, 0x7e, 0x3f, 0x66,
+	0x72, 0x32, 0x8f, 0x20, 0xe1, 0x33, 0xb3, 0xfe, 0x88, 0x45, 0xa4, 0x37, 0xe6, 0x28, 0x3f, 0x45,
+	0x33, 0x1e, 0xcf, 0xa7, 0x54, 0xa8, 0x03, 0x14, 0x68, 0xf0, 0xb6, 0xb7, 0x39, 0xa2, 0xde, 0xcd,
+	0xed, 0xc9, 0xcb, 0x94, 0x8a, 0xa8, 0x5e, 0xad, 0xf6, 0xe9, 0xf5, 0x00, 0x58, 0x67, 0x19, 0xbd,
+	0xb0, 0x03, 0x00, 0x00,
 }
 
 func (m *InterchainAccount) Marshal() (dAtA []byte, err error) {
@@ -139,6 +244,69 @@ func (m *InterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InterchainAccountRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveChannelState != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.ActiveChannelState))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ActiveChannelId) > 0 {
+		i -= len(m.ActiveChannelId)
+		copy(dAtA[i:], m.ActiveChannelId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.ActiveChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAccount(v)
 	base := offset
@@ -167,6 +335,38 @@ func (m *InterchainAccount) Size() (n int) {
 	return n
 }
 
+func (m *InterchainAccountRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.ActiveChannelId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.ActiveChannelState != 0 {
+		n += 1 + sovAccount(uint64(m.ActiveChannelState))
+	}
+	return n
+}
+
 func sovAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -291,6 +491,235 @@ func (m *InterchainAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InterchainAccountRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveChannelState", wireType)
+			}
+			m.ActiveChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveChannelState |= types1.State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// ValidateChannelOrdering returns an error if the provided channel ordering is not supported by interchain accounts
+// channels. Both ORDERED and UNORDERED channels are supported, the ordering is agreed upon during the channel handshake.
+func ValidateChannelOrdering(order channeltypes.Order) error {
+	switch order {
+	case channeltypes.ORDERED, channeltypes.UNORDERED:
+		return nil
+	default:
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s", channeltypes.ORDERED, channeltypes.UNORDERED, order)
+	}
+}
@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestValidateChannelOrdering() {
+	testCases := []struct {
+		name    string
+		order   channeltypes.Order
+		expPass bool
+	}{
+		{"success: ORDERED", channeltypes.ORDERED, true},
+		{"success: UNORDERED", channeltypes.UNORDERED, true},
+		{"unspecified ordering", channeltypes.NONE, false},
+		{"unknown ordering", channeltypes.Order(5), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := types.ValidateChannelOrdering(tc.order)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, channeltypes.ErrInvalidChannelOrdering)
+			}
+		})
+	}
+}
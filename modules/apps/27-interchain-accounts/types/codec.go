@@ -25,9 +25,17 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // SerializeCosmosTx serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
 // packed into Any's and inserted into the Messages field of a CosmosTx. The proto marshaled CosmosTx
 // bytes are returned. Only the ProtoCodec is supported for serializing messages.
-func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err error) {
+func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) ([]byte, error) {
+	return SerializeCosmosTxWithEncoding(cdc, msgs, EncodingProtobuf)
+}
+
+// SerializeCosmosTxWithEncoding serializes a slice of sdk.Msg's using the CosmosTx type and the given
+// encoding format negotiated in the channel metadata. The sdk.Msg's are packed into Any's and inserted
+// into the Messages field of a CosmosTx. Only the ProtoCodec is supported for serializing messages.
+func SerializeCosmosTxWithEncoding(cdc codec.BinaryCodec, msgs []sdk.Msg, encoding string) (bz []byte, err error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
@@ -44,25 +52,28 @@ func SerializeCosmosTx(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err er
 		Messages: msgAnys,
 	}
 
-	bz, err = cdc.Marshal(cosmosTx)
-	if err != nil {
-		return nil, err
-	}
-
-	return bz, nil
+	return marshal(protoCdc, cosmosTx, encoding)
 }
 
 // DeserializeCosmosTx unmarshals and unpacks a slice of transaction bytes
 // into a slice of sdk.Msg's. Only the ProtoCodec is supported for message
 // deserialization.
 func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+	return DeserializeCosmosTxWithEncoding(cdc, data, EncodingProtobuf)
+}
+
+// DeserializeCosmosTxWithEncoding unmarshals and unpacks a slice of transaction bytes encoded using the
+// given encoding format into a slice of sdk.Msg's. Only the ProtoCodec is supported for message
+// deserialization.
+func DeserializeCosmosTxWithEncoding(cdc codec.BinaryCodec, data []byte, encoding string) ([]sdk.Msg, error) {
 	// only ProtoCodec is supported
-	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
 		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
 	}
 
 	var cosmosTx CosmosTx
-	if err := cdc.Unmarshal(data, &cosmosTx); err != nil {
+	if err := unmarshal(protoCdc, data, &cosmosTx, encoding); err != nil {
 		return nil, err
 	}
 
@@ -81,3 +92,72 @@ func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error)
 
 	return msgs, nil
 }
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type. The proto
+// marshaled CosmosQuery bytes are returned. Only the ProtoCodec is supported for serializing queries.
+func SerializeCosmosQuery(cdc codec.BinaryCodec, requests []QueryRequest) ([]byte, error) {
+	return SerializeCosmosQueryWithEncoding(cdc, requests, EncodingProtobuf)
+}
+
+// SerializeCosmosQueryWithEncoding serializes a slice of query requests using the CosmosQuery type and
+// the given encoding format. Only the ProtoCodec is supported for serializing queries.
+func SerializeCosmosQueryWithEncoding(cdc codec.BinaryCodec, requests []QueryRequest, encoding string) ([]byte, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving queries on the host chain")
+	}
+
+	cosmosQuery := &CosmosQuery{
+		Requests: requests,
+	}
+
+	return marshal(protoCdc, cosmosQuery, encoding)
+}
+
+// DeserializeCosmosQuery unmarshals a slice of query bytes into a slice of query requests.
+// Only the ProtoCodec is supported for query deserialization.
+func DeserializeCosmosQuery(cdc codec.BinaryCodec, data []byte) ([]QueryRequest, error) {
+	return DeserializeCosmosQueryWithEncoding(cdc, data, EncodingProtobuf)
+}
+
+// DeserializeCosmosQueryWithEncoding unmarshals a slice of query bytes encoded using the given encoding
+// format into a slice of query requests. Only the ProtoCodec is supported for query deserialization.
+func DeserializeCosmosQueryWithEncoding(cdc codec.BinaryCodec, data []byte, encoding string) ([]QueryRequest, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving queries on the host chain")
+	}
+
+	var cosmosQuery CosmosQuery
+	if err := unmarshal(protoCdc, data, &cosmosQuery, encoding); err != nil {
+		return nil, err
+	}
+
+	return cosmosQuery.Requests, nil
+}
+
+// marshal encodes the message using the given encoding format
+func marshal(cdc *codec.ProtoCodec, msg codec.ProtoMarshaler, encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingProtobuf:
+		return cdc.Marshal(msg)
+	case EncodingProto3JSON:
+		return cdc.MarshalJSON(msg)
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
+// unmarshal decodes the bytes encoded using the given encoding format into the message
+func unmarshal(cdc *codec.ProtoCodec, bz []byte, msg codec.ProtoMarshaler, encoding string) error {
+	switch encoding {
+	case EncodingProtobuf:
+		return cdc.Unmarshal(bz, msg)
+	case EncodingProto3JSON:
+		return cdc.UnmarshalJSON(bz, msg)
+	default:
+		return sdkerrors.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
@@ -149,3 +149,129 @@ func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxWithAmino() {
 	suite.Require().Error(err)
 	suite.Require().Empty(bz)
 }
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	balanceReq := banktypes.QueryBalanceRequest{
+		Address: TestOwnerAddress,
+		Denom:   "bananas",
+	}
+
+	requests := []types.QueryRequest{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: simapp.MakeTestEncodingConfig().Marshaler.MustMarshal(&balanceReq),
+		},
+		{
+			Path: "/cosmos.bank.v1beta1.Query/TotalSupply",
+		},
+	}
+
+	bz, err := types.SerializeCosmosQuery(simapp.MakeTestEncodingConfig().Marshaler, requests)
+	suite.Require().NoError(err)
+
+	deserialized, err := types.DeserializeCosmosQuery(simapp.MakeTestEncodingConfig().Marshaler, bz)
+	suite.Require().NoError(err)
+	suite.Require().Equal(requests, deserialized)
+
+	// test deserializing unknown bytes
+	deserialized, err = types.DeserializeCosmosQuery(simapp.MakeTestEncodingConfig().Marshaler, []byte("invalid"))
+	suite.Require().Error(err)
+	suite.Require().Empty(deserialized)
+
+	// test unsupported amino codec
+	marshaler := codec.NewAminoCodec(codec.NewLegacyAmino())
+
+	bz, err = types.SerializeCosmosQuery(marshaler, requests)
+	suite.Require().Error(err)
+	suite.Require().Empty(bz)
+
+	deserialized, err = types.DeserializeCosmosQuery(marshaler, []byte{0x10, 0})
+	suite.Require().Error(err)
+	suite.Require().Empty(deserialized)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTxWithEncoding() {
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: TestOwnerAddress,
+			ToAddress:   TestOwnerAddress,
+			Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+		},
+		&govtypes.MsgSubmitProposal{
+			InitialDeposit: sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+			Proposer:       TestOwnerAddress,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		encoding string
+		expPass  bool
+	}{
+		{"success: protobuf encoding", types.EncodingProtobuf, true},
+		{"success: proto3 json encoding", types.EncodingProto3JSON, true},
+		{"unsupported encoding format", "invalid-encoding-format", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+			bz, err := types.SerializeCosmosTxWithEncoding(cdc, msgs, tc.encoding)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+				suite.Require().Empty(bz)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			deserialized, err := types.DeserializeCosmosTxWithEncoding(cdc, bz, tc.encoding)
+			suite.Require().NoError(err)
+			suite.Require().Equal(msgs, deserialized)
+		})
+	}
+
+	// proto3 json encoded bytes use the type url of the messages
+	bz, err := types.SerializeCosmosTxWithEncoding(simapp.MakeTestEncodingConfig().Marshaler, msgs[:1], types.EncodingProto3JSON)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(bz), `"@type":"/cosmos.bank.v1beta1.MsgSend"`)
+
+	// bytes encoded using one encoding format cannot be decoded using the other
+	_, err = types.DeserializeCosmosTxWithEncoding(simapp.MakeTestEncodingConfig().Marshaler, bz, types.EncodingProtobuf)
+	suite.Require().Error(err)
+
+	bz, err = types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, msgs)
+	suite.Require().NoError(err)
+
+	_, err = types.DeserializeCosmosTxWithEncoding(simapp.MakeTestEncodingConfig().Marshaler, bz, types.EncodingProto3JSON)
+	suite.Require().Error(err)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQueryWithEncoding() {
+	requests := []types.QueryRequest{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: simapp.MakeTestEncodingConfig().Marshaler.MustMarshal(&banktypes.QueryBalanceRequest{Address: TestOwnerAddress, Denom: "bananas"}),
+		},
+	}
+
+	for _, encoding := range []string{types.EncodingProtobuf, types.EncodingProto3JSON} {
+		bz, err := types.SerializeCosmosQueryWithEncoding(simapp.MakeTestEncodingConfig().Marshaler, requests, encoding)
+		suite.Require().NoError(err, encoding)
+
+		deserialized, err := types.DeserializeCosmosQueryWithEncoding(simapp.MakeTestEncodingConfig().Marshaler, bz, encoding)
+		suite.Require().NoError(err, encoding)
+		suite.Require().Equal(requests, deserialized, encoding)
+	}
+
+	bz, err := types.SerializeCosmosQueryWithEncoding(simapp.MakeTestEncodingConfig().Marshaler, requests, "invalid-encoding-format")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(bz)
+
+	deserialized, err := types.DeserializeCosmosQueryWithEncoding(simapp.MakeTestEncodingConfig().Marshaler, []byte("{}"), "invalid-encoding-format")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+	suite.Require().Empty(deserialized)
+}
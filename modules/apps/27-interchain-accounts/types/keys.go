@@ -39,6 +39,19 @@ var (
 
 	// PortKeyPrefix defines the key prefix used to store ports
 	PortKeyPrefix = "port"
+
+	// IsMiddlewareEnabledPrefix defines the key prefix used to store a flag indicating whether the
+	// underlying application callbacks are enabled for a controller port and connection
+	IsMiddlewareEnabledPrefix = "isMiddlewareEnabled"
+
+	// ChannelHistoryKeyPrefix defines the key prefix used to store the channels opened for a controller port and connection
+	ChannelHistoryKeyPrefix = "channelHistory"
+
+	// MiddlewareEnabled is the value used to signal that the underlying application callbacks are enabled
+	MiddlewareEnabled = []byte{0x01}
+
+	// MiddlewareDisabled is the value used to signal that the underlying application callbacks are disabled
+	MiddlewareDisabled = []byte{0x02}
 )
 
 // KeyActiveChannel creates and returns a new key used for active channels store operations
@@ -51,7 +64,32 @@ func KeyOwnerAccount(portID, connectionID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", OwnerKeyPrefix, portID, connectionID))
 }
 
+// KeyOwnerAccountPrefix creates and returns a new key prefix used for iterating over the interchain accounts whose
+// owner address starts with the provided owner prefix. All interchain accounts are iterated if the owner prefix is empty.
+func KeyOwnerAccountPrefix(ownerPrefix string) []byte {
+	if ownerPrefix == "" {
+		return []byte(fmt.Sprintf("%s/", OwnerKeyPrefix))
+	}
+
+	return []byte(fmt.Sprintf("%s/%s%s", OwnerKeyPrefix, PortPrefix, ownerPrefix))
+}
+
 // KeyPort creates and returns a new key used for port store operations
 func KeyPort(portID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", PortKeyPrefix, portID))
 }
+
+// KeyIsMiddlewareEnabled creates and returns a new key used for signaling whether the underlying application callbacks are enabled
+func KeyIsMiddlewareEnabled(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", IsMiddlewareEnabledPrefix, portID, connectionID))
+}
+
+// KeyChannelHistory creates and returns a new key prefix used for iterating over the channel history of a controller port and connection
+func KeyChannelHistory(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ChannelHistoryKeyPrefix, portID, connectionID))
+}
+
+// KeyChannelRecord creates and returns a new key used for channel history store operations
+func KeyChannelRecord(portID, connectionID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ChannelHistoryKeyPrefix, portID, connectionID, channelID))
+}
@@ -13,3 +13,18 @@ func (suite *TypesTestSuite) TestKeyOwnerAccount() {
 	key := types.KeyOwnerAccount("port-id", "connection-id")
 	suite.Require().Equal("owner/port-id/connection-id", string(key))
 }
+
+func (suite *TypesTestSuite) TestKeyOwnerAccountPrefix() {
+	suite.Require().Equal("owner/", string(types.KeyOwnerAccountPrefix("")))
+	suite.Require().Equal("owner/icacontroller-cosmos1", string(types.KeyOwnerAccountPrefix("cosmos1")))
+}
+
+func (suite *TypesTestSuite) TestKeyIsMiddlewareEnabled() {
+	key := types.KeyIsMiddlewareEnabled("port-id", "connection-id")
+	suite.Require().Equal("isMiddlewareEnabled/port-id/connection-id", string(key))
+}
+
+func (suite *TypesTestSuite) TestKeyChannelRecord() {
+	key := types.KeyChannelRecord("port-id", "connection-id", "channel-id")
+	suite.Require().Equal("channelHistory/port-id/connection-id/channel-id", string(key))
+}
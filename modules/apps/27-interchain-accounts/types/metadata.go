@@ -11,6 +11,9 @@ const (
 	// EncodingProtobuf defines the protocol buffers proto3 encoding format
 	EncodingProtobuf = "proto3"
 
+	// EncodingProto3JSON defines the proto3 JSON encoding format
+	EncodingProto3JSON = "proto3json"
+
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
 )
@@ -119,7 +122,7 @@ func isSupportedEncoding(encoding string) bool {
 
 // getSupportedEncoding returns a string slice of supported encoding formats
 func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf}
+	return []string{EncodingProtobuf, EncodingProto3JSON}
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
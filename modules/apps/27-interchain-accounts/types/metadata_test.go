@@ -152,6 +152,13 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3 json encoding",
+			func() {
+				metadata.Encoding = types.EncodingProto3JSON
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -293,6 +300,13 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3 json encoding",
+			func() {
+				metadata.Encoding = types.EncodingProto3JSON
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
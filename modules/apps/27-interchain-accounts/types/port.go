@@ -15,3 +15,13 @@ func NewControllerPortID(owner string) (string, error) {
 
 	return fmt.Sprint(PortPrefix, owner), nil
 }
+
+// ParseControllerPortID returns the owner string of the provided prefixed controller port identifier.
+// False is returned if the port identifier is not prefixed with the controller port prefix.
+func ParseControllerPortID(portID string) (string, bool) {
+	if !strings.HasPrefix(portID, PortPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(portID, PortPrefix), true
+}
@@ -57,3 +57,13 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestParseControllerPortID() {
+	owner, found := types.ParseControllerPortID(fmt.Sprint(types.PortPrefix, TestOwnerAddress))
+	suite.Require().True(found)
+	suite.Require().Equal(TestOwnerAddress, owner)
+
+	owner, found = types.ParseControllerPortID("transfer")
+	suite.Require().False(found)
+	suite.Require().Empty(owner)
+}
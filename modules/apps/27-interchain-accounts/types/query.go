@@ -0,0 +1,83 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PaginateInterchainAccounts returns a page of the interchain accounts registered in the provided controller or host
+// store whose owner starts with the provided owner prefix. If a connection identifier is provided, only the interchain
+// accounts registered on that connection are returned. The state of the active channel of each interchain account is
+// looked up under the port identifier returned by channelPortID for the controller port identifier of the account.
+func PaginateInterchainAccounts(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	channelKeeper ChannelKeeper,
+	channelPortID func(portID string) string,
+	ownerPrefix, connectionID string,
+	pagination *query.PageRequest,
+) ([]InterchainAccountRecord, *query.PageResponse, error) {
+	keyPrefix := KeyOwnerAccountPrefix(ownerPrefix)
+	accountStore := prefix.NewStore(store, keyPrefix)
+
+	var interchainAccounts []InterchainAccountRecord
+	pageRes, err := query.FilteredPaginate(accountStore, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// keys are of the form owner/{portID}/{connectionID}
+		keySplit := strings.Split(string(keyPrefix)+string(key), "/")
+		if len(keySplit) != 3 {
+			return false, nil
+		}
+
+		portID, accountConnectionID := keySplit[1], keySplit[2]
+		if connectionID != "" && connectionID != accountConnectionID {
+			return false, nil
+		}
+
+		if accumulate {
+			record := NewInterchainAccountRecord(accountConnectionID, portID, string(value))
+			if channelID, found := getActiveChannelID(store, accountConnectionID, portID); found {
+				record.ActiveChannelId = channelID
+				if channel, found := channelKeeper.GetChannel(ctx, channelPortID(portID), channelID); found {
+					record.ActiveChannelState = channel.State
+				}
+			}
+
+			interchainAccounts = append(interchainAccounts, record)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return interchainAccounts, pageRes, nil
+}
+
+// NewInterchainAccountRecord creates a new InterchainAccountRecord instance without an active channel. The owner is
+// parsed from the provided controller port identifier and left empty if the port identifier is not prefixed with the
+// interchain accounts controller port prefix
+func NewInterchainAccountRecord(connectionID, portID, address string) InterchainAccountRecord {
+	owner, _ := ParseControllerPortID(portID)
+
+	return InterchainAccountRecord{
+		Owner:          owner,
+		ConnectionId:   connectionID,
+		PortId:         portID,
+		AccountAddress: address,
+	}
+}
+
+// getActiveChannelID returns the active channel identifier stored in the provided controller or host store for the
+// interchain account registered on the provided connection and controller port identifier
+func getActiveChannelID(store sdk.KVStore, connectionID, portID string) (string, bool) {
+	key := KeyActiveChannel(portID, connectionID)
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
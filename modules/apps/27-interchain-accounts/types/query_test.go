@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *TypesTestSuite) TestPaginateInterchainAccounts() {
+	var (
+		ownerPrefix  string
+		connectionID string
+		expRecords   []icatypes.InterchainAccountRecord
+	)
+
+	portID, err := icatypes.NewControllerPortID(TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	otherOwnerAddress := sdk.AccAddress([]byte("other-owner-address")).String()
+	otherPortID, err := icatypes.NewControllerPortID(otherOwnerAddress)
+	suite.Require().NoError(err)
+
+	activeRecord := icatypes.NewInterchainAccountRecord(ibctesting.FirstConnectionID, portID, TestOwnerAddress)
+	activeRecord.ActiveChannelId = ibctesting.FirstChannelID
+	activeRecord.ActiveChannelState = channeltypes.OPEN
+
+	otherRecord := icatypes.NewInterchainAccountRecord("connection-1", otherPortID, TestOwnerAddress)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: all interchain accounts",
+			func() {
+				expRecords = []icatypes.InterchainAccountRecord{activeRecord, otherRecord}
+				if portID > otherPortID {
+					expRecords = []icatypes.InterchainAccountRecord{otherRecord, activeRecord}
+				}
+			},
+		},
+		{
+			"success: filtered by connection",
+			func() {
+				connectionID = ibctesting.FirstConnectionID
+				expRecords = []icatypes.InterchainAccountRecord{activeRecord}
+			},
+		},
+		{
+			"success: filtered by owner prefix",
+			func() {
+				ownerPrefix = otherOwnerAddress
+				expRecords = []icatypes.InterchainAccountRecord{otherRecord}
+			},
+		},
+		{
+			"success: no interchain accounts on connection",
+			func() {
+				connectionID = "connection-2"
+				expRecords = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ownerPrefix, connectionID = "", ""
+
+			ctx := suite.chainA.GetContext()
+			controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+			controllerKeeper.SetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID, TestOwnerAddress)
+			controllerKeeper.SetActiveChannelID(ctx, ibctesting.FirstConnectionID, portID, ibctesting.FirstChannelID)
+			controllerKeeper.SetInterchainAccountAddress(ctx, "connection-1", otherPortID, TestOwnerAddress)
+
+			channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(icatypes.PortID, ibctesting.FirstChannelID), []string{ibctesting.FirstConnectionID}, "")
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(ctx, portID, ibctesting.FirstChannelID, channel)
+
+			tc.malleate()
+
+			records, _, err := icatypes.PaginateInterchainAccounts(
+				ctx, ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey)), suite.chainA.App.GetIBCKeeper().ChannelKeeper,
+				func(portID string) string { return portID }, ownerPrefix, connectionID, nil,
+			)
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(expRecords, records)
+		})
+	}
+}
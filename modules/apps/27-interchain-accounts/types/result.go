@@ -0,0 +1,230 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// MsgResponse defines the response of a single message executed by the interchain account on the host chain.
+// The Response is resolved from the message type URL using the interface registry and is nil if the
+// message type or its response type is unknown to the controller chain, or if the response bytes cannot
+// be unmarshaled into the response type, in which case only the raw response bytes are available.
+type MsgResponse struct {
+	MsgTypeURL string
+	Data       []byte
+	Response   proto.Message
+}
+
+// ExecutionError defines the error returned by the host chain for a failed interchain accounts packet.
+// The Code is the ABCI error code included in the error acknowledgement, or zero if it cannot be parsed.
+type ExecutionError struct {
+	Code    uint32
+	Message string
+}
+
+// Error implements the error interface
+func (e ExecutionError) Error() string {
+	return e.Message
+}
+
+// ExecutionResult defines the decoded result of an interchain accounts packet executed on the host chain.
+// MsgResponses is set for successful TYPE_EXECUTE_TX packets, in the order of the executed messages.
+// QueryResponses is set for successful TYPE_EXECUTE_QUERY packets, in the order of the query requests.
+// Error is set if the host chain returned an error acknowledgement.
+type ExecutionResult struct {
+	MsgResponses   []MsgResponse
+	QueryResponses []QueryResponse
+	Error          *ExecutionError
+}
+
+// Success returns true if the packet was executed successfully on the host chain.
+func (er ExecutionResult) Success() bool {
+	return er.Error == nil
+}
+
+// DecodeExecutionResult decodes the acknowledgement of an interchain accounts packet into an ExecutionResult.
+// The packet data is used to determine the type of the packet and therefore the format of the acknowledgement
+// result. The message responses are resolved using the interface registry of the provided codec. Only the
+// ProtoCodec is supported. The message responses of host chains running any Cosmos SDK version are decoded,
+// from the data field of TxMsgData up to v0.45 and from its msg_responses field from v0.46.
+func DecodeExecutionResult(cdc codec.BinaryCodec, packetData, acknowledgement []byte) (ExecutionResult, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return ExecutionResult{}, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for decoding execution results")
+	}
+
+	var data InterchainAccountPacketData
+	if err := ModuleCdc.UnmarshalJSON(packetData, &data); err != nil {
+		return ExecutionResult{}, sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data: %s", err.Error())
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return ExecutionResult{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 interchain account packet acknowledgement: %s", err.Error())
+	}
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return ExecutionResult{Error: parseExecutionError(resp.Error)}, nil
+	case *channeltypes.Acknowledgement_Result:
+		switch data.Type {
+		case EXECUTE_TX:
+			msgResponses, err := decodeMsgResponses(protoCdc, resp.Result)
+			if err != nil {
+				return ExecutionResult{}, err
+			}
+
+			return ExecutionResult{MsgResponses: msgResponses}, nil
+		case EXECUTE_QUERY:
+			var queryResponse CosmosQueryResponse
+			if err := protoCdc.Unmarshal(resp.Result, &queryResponse); err != nil {
+				return ExecutionResult{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal query responses: %s", err.Error())
+			}
+
+			return ExecutionResult{QueryResponses: queryResponse.Responses}, nil
+		default:
+			return ExecutionResult{}, ErrUnknownDataType
+		}
+	default:
+		return ExecutionResult{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unsupported acknowledgement response type %T", resp)
+	}
+}
+
+// txMsgDataMsgResponsesField is the field number of the msg_responses field added to TxMsgData in
+// Cosmos SDK v0.46, which is unknown to the TxMsgData type of the Cosmos SDK version of this module.
+const txMsgDataMsgResponsesField protowire.Number = 2
+
+// decodeMsgResponses unmarshals the TxMsgData returned by the host chain and resolves the response of
+// each message. Hosts running Cosmos SDK v0.45 or earlier return the responses in the data field of
+// TxMsgData, in which case the response type is the message type name suffixed with "Response", as
+// defined by the Msg service conventions. Hosts running Cosmos SDK v0.46 or later return the responses
+// in the msg_responses field as Any, in which case the message type is the response type name without
+// the "Response" suffix. An error is returned if the result is not empty but no responses are decoded.
+func decodeMsgResponses(cdc *codec.ProtoCodec, result []byte) ([]MsgResponse, error) {
+	var txMsgData sdk.TxMsgData
+	if err := cdc.Unmarshal(result, &txMsgData); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal tx msg data: %s", err.Error())
+	}
+
+	var msgResponses []MsgResponse
+	if len(txMsgData.Data) > 0 {
+		msgResponses = make([]MsgResponse, len(txMsgData.Data))
+		for i, msgData := range txMsgData.Data {
+			msgResponses[i] = MsgResponse{
+				MsgTypeURL: msgData.MsgType,
+				Data:       msgData.Data,
+				Response:   resolveMsgResponse(cdc, msgData.MsgType, msgData.Data),
+			}
+		}
+	} else {
+		anys, err := unmarshalMsgResponseAnys(result)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal tx msg data msg responses: %s", err.Error())
+		}
+
+		msgResponses = make([]MsgResponse, len(anys))
+		for i, msgResponseAny := range anys {
+			msgTypeURL := strings.TrimSuffix(msgResponseAny.TypeUrl, "Response")
+			msgResponses[i] = MsgResponse{
+				MsgTypeURL: msgTypeURL,
+				Data:       msgResponseAny.Value,
+				Response:   resolveMsgResponse(cdc, msgTypeURL, msgResponseAny.Value),
+			}
+		}
+	}
+
+	if len(result) > 0 && len(msgResponses) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no msg responses decoded from non-empty tx msg data")
+	}
+
+	return msgResponses, nil
+}
+
+// resolveMsgResponse returns the response of the given message type unmarshaled from the given bytes.
+// Nil is returned if the message type or its response type is unknown, or if the bytes cannot be
+// unmarshaled into the response type.
+func resolveMsgResponse(cdc *codec.ProtoCodec, msgTypeURL string, bz []byte) proto.Message {
+	msg, err := cdc.InterfaceRegistry().Resolve(msgTypeURL)
+	if err != nil {
+		return nil
+	}
+
+	responseType := proto.MessageType(proto.MessageName(msg) + "Response")
+	if responseType == nil {
+		return nil
+	}
+
+	response, ok := reflect.New(responseType.Elem()).Interface().(codec.ProtoMarshaler)
+	if !ok {
+		return nil
+	}
+
+	// the response is left unresolved if the host chain returned a response unknown to the controller chain
+	if err := cdc.Unmarshal(bz, response); err != nil {
+		return nil
+	}
+
+	return response
+}
+
+// unmarshalMsgResponseAnys unmarshals the msg_responses field of the TxMsgData encoded in the given bytes,
+// skipping all other fields.
+func unmarshalMsgResponseAnys(bz []byte) ([]codectypes.Any, error) {
+	var anys []codectypes.Any
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if num != txMsgDataMsgResponsesField || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			bz = bz[n:]
+
+			continue
+		}
+
+		value, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		var msgResponseAny codectypes.Any
+		if err := msgResponseAny.Unmarshal(value); err != nil {
+			return nil, err
+		}
+
+		anys = append(anys, msgResponseAny)
+	}
+
+	return anys, nil
+}
+
+// parseExecutionError parses the ABCI code from an error acknowledgement written by the host chain.
+func parseExecutionError(ackError string) *ExecutionError {
+	var code uint32
+	if _, err := fmt.Sscanf(ackError, "ABCI code: %d:", &code); err != nil {
+		code = 0
+	}
+
+	return &ExecutionError{
+		Code:    code,
+		Message: ackError,
+	}
+}
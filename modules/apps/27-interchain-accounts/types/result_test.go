@@ -0,0 +1,217 @@
+package types_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *TypesTestSuite) TestDecodeExecutionResult() {
+	var (
+		packetData      []byte
+		acknowledgement []byte
+		expResult       types.ExecutionResult
+	)
+
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	txPacketData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+	}.GetBytes()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: msg responses",
+			func() {
+				proposalResponse := &govtypes.MsgSubmitProposalResponse{ProposalId: 1}
+				proposalResponseBz, err := proto.Marshal(proposalResponse)
+				suite.Require().NoError(err)
+
+				txMsgData := &sdk.TxMsgData{
+					Data: []*sdk.MsgData{
+						{MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+						{MsgType: sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}), Data: proposalResponseBz},
+					},
+				}
+				bz, err := proto.Marshal(txMsgData)
+				suite.Require().NoError(err)
+
+				acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+				expResult = types.ExecutionResult{
+					MsgResponses: []types.MsgResponse{
+						{MsgTypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), Response: &banktypes.MsgSendResponse{}},
+						{MsgTypeURL: sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}), Data: proposalResponseBz, Response: proposalResponse},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: unknown msg type is left unresolved",
+			func() {
+				txMsgData := &sdk.TxMsgData{
+					Data: []*sdk.MsgData{
+						{MsgType: "/unknown.v1.MsgUnknown", Data: []byte("response")},
+					},
+				}
+				bz, err := proto.Marshal(txMsgData)
+				suite.Require().NoError(err)
+
+				acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+				expResult = types.ExecutionResult{
+					MsgResponses: []types.MsgResponse{
+						{MsgTypeURL: "/unknown.v1.MsgUnknown", Data: []byte("response")},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: msg responses of hosts running Cosmos SDK v0.46 or later",
+			func() {
+				proposalResponse := &govtypes.MsgSubmitProposalResponse{ProposalId: 1}
+				proposalResponseBz, err := proto.Marshal(proposalResponse)
+				suite.Require().NoError(err)
+
+				// the msg_responses field of TxMsgData is encoded manually as it is unknown to this Cosmos SDK version
+				var bz []byte
+				for _, msgResponse := range []*codectypes.Any{
+					{TypeUrl: "/cosmos.bank.v1beta1.MsgSendResponse"},
+					{TypeUrl: "/cosmos.gov.v1beta1.MsgSubmitProposalResponse", Value: proposalResponseBz},
+					{TypeUrl: "/unknown.v1.MsgUnknownResponse", Value: []byte("response")},
+				} {
+					anyBz, err := msgResponse.Marshal()
+					suite.Require().NoError(err)
+
+					bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+					bz = protowire.AppendBytes(bz, anyBz)
+				}
+
+				acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+				expResult = types.ExecutionResult{
+					MsgResponses: []types.MsgResponse{
+						{MsgTypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), Response: &banktypes.MsgSendResponse{}},
+						{MsgTypeURL: sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}), Data: proposalResponseBz, Response: proposalResponse},
+						{MsgTypeURL: "/unknown.v1.MsgUnknown", Data: []byte("response")},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"tx msg data without msg responses",
+			func() {
+				bz := protowire.AppendTag(nil, 3, protowire.VarintType)
+				bz = protowire.AppendVarint(bz, 1)
+
+				acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+			},
+			false,
+		},
+		{
+			"success: query responses",
+			func() {
+				packetData = types.InterchainAccountPacketData{
+					Type: types.EXECUTE_QUERY,
+					Data: []byte("data"),
+				}.GetBytes()
+
+				queryResponse := &types.CosmosQueryResponse{
+					Responses: []types.QueryResponse{{Value: []byte("value"), Height: 10}},
+				}
+				bz, err := proto.Marshal(queryResponse)
+				suite.Require().NoError(err)
+
+				acknowledgement = channeltypes.NewResultAcknowledgement(bz).Acknowledgement()
+				expResult = types.ExecutionResult{
+					QueryResponses: queryResponse.Responses,
+				}
+			},
+			true,
+		},
+		{
+			"success: error acknowledgement",
+			func() {
+				ack := hosttypes.NewErrorAcknowledgement(sdkerrors.ErrUnauthorized)
+				acknowledgement = ack.Acknowledgement()
+				expResult = types.ExecutionResult{
+					Error: &types.ExecutionError{
+						Code:    sdkerrors.ErrUnauthorized.ABCICode(),
+						Message: ack.GetError(),
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success: error acknowledgement without ABCI code",
+			func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgement("failed").Acknowledgement()
+				expResult = types.ExecutionResult{
+					Error: &types.ExecutionError{Message: "failed"},
+				}
+			},
+			true,
+		},
+		{
+			"invalid packet data",
+			func() {
+				packetData = []byte("invalid packet data")
+			},
+			false,
+		},
+		{
+			"invalid acknowledgement",
+			func() {
+				acknowledgement = []byte("invalid acknowledgement")
+			},
+			false,
+		},
+		{
+			"invalid tx msg data",
+			func() {
+				acknowledgement = channeltypes.NewResultAcknowledgement([]byte("invalid tx msg data")).Acknowledgement()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			packetData = txPacketData
+			acknowledgement = channeltypes.NewResultAcknowledgement([]byte{}).Acknowledgement()
+			expResult = types.ExecutionResult{}
+
+			tc.malleate()
+
+			result, err := types.DecodeExecutionResult(cdc, packetData, acknowledgement)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResult, result)
+				suite.Require().Equal(expResult.Error == nil, result.Success())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// test unsupported amino codec
+	_, err := types.DecodeExecutionResult(codec.NewAminoCodec(codec.NewLegacyAmino()), txPacketData, acknowledgement)
+	suite.Require().Error(err)
+}
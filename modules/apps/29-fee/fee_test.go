@@ -0,0 +1,107 @@
+package fee_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(300)}}
+)
+
+type FeeTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *FeeTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewFeeMockPath(suite.chainA, suite.chainB)
+}
+
+func TestFeeTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeTestSuite))
+}
+
+// NewFeeMockPath returns a path between the fee enabled mock applications of the provided chains.
+func NewFeeMockPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.MockFeePort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.MockFeePort
+	path.EndpointA.ChannelConfig.Version = ibctesting.MockFeeVersion
+	path.EndpointB.ChannelConfig.Version = ibctesting.MockFeeVersion
+
+	return path
+}
+
+// NewFeeTransferPath returns a path between the transfer applications of the provided chains
+// with the fee middleware enabled.
+func NewFeeTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+
+	return path
+}
+
+// getFeeMiddleware returns the IBC module routed to by the fee enabled mock port on the provided chain.
+func (suite *FeeTestSuite) getFeeMiddleware(chain *ibctesting.TestChain) porttypes.IBCModule {
+	module, _, err := chain.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(chain.GetContext(), ibctesting.MockFeePort)
+	suite.Require().NoError(err)
+
+	cbs, ok := chain.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	return cbs
+}
+
+// createMockPacket sends a mock packet from endpoint A of the test path and returns it.
+func (suite *FeeTestSuite) createMockPacket(data []byte) channeltypes.Packet {
+	sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+
+	packet := channeltypes.NewPacket(
+		data, sequence,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+
+	return packet
+}
+
+// escrowFee escrows the provided fee for the packet using the given sender account on chain A.
+func (suite *FeeTestSuite) escrowFee(packetID channeltypes.PacketId, fee types.Fee, refundAddr sdk.AccAddress) {
+	packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
+	msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+	_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,570 @@
+package fee_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	fee "github.com/cosmos/ibc-go/v3/modules/apps/29-fee"
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+func (suite *FeeTestSuite) TestChannelHandshake() {
+	var (
+		initVersion         string
+		counterpartyVersion string
+		expFeeEnabled       bool
+	)
+
+	invalidFeeVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: "invalid-ics29-1", AppVersion: ibcmock.Version}))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expInitPass bool
+		expAckPass  bool
+	}{
+		{
+			"success: fee enabled", func() {}, true, true,
+		},
+		{
+			"success: fee disabled", func() {
+				initVersion = ibcmock.Version
+				expFeeEnabled = false
+			}, true, true,
+		},
+		{
+			"failure: invalid fee version on init", func() {
+				initVersion = invalidFeeVersion
+			}, false, false,
+		},
+		{
+			"failure: counterparty version is not a fee version on ack", func() {
+				counterpartyVersion = ibcmock.Version
+			}, true, false,
+		},
+		{
+			"failure: counterparty version has an invalid fee version on ack", func() {
+				counterpartyVersion = invalidFeeVersion
+			}, true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.SetupConnections(suite.path)
+
+			initVersion = ibctesting.MockFeeVersion
+			counterpartyVersion = ""
+			expFeeEnabled = true
+
+			tc.malleate()
+
+			suite.path.EndpointA.ChannelConfig.Version = initVersion
+
+			if !tc.expInitPass {
+				// invoke the callback directly as the testing package requires handshake messages to succeed
+				counterparty := channeltypes.NewCounterparty(suite.path.EndpointB.ChannelConfig.PortID, "")
+				err := suite.getFeeMiddleware(suite.chainA).OnChanOpenInit(
+					suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.Order, []string{suite.path.EndpointA.ConnectionID},
+					suite.path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID, nil, counterparty, initVersion,
+				)
+				suite.Require().ErrorIs(err, types.ErrInvalidVersion)
+				return
+			}
+
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenInit())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+
+			if !tc.expAckPass {
+				// invoke the callback directly as the counterparty version would otherwise fail proof verification
+				err := suite.getFeeMiddleware(suite.chainA).OnChanOpenAck(
+					suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+					suite.path.EndpointB.ChannelID, counterpartyVersion,
+				)
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+
+			for _, endpoint := range []*ibctesting.Endpoint{suite.path.EndpointA, suite.path.EndpointB} {
+				feeKeeper := endpoint.Chain.GetSimApp().IBCFeeKeeper
+				ctx := endpoint.Chain.GetContext()
+
+				suite.Require().Equal(expFeeEnabled, feeKeeper.IsFeeEnabled(ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID))
+
+				appVersion, found := feeKeeper.GetAppVersion(ctx, endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(ibcmock.Version, appVersion)
+			}
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestOnRecvPacket() {
+	var (
+		packet          channeltypes.Packet
+		feeEnabled      bool
+		registerPayee   bool
+		expAsync        bool
+		expFailedAppAck bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: counterparty payee is registered", func() {},
+		},
+		{
+			"success: counterparty payee is not registered", func() {
+				registerPayee = false
+			},
+		},
+		{
+			"success: application returns an error acknowledgement", func() {
+				packet.Data = ibctesting.MockFailPacketData
+				expFailedAppAck = true
+			},
+		},
+		{
+			"success: asynchronous acknowledgement", func() {
+				packet.Data = ibcmock.MockAsyncPacketData
+				expAsync = true
+			},
+		},
+		{
+			"success: fee not enabled", func() {
+				feeEnabled = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			relayer := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			counterpartyPayee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+
+			feeEnabled, registerPayee, expAsync, expFailedAppAck = true, true, false, false
+			packet = suite.createMockPacket(ibctesting.MockPacketData)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			feeKeeper := suite.chainB.GetSimApp().IBCFeeKeeper
+
+			if !feeEnabled {
+				feeKeeper.DeleteFeeEnabled(ctx, packet.DestinationPort, packet.DestinationChannel)
+			}
+
+			if registerPayee {
+				feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer.String(), counterpartyPayee, packet.DestinationChannel)
+			}
+
+			ack := suite.getFeeMiddleware(suite.chainB).OnRecvPacket(ctx, packet, relayer)
+
+			packetID := channeltypes.NewPacketId(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+
+			switch {
+			case !feeEnabled:
+				suite.Require().Equal(ibcmock.MockAcknowledgement, ack)
+
+			case expAsync:
+				suite.Require().Nil(ack)
+
+				forwardRelayer, found := feeKeeper.GetRelayerAddressForAsyncAck(ctx, packetID)
+				suite.Require().True(found)
+				suite.Require().Equal(relayer.String(), forwardRelayer)
+
+			default:
+				expForwardRelayer := ""
+				if registerPayee {
+					expForwardRelayer = counterpartyPayee
+				}
+
+				appAck := ibcmock.MockAcknowledgement
+				if expFailedAppAck {
+					appAck = ibcmock.MockFailAcknowledgement
+				}
+
+				expAck := types.NewIncentivizedAcknowledgement(expForwardRelayer, appAck.Acknowledgement(), appAck.Success())
+				suite.Require().Equal(expAck, ack)
+			}
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		packet         channeltypes.Packet
+		ackBytes       []byte
+		forwardRelayer string
+		expPayee       sdk.AccAddress
+		expDistributed bool
+		relayer        sdk.AccAddress
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: payee is registered", func() {
+				expPayee = suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), relayer.String(), expPayee.String(), packet.SourceChannel)
+			}, true,
+		},
+		{
+			"success: no forward relayer, receive fee is refunded", func() {
+				forwardRelayer = ""
+			}, true,
+		},
+		{
+			"success: no fees in escrow", func() {
+				packetID := channeltypes.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeesInEscrow(suite.chainA.GetContext(), packetID)
+				expDistributed = false
+			}, true,
+		},
+		{
+			"success: fee module is locked", func() {
+				lockFeeModule(suite.chainA)
+				expDistributed = false
+			}, true,
+		},
+		{
+			"success: fee not enabled", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel)
+				ackBytes = ibcmock.MockAcknowledgement.Acknowledgement()
+				expDistributed = false
+			}, true,
+		},
+		{
+			"failure: acknowledgement is not an incentivized acknowledgement", func() {
+				ackBytes = ibcmock.MockAcknowledgement.Acknowledgement()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			relayer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			refundAddr := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			packet = suite.createMockPacket(ibctesting.MockPacketData)
+			packetID := channeltypes.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.escrowFee(packetID, fee, refundAddr)
+
+			forwardRelayer = suite.chainA.SenderAccounts[4].SenderAccount.GetAddress().String()
+			expPayee = relayer
+			expDistributed = true
+			ackBytes = nil
+
+			tc.malleate()
+
+			if ackBytes == nil {
+				ack := types.NewIncentivizedAcknowledgement(forwardRelayer, ibcmock.MockAcknowledgement.Acknowledgement(), true)
+				ackBytes = ack.Acknowledgement()
+			}
+
+			ctx := suite.chainA.GetContext()
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+			refundBefore := bankKeeper.GetAllBalances(ctx, refundAddr)
+			payeeBefore := bankKeeper.GetAllBalances(ctx, expPayee)
+
+			var forwardBefore sdk.Coins
+			if forwardRelayer != "" {
+				forwardBefore = bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(forwardRelayer))
+			}
+
+			err := suite.getFeeMiddleware(suite.chainA).OnAcknowledgementPacket(ctx, packet, ackBytes, relayer)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			if !expDistributed {
+				suite.Require().Equal(refundBefore, bankKeeper.GetAllBalances(ctx, refundAddr))
+				suite.Require().Equal(payeeBefore, bankKeeper.GetAllBalances(ctx, expPayee))
+				return
+			}
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+			suite.Require().Equal(payeeBefore.Add(defaultAckFee...), bankKeeper.GetAllBalances(ctx, expPayee))
+
+			if forwardRelayer != "" {
+				suite.Require().Equal(forwardBefore.Add(defaultRecvFee...), bankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(forwardRelayer)))
+				suite.Require().Equal(refundBefore.Add(defaultTimeoutFee...), bankKeeper.GetAllBalances(ctx, refundAddr))
+			} else {
+				suite.Require().Equal(refundBefore.Add(defaultTimeoutFee...).Add(defaultRecvFee...), bankKeeper.GetAllBalances(ctx, refundAddr))
+			}
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestOnTimeoutPacket() {
+	var (
+		packet         channeltypes.Packet
+		expPayee       sdk.AccAddress
+		expDistributed bool
+		relayer        sdk.AccAddress
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success", func() {},
+		},
+		{
+			"success: payee is registered", func() {
+				expPayee = suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), relayer.String(), expPayee.String(), packet.SourceChannel)
+			},
+		},
+		{
+			"success: fee module is locked", func() {
+				lockFeeModule(suite.chainA)
+				expDistributed = false
+			},
+		},
+		{
+			"success: fee not enabled", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel)
+				expDistributed = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			relayer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			refundAddr := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			packet = suite.createMockPacket(ibctesting.MockPacketData)
+			packetID := channeltypes.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.escrowFee(packetID, fee, refundAddr)
+
+			expPayee = relayer
+			expDistributed = true
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+			refundBefore := bankKeeper.GetAllBalances(ctx, refundAddr)
+			payeeBefore := bankKeeper.GetAllBalances(ctx, expPayee)
+
+			err := suite.getFeeMiddleware(suite.chainA).OnTimeoutPacket(ctx, packet, relayer)
+			suite.Require().NoError(err)
+
+			if !expDistributed {
+				suite.Require().Equal(refundBefore, bankKeeper.GetAllBalances(ctx, refundAddr))
+				suite.Require().Equal(payeeBefore, bankKeeper.GetAllBalances(ctx, expPayee))
+				return
+			}
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+			suite.Require().Equal(payeeBefore.Add(defaultTimeoutFee...), bankKeeper.GetAllBalances(ctx, expPayee))
+			suite.Require().Equal(refundBefore.Add(defaultRecvFee...).Add(defaultAckFee...), bankKeeper.GetAllBalances(ctx, refundAddr))
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestOnChanCloseInit() {
+	var packetID channeltypes.PacketId
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expRefund bool
+	}{
+		{
+			"success: fees are refunded", func() {}, true, true,
+		},
+		{
+			"success: fee not enabled", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId)
+			}, true, false,
+		},
+		{
+			"failure: fee module is locked", func() {
+				lockFeeModule(suite.chainA)
+			}, false, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			refundAddr := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			packet := suite.createMockPacket(ibctesting.MockPacketData)
+			packetID = channeltypes.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.escrowFee(packetID, fee, refundAddr)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			refundBefore := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAddr)
+
+			err := suite.getFeeMiddleware(suite.chainA).OnChanCloseInit(ctx, packetID.PortId, packetID.ChannelId)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrFeeModuleLocked)
+			}
+
+			refundAfter := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAddr)
+			if tc.expRefund {
+				suite.Require().Equal(refundBefore.Add(fee.Total()...), refundAfter)
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+			} else {
+				suite.Require().Equal(refundBefore, refundAfter)
+			}
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestWriteAcknowledgementAsync() {
+	suite.coordinator.Setup(suite.path)
+
+	relayer := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	counterpartyPayee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+
+	packet := suite.createMockPacket(ibcmock.MockAsyncPacketData)
+	packetID := channeltypes.NewPacketId(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+
+	ctx := suite.chainB.GetContext()
+	feeKeeper := suite.chainB.GetSimApp().IBCFeeKeeper
+	feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer.String(), counterpartyPayee, packet.DestinationChannel)
+
+	ack := suite.getFeeMiddleware(suite.chainB).OnRecvPacket(ctx, packet, relayer)
+	suite.Require().Nil(ack)
+
+	// the packet receipt is written by core IBC when receiving the packet
+	suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+
+	middleware, ok := suite.getFeeMiddleware(suite.chainB).(fee.IBCMiddleware)
+	suite.Require().True(ok)
+
+	chanCap := suite.chainB.GetChannelCapability(packet.DestinationPort, packet.DestinationChannel)
+	err := middleware.WriteAcknowledgement(ctx, chanCap, packet, ibcmock.MockAcknowledgement)
+	suite.Require().NoError(err)
+
+	_, found := feeKeeper.GetRelayerAddressForAsyncAck(ctx, packetID)
+	suite.Require().False(found)
+
+	expAck := types.NewIncentivizedAcknowledgement(counterpartyPayee, ibcmock.MockAcknowledgement.Acknowledgement(), true)
+	commitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), commitment)
+}
+
+// TestFeeTransfer performs an incentivized ICS20 transfer over a fee enabled transfer channel and
+// asserts the fees are paid out to the relayers once the acknowledgement is relayed back.
+func (suite *FeeTestSuite) TestFeeTransfer() {
+	path := NewFeeTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	relayerB := suite.chainB.SenderAccount.GetAddress()
+	forwardRelayer := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// register the address on chain A which is paid the receive fee for relaying on chain B
+	msgRegister := types.NewMsgRegisterCounterpartyPayee(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, relayerB.String(), forwardRelayer.String())
+	_, err := suite.chainB.SendMsgs(msgRegister)
+	suite.Require().NoError(err)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msgPayFee := types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sender.String(), nil)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	msgTransfer := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), relayerB.String(), timeoutHeight, 0)
+
+	_, err = suite.chainA.SendMsgs(msgPayFee, msgTransfer)
+	suite.Require().NoError(err)
+
+	packetID := channeltypes.NewPacketId(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+	suite.Require().Equal(fee, feesInEscrow.PacketFees[0].Fee)
+
+	data := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender.String(), relayerB.String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+	ackBytes := suite.chainB.GetAcknowledgement(packet)
+	suite.Require().NotNil(ackBytes)
+
+	expAck := types.NewIncentivizedAcknowledgement(forwardRelayer.String(), channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), true)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ackBytes)
+
+	forwardBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forwardRelayer, sdk.DefaultBondDenom)
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, expAck.Acknowledgement()))
+
+	ctx := suite.chainA.GetContext()
+	forwardAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, forwardRelayer, sdk.DefaultBondDenom)
+	suite.Require().Equal(forwardBefore.Add(defaultRecvFee[0]), forwardAfter)
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress()).IsZero())
+}
+
+// lockFeeModule locks the fee module of the provided chain by attempting to distribute a fee
+// which exceeds the balance of the escrow account.
+func lockFeeModule(chain *ibctesting.TestChain) {
+	ctx := chain.GetContext()
+	refundAddr := chain.SenderAccount.GetAddress()
+
+	excessiveFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
+	packetFee := types.NewPacketFee(types.NewFee(excessiveFee, excessiveFee, excessiveFee), refundAddr.String(), nil)
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 100)
+
+	chain.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(ctx, refundAddr, []types.PacketFee{packetFee}, packetID)
+}
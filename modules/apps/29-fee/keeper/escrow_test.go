@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestDistributeFeesOnAcknowledgement() {
+	var (
+		packetID       channeltypes.PacketId
+		packetFees     []types.PacketFee
+		forwardRelayer string
+		reverseRelayer sdk.AccAddress
+		refundAcc      sdk.AccAddress
+		expRecvFeeAcc  sdk.AccAddress
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expLocked bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"forward relayer address is empty, receive fee is refunded",
+			func() {
+				forwardRelayer = ""
+				expRecvFeeAcc = refundAcc
+			},
+			false,
+		},
+		{
+			"forward relayer address is blocked, receive fee is refunded",
+			func() {
+				forwardRelayer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+				expRecvFeeAcc = refundAcc
+			},
+			false,
+		},
+		{
+			"escrow account has insufficient balance, fee module is locked",
+			func() {
+				excessiveFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
+				packetFees = append(packetFees, types.NewPacketFee(types.NewFee(excessiveFee, nil, nil), refundAcc.String(), nil))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAcc = suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+			reverseRelayer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			forwardAcc := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+			forwardRelayer = forwardAcc.String()
+			expRecvFeeAcc = forwardAcc
+
+			packetID = suite.sendPacket()
+			packetFee := types.NewPacketFee(fee, refundAcc.String(), nil)
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+			suite.Require().NoError(err)
+
+			packetFees = []types.PacketFee{packetFee}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			refundBalance := bankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom)
+			reverseBalance := bankKeeper.GetBalance(ctx, reverseRelayer, sdk.DefaultBondDenom)
+			recvFeeBalance := bankKeeper.GetBalance(ctx, expRecvFeeAcc, sdk.DefaultBondDenom)
+			escrowBalance := bankKeeper.GetBalance(ctx, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(ctx, forwardRelayer, reverseRelayer, packetFees, packetID)
+
+			if tc.expLocked {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(ctx))
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+				suite.Require().Equal(escrowBalance, bankKeeper.GetBalance(ctx, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom))
+				return
+			}
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+			suite.Require().True(bankKeeper.GetBalance(ctx, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom).IsZero())
+
+			// the reverse relayer is paid the acknowledgement fee
+			suite.Require().Equal(reverseBalance.Add(defaultAckFee[0]), bankKeeper.GetBalance(ctx, reverseRelayer, sdk.DefaultBondDenom))
+
+			if expRecvFeeAcc.Equals(refundAcc) {
+				// the refund account is refunded both the receive and timeout fees
+				suite.Require().Equal(refundBalance.Add(defaultRecvFee[0]).Add(defaultTimeoutFee[0]), bankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().Equal(recvFeeBalance.Add(defaultRecvFee[0]), bankKeeper.GetBalance(ctx, expRecvFeeAcc, sdk.DefaultBondDenom))
+				suite.Require().Equal(refundBalance.Add(defaultTimeoutFee[0]), bankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeFeesOnTimeout() {
+	var (
+		packetID       channeltypes.PacketId
+		packetFees     []types.PacketFee
+		timeoutRelayer sdk.AccAddress
+		refundAcc      sdk.AccAddress
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expLocked bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"timeout relayer is a blocked address, timeout fee is refunded",
+			func() {
+				timeoutRelayer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			},
+			false,
+		},
+		{
+			"escrow account has insufficient balance, fee module is locked",
+			func() {
+				excessiveFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
+				packetFees = append(packetFees, types.NewPacketFee(types.NewFee(nil, nil, excessiveFee), refundAcc.String(), nil))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAcc = suite.chainA.SenderAccounts[0].SenderAccount.GetAddress()
+			timeoutRelayer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+			packetID = suite.sendPacket()
+			packetFee := types.NewPacketFee(fee, refundAcc.String(), nil)
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+			suite.Require().NoError(err)
+
+			packetFees = []types.PacketFee{packetFee}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			refundBalance := bankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom)
+			timeoutBalance := bankKeeper.GetBalance(ctx, timeoutRelayer, sdk.DefaultBondDenom)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(ctx, timeoutRelayer, packetFees, packetID)
+
+			if tc.expLocked {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(ctx))
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+				suite.Require().Equal(refundBalance, bankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom))
+				return
+			}
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+
+			if bankKeeper.BlockedAddr(timeoutRelayer) {
+				suite.Require().Equal(refundBalance.Add(fee.Total()[0]), bankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().Equal(timeoutBalance.Add(defaultTimeoutFee[0]), bankKeeper.GetBalance(ctx, timeoutRelayer, sdk.DefaultBondDenom))
+				suite.Require().Equal(refundBalance.Add(defaultRecvFee[0]).Add(defaultAckFee[0]), bankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
+	var (
+		packetIDs     []channeltypes.PacketId
+		otherPacketID channeltypes.PacketId
+		refundAcc     sdk.AccAddress
+		expLocked     bool
+		expRefund     sdk.Coins
+		numPackets    = 3
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success",
+			func() {},
+		},
+		{
+			"fees in escrow on a different channel are not refunded",
+			func() {
+				otherPacketID = channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, "channel-100", 1)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), otherPacketID, types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}))
+			},
+		},
+		{
+			"escrow account has insufficient balance, fee module is locked",
+			func() {
+				excessiveFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
+				packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(types.NewFee(excessiveFee, nil, nil), refundAcc.String(), nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetIDs[0], packetFees)
+
+				expLocked = true
+				expRefund = sdk.NewCoins()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			expLocked = false
+			expRefund = sdk.NewCoins()
+			packetIDs = []channeltypes.PacketId{}
+			otherPacketID = channeltypes.PacketId{}
+
+			for i := 0; i < numPackets; i++ {
+				packetID := suite.sendPacket()
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, refundAcc.String(), nil)))
+				suite.Require().NoError(err)
+
+				packetIDs = append(packetIDs, packetID)
+				expRefund = expRefund.Add(fee.Total()...)
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			refundBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom)
+
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundFeesOnChannelClosure(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(expLocked, suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(ctx))
+			suite.Require().Equal(refundBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, expRefund.AmountOf(sdk.DefaultBondDenom))), suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAcc, sdk.DefaultBondDenom))
+
+			for _, packetID := range packetIDs {
+				suite.Require().Equal(expLocked, suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+			}
+
+			// fees on other channels are untouched
+			if otherPacketID.ChannelId != "" {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(ctx, otherPacketID))
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	relayer := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	counterpartyPayee := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	genesisState := types.NewGenesisState(
+		[]types.IdentifiedPacketFees{
+			types.NewIdentifiedPacketFees(packetID, []types.PacketFee{types.NewPacketFee(fee, relayer, nil)}),
+		},
+		[]types.FeeEnabledChannel{types.NewFeeEnabledChannel(ibctesting.MockFeePort, ibctesting.FirstChannelID)},
+		[]types.RegisteredPayee{types.NewRegisteredPayee(relayer, payee, ibctesting.FirstChannelID)},
+		[]types.RegisteredCounterpartyPayee{types.NewRegisteredCounterpartyPayee(relayer, counterpartyPayee, ibctesting.FirstChannelID)},
+		[]types.ForwardRelayerAddress{types.NewForwardRelayerAddress(relayer, packetID)},
+	)
+
+	// initialize the genesis state on a chain without any fee enabled channels
+	ctx := suite.chainB.GetContext()
+	feeKeeper := suite.chainB.GetSimApp().IBCFeeKeeper
+	feeKeeper.DeleteFeeEnabled(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+
+	feeKeeper.InitGenesis(ctx, *genesisState)
+
+	suite.Require().True(feeKeeper.IsFeeEnabled(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID))
+
+	feesInEscrow, found := feeKeeper.GetFeesInEscrow(ctx, packetID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.IdentifiedFees[0].PacketFees, feesInEscrow.PacketFees)
+
+	registeredPayee, found := feeKeeper.GetPayeeAddress(ctx, relayer, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(payee, registeredPayee)
+
+	registeredCounterpartyPayee, found := feeKeeper.GetCounterpartyPayeeAddress(ctx, relayer, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(counterpartyPayee, registeredCounterpartyPayee)
+
+	forwardRelayer, found := feeKeeper.GetRelayerAddressForAsyncAck(ctx, packetID)
+	suite.Require().True(found)
+	suite.Require().Equal(relayer, forwardRelayer)
+
+	suite.Require().Equal(genesisState, feeKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,369 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPackets() {
+	var (
+		req             *types.QueryIncentivizedPacketsRequest
+		expectedPackets []types.IdentifiedPacketFees
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				fee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+				for i := 0; i < 3; i++ {
+					packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, uint64(i+1))
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{fee}))
+
+					expectedPackets = append(expectedPackets, types.NewIdentifiedPacketFees(packetID, []types.PacketFee{fee}))
+				}
+
+				req = &types.QueryIncentivizedPacketsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryIncentivizedPacketsRequest{}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expectedPackets = nil
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPackets(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expectedPackets, res.IncentivizedPackets)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacket() {
+	var req *types.QueryIncentivizedPacketRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"fees not found for packet id",
+			func() {
+				req = &types.QueryIncentivizedPacketRequest{
+					PacketId: channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 100),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+			fee := types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), suite.chainA.SenderAccount.GetAddress().String(), nil)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{fee, fee}))
+
+			req = &types.QueryIncentivizedPacketRequest{
+				PacketId: packetID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPacket(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(types.NewIdentifiedPacketFees(packetID, []types.PacketFee{fee, fee}), res.IncentivizedPacket)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForChannel() {
+	var (
+		req                     *types.QueryIncentivizedPacketsForChannelRequest
+		expIdentifiedPacketFees []types.IdentifiedPacketFees
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryIncentivizedPacketsForChannelRequest{
+					PortId:    ibctesting.MockFeePort,
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				req = &types.QueryIncentivizedPacketsForChannelRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+					PortId:    ibctesting.MockFeePort,
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"packets on other channels are not returned",
+			func() {
+				// a channel identifier which shares a prefix with the queried channel
+				packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID+"0", 1)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+				req = &types.QueryIncentivizedPacketsForChannelRequest{
+					PortId:    ibctesting.MockFeePort,
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req = &types.QueryIncentivizedPacketsForChannelRequest{
+					PortId:    ibctesting.MockFeePort,
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			packetFees := types.NewPacketFees([]types.PacketFee{packetFee, packetFee, packetFee})
+
+			expIdentifiedPacketFees = nil
+			for i := 1; i <= 3; i++ {
+				packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, uint64(i))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, packetFees)
+
+				expIdentifiedPacketFees = append(expIdentifiedPacketFees, types.NewIdentifiedPacketFees(packetID, packetFees.PacketFees))
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPacketsForChannel(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expIdentifiedPacketFees, res.IncentivizedPackets)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTotalFees() {
+	var packetID channeltypes.PacketId
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"packet not found",
+			func() {
+				packetID.Sequence = 100
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetID = channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee, packetFee}))
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			recvRes, recvErr := suite.chainA.GetSimApp().IBCFeeKeeper.TotalRecvFees(ctx, &types.QueryTotalRecvFeesRequest{PacketId: packetID})
+			ackRes, ackErr := suite.chainA.GetSimApp().IBCFeeKeeper.TotalAckFees(ctx, &types.QueryTotalAckFeesRequest{PacketId: packetID})
+			timeoutRes, timeoutErr := suite.chainA.GetSimApp().IBCFeeKeeper.TotalTimeoutFees(ctx, &types.QueryTotalTimeoutFeesRequest{PacketId: packetID})
+
+			if tc.expPass {
+				suite.Require().NoError(recvErr)
+				suite.Require().NoError(ackErr)
+				suite.Require().NoError(timeoutErr)
+
+				suite.Require().Equal(defaultRecvFee.Add(defaultRecvFee...), recvRes.RecvFees)
+				suite.Require().Equal(defaultAckFee.Add(defaultAckFee...), ackRes.AckFees)
+				suite.Require().Equal(defaultTimeoutFee.Add(defaultTimeoutFee...), timeoutRes.TimeoutFees)
+			} else {
+				suite.Require().Error(recvErr)
+				suite.Require().Error(ackErr)
+				suite.Require().Error(timeoutErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPayees() {
+	var (
+		channelID string
+		relayer   string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"payee not found for relayer",
+			func() {
+				relayer = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"payee not found for channel",
+			func() {
+				channelID = "channel-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer = suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+			payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			counterpartyPayee := suite.chainB.SenderAccount.GetAddress().String()
+			channelID = suite.path.EndpointA.ChannelID
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), relayer, payee, channelID)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainA.GetContext(), relayer, counterpartyPayee, channelID)
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			payeeRes, payeeErr := suite.chainA.GetSimApp().IBCFeeKeeper.Payee(ctx, &types.QueryPayeeRequest{ChannelId: channelID, Relayer: relayer})
+			counterpartyRes, counterpartyErr := suite.chainA.GetSimApp().IBCFeeKeeper.CounterpartyPayee(ctx, &types.QueryCounterpartyPayeeRequest{ChannelId: channelID, Relayer: relayer})
+
+			if tc.expPass {
+				suite.Require().NoError(payeeErr)
+				suite.Require().NoError(counterpartyErr)
+				suite.Require().Equal(payee, payeeRes.PayeeAddress)
+				suite.Require().Equal(counterpartyPayee, counterpartyRes.CounterpartyPayee)
+			} else {
+				suite.Require().Error(payeeErr)
+				suite.Require().Error(counterpartyErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeEnabledChannels() {
+	ctx := suite.chainA.GetContext()
+
+	res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeEnabledChannels(sdk.WrapSDKContext(ctx), &types.QueryFeeEnabledChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeEnabledChannel{types.NewFeeEnabledChannel(ibctesting.MockFeePort, suite.path.EndpointA.ChannelID)}, res.FeeEnabledChannels)
+
+	enabledRes, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeEnabledChannel(sdk.WrapSDKContext(ctx), &types.QueryFeeEnabledChannelRequest{PortId: ibctesting.MockFeePort, ChannelId: suite.path.EndpointA.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().True(enabledRes.FeeEnabled)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(ctx, ibctesting.MockFeePort, suite.path.EndpointA.ChannelID)
+
+	res, err = suite.chainA.GetSimApp().IBCFeeKeeper.FeeEnabledChannels(sdk.WrapSDKContext(ctx), &types.QueryFeeEnabledChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.FeeEnabledChannels)
+
+	enabledRes, err = suite.chainA.GetSimApp().IBCFeeKeeper.FeeEnabledChannel(sdk.WrapSDKContext(ctx), &types.QueryFeeEnabledChannelRequest{PortId: ibctesting.MockFeePort, ChannelId: suite.path.EndpointA.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().False(enabledRes.FeeEnabled)
+}
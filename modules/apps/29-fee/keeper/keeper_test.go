@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(300)}}
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.MockFeePort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.MockFeePort
+	path.EndpointA.ChannelConfig.Version = ibctesting.MockFeeVersion
+	path.EndpointB.ChannelConfig.Version = ibctesting.MockFeeVersion
+
+	suite.path = path
+	suite.coordinator.Setup(suite.path)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().IBCFeeKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// sendPacket sends a mock packet over the test path and returns the identifier of the sent packet.
+func (suite *KeeperTestSuite) sendPacket() channeltypes.PacketId {
+	endpoint := suite.path.EndpointA
+
+	sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	suite.Require().True(found)
+
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, sequence,
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID,
+		suite.chainB.GetTimeoutHeight(), 0,
+	)
+	suite.Require().NoError(endpoint.SendPacket(packet))
+
+	return channeltypes.NewPacketId(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+}
+
+func (suite *KeeperTestSuite) TestFeeEnabled() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	suite.Require().True(feeKeeper.IsFeeEnabled(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+	suite.Require().False(feeKeeper.IsFeeEnabled(ctx, ibctesting.MockPort, suite.path.EndpointA.ChannelID))
+
+	feeKeeper.SetFeeEnabled(ctx, ibctesting.MockPort, "channel-7")
+	suite.Require().True(feeKeeper.IsFeeEnabled(ctx, ibctesting.MockPort, "channel-7"))
+
+	expChannels := []types.FeeEnabledChannel{
+		types.NewFeeEnabledChannel(ibctesting.MockPort, "channel-7"),
+		types.NewFeeEnabledChannel(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID),
+	}
+	suite.Require().Equal(expChannels, feeKeeper.GetAllFeeEnabledChannels(ctx))
+
+	feeKeeper.DeleteFeeEnabled(ctx, ibctesting.MockPort, "channel-7")
+	suite.Require().False(feeKeeper.IsFeeEnabled(ctx, ibctesting.MockPort, "channel-7"))
+}
+
+func (suite *KeeperTestSuite) TestGetAllIdentifiedPacketFees() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+	refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	var expIdentifiedPacketFees []types.IdentifiedPacketFees
+	for seq := uint64(1); seq <= 3; seq++ {
+		packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, seq)
+		packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAddr, nil)}
+
+		feeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(packetFees))
+		expIdentifiedPacketFees = append(expIdentifiedPacketFees, types.NewIdentifiedPacketFees(packetID, packetFees))
+	}
+
+	// fees escrowed on a channel with a shared identifier prefix must not be returned for the channel
+	otherPacketID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID+"0", 1)
+	otherPacketFees := []types.PacketFee{types.NewPacketFee(fee, refundAddr, nil)}
+	feeKeeper.SetFeesInEscrow(ctx, otherPacketID, types.NewPacketFees(otherPacketFees))
+
+	identifiedFees := feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().Equal(expIdentifiedPacketFees, identifiedFees)
+
+	allFees := feeKeeper.GetAllIdentifiedPacketFees(ctx)
+	suite.Require().Len(allFees, 4)
+	suite.Require().Contains(allFees, types.NewIdentifiedPacketFees(otherPacketID, otherPacketFees))
+}
+
+func (suite *KeeperTestSuite) TestGetAllPayees() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	var expectedPayees []types.RegisteredPayee
+	for i := 0; i < 3; i++ {
+		relayer := suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String()
+		payee := suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String()
+
+		feeKeeper.SetPayeeAddress(ctx, relayer, payee, ibctesting.FirstChannelID)
+		expectedPayees = append(expectedPayees, types.NewRegisteredPayee(relayer, payee, ibctesting.FirstChannelID))
+	}
+
+	suite.Require().ElementsMatch(expectedPayees, feeKeeper.GetAllPayees(ctx))
+
+	payee, found := feeKeeper.GetPayeeAddress(ctx, expectedPayees[0].Relayer, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(expectedPayees[0].Payee, payee)
+
+	_, found = feeKeeper.GetPayeeAddress(ctx, expectedPayees[0].Relayer, "channel-7")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetAllCounterpartyPayees() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	var expectedCounterpartyPayees []types.RegisteredCounterpartyPayee
+	for i := 0; i < 3; i++ {
+		relayer := suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String()
+		counterpartyPayee := suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String()
+
+		feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer, counterpartyPayee, ibctesting.FirstChannelID)
+		expectedCounterpartyPayees = append(expectedCounterpartyPayees, types.NewRegisteredCounterpartyPayee(relayer, counterpartyPayee, ibctesting.FirstChannelID))
+	}
+
+	suite.Require().ElementsMatch(expectedCounterpartyPayees, feeKeeper.GetAllCounterpartyPayees(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetAllForwardRelayerAddresses() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+
+	var expectedForwardRelayers []types.ForwardRelayerAddress
+	for seq := uint64(1); seq <= 3; seq++ {
+		packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, seq)
+
+		feeKeeper.SetRelayerAddressForAsyncAck(ctx, packetID, relayer)
+		expectedForwardRelayers = append(expectedForwardRelayers, types.NewForwardRelayerAddress(relayer, packetID))
+	}
+
+	suite.Require().Equal(expectedForwardRelayers, feeKeeper.GetAllForwardRelayerAddresses(ctx))
+
+	feeKeeper.DeleteForwardRelayerAddress(ctx, expectedForwardRelayers[0].PacketId)
+	_, found := feeKeeper.GetRelayerAddressForAsyncAck(ctx, expectedForwardRelayers[0].PacketId)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetAppVersion() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	appVersion, found := feeKeeper.GetAppVersion(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(ibctesting.DefaultChannelVersion, appVersion)
+
+	_, found = feeKeeper.GetAppVersion(ctx, suite.path.EndpointA.ChannelConfig.PortID, "channel-7")
+	suite.Require().False(found)
+}
@@ -0,0 +1,341 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestRegisterPayee() {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			false,
+		},
+		{
+			"payee is a blocked address",
+			func() {
+				msg.Payee = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgRegisterPayee(
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterPayee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), msg.Relayer, msg.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Payee, payeeAddr)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
+	var msg *types.MsgRegisterCounterpartyPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"counterparty payee is an arbitrary string",
+			func() {
+				msg.CounterpartyPayee = "arbitrary-string"
+			},
+			true,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgRegisterCounterpartyPayee(
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterCounterpartyPayee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				counterpartyPayee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), msg.Relayer, msg.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.CounterpartyPayee, counterpartyPayee)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFee() {
+	var (
+		msg          *types.MsgPayPacketFee
+		expEscrowFee types.PacketFees
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: fees are appended to existing fees in escrow",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+
+				packetFee := types.NewPacketFee(fee, msg.Signer, nil)
+				expEscrowFee = types.NewPacketFees([]types.PacketFee{packetFee, packetFee})
+			},
+			true,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+		{
+			"fee not enabled on this channel",
+			func() {
+				msg.SourceChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"refund account is a blocked address",
+			func() {
+				msg.Signer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+			},
+			false,
+		},
+		{
+			"refund account does not exist",
+			func() {
+				msg.Signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"insufficient funds",
+			func() {
+				msg.Fee = types.NewFee(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntWithDecimal(1, 30))), nil, nil)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+			msg = types.NewMsgPayPacketFee(fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, refundAddr, nil)
+			expEscrowFee = types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAddr, nil)})
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(sdk.WrapSDKContext(ctx), msg)
+
+			packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(expEscrowFee, feesInEscrow)
+
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewInt(int64(len(expEscrowFee.PacketFees))).Mul(fee.Total().AmountOf(sdk.DefaultBondDenom)), escrowBalance.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
+	var (
+		packetID channeltypes.PacketId
+		msg      *types.MsgPayPacketFeeAsync
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"fee not enabled on this channel",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId)
+			},
+			types.ErrFeeNotEnabled,
+		},
+		{
+			"packet has not been sent",
+			func() {
+				msg.PacketId.Sequence = packetID.Sequence + 1
+			},
+			channeltypes.ErrPacketNotSent,
+		},
+		{
+			"packet has already been acknowledged",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey))
+				store.Delete(host.PacketCommitmentKey(packetID.PortId, packetID.ChannelId, packetID.Sequence))
+			},
+			channeltypes.ErrPacketCommitmentNotFound,
+		},
+		{
+			"refund account does not exist",
+			func() {
+				msg.PacketFee.RefundAddress = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			types.ErrRefundAccNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			packetID = suite.sendPacket()
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			msg = types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPacketFees([]types.PacketFee{packetFee}), feesInEscrow)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// lockFeeModule locks the fee module of the provided chain by attempting to distribute a fee
+// which exceeds the balance of the escrow account.
+func lockFeeModule(chain *ibctesting.TestChain) {
+	ctx := chain.GetContext()
+	refundAddr := chain.SenderAccount.GetAddress()
+
+	excessiveFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))
+	packetFee := types.NewPacketFee(types.NewFee(excessiveFee, excessiveFee, excessiveFee), refundAddr.String(), nil)
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 100)
+
+	chain.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(ctx, refundAddr, []types.PacketFee{packetFee}, packetID)
+}
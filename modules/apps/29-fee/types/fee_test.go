@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(300)}}
+	invalidFee        = sdk.Coins{sdk.Coin{Denom: "invalid-denom", Amount: sdk.NewInt(-2)}}
+
+	defaultAccAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+)
+
+func TestFeeTotal(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	total := fee.Total()
+	require.Equal(t, sdk.NewInt(600), total.AmountOf(sdk.DefaultBondDenom))
+}
+
+func TestPacketFeeValidation(t *testing.T) {
+	var packetFee types.PacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: only receive fee is set",
+			func() {
+				packetFee.Fee.AckFee = sdk.Coins{}
+				packetFee.Fee.TimeoutFee = sdk.Coins{}
+			},
+			true,
+		},
+		{
+			"should fail when refund address is invalid",
+			func() {
+				packetFee.RefundAddress = "invalid-address"
+			},
+			false,
+		},
+		{
+			"should fail when all fees are zero",
+			func() {
+				packetFee.Fee.RecvFee = sdk.Coins{}
+				packetFee.Fee.AckFee = sdk.Coins{}
+				packetFee.Fee.TimeoutFee = sdk.Coins{}
+			},
+			false,
+		},
+		{
+			"should fail with invalid recv fee",
+			func() {
+				packetFee.Fee.RecvFee = invalidFee
+			},
+			false,
+		},
+		{
+			"should fail with invalid ack fee",
+			func() {
+				packetFee.Fee.AckFee = invalidFee
+			},
+			false,
+		},
+		{
+			"should fail with invalid timeout fee",
+			func() {
+				packetFee.Fee.TimeoutFee = invalidFee
+			},
+			false,
+		},
+		{
+			"should fail when relayers are specified",
+			func() {
+				packetFee.Relayers = []string{defaultAccAddress}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		packetFee = types.NewPacketFee(fee, defaultAccAddress, nil)
+
+		tc.malleate() // malleate mutates test data
+
+		err := packetFee.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestIncentivizedAcknowledgement(t *testing.T) {
+	ack := types.NewIncentivizedAcknowledgement(defaultAccAddress, []byte("app acknowledgement"), true)
+	require.True(t, ack.Success())
+
+	var decoded types.IncentivizedAcknowledgement
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &decoded))
+	require.Equal(t, ack, decoded)
+
+	failedAck := types.NewIncentivizedAcknowledgement("", []byte("app acknowledgement"), false)
+	require.False(t, failedAck.Success())
+}
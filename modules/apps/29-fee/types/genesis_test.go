@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestValidateDefaultGenesis(t *testing.T) {
+	err := types.DefaultGenesisState().Validate()
+	require.NoError(t, err)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid genesis",
+			func() {},
+			true,
+		},
+		{
+			"invalid packetID: invalid port ID",
+			func() {
+				genState.IdentifiedFees[0].PacketId = channeltypes.NewPacketId("", ibctesting.FirstChannelID, 1)
+			},
+			false,
+		},
+		{
+			"invalid packetID: invalid sequence",
+			func() {
+				genState.IdentifiedFees[0].PacketId = channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 0)
+			},
+			false,
+		},
+		{
+			"invalid packet fee: invalid refund address",
+			func() {
+				genState.IdentifiedFees[0].PacketFees[0].RefundAddress = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid fee enabled channel: invalid port ID",
+			func() {
+				genState.FeeEnabledChannels[0].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid fee enabled channel: invalid channel ID",
+			func() {
+				genState.FeeEnabledChannels[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid registered payee: relayer equals payee",
+			func() {
+				genState.RegisteredPayees[0].Payee = genState.RegisteredPayees[0].Relayer
+			},
+			false,
+		},
+		{
+			"invalid registered payee: invalid payee address",
+			func() {
+				genState.RegisteredPayees[0].Payee = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid registered counterparty payee: empty counterparty payee",
+			func() {
+				genState.RegisteredCounterpartyPayees[0].CounterpartyPayee = " "
+			},
+			false,
+		},
+		{
+			"invalid registered counterparty payee: invalid channel ID",
+			func() {
+				genState.RegisteredCounterpartyPayees[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid forward relayer address",
+			func() {
+				genState.ForwardRelayers[0].Address = "invalid-address"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		payee := ibctesting.TestAccAddress
+
+		genState = types.NewGenesisState(
+			[]types.IdentifiedPacketFees{
+				types.NewIdentifiedPacketFees(validPacketID, []types.PacketFee{types.NewPacketFee(fee, defaultAccAddress, nil)}),
+			},
+			[]types.FeeEnabledChannel{types.NewFeeEnabledChannel(ibctesting.MockFeePort, ibctesting.FirstChannelID)},
+			[]types.RegisteredPayee{types.NewRegisteredPayee(defaultAccAddress, payee, ibctesting.FirstChannelID)},
+			[]types.RegisteredCounterpartyPayee{types.NewRegisteredCounterpartyPayee(defaultAccAddress, payee, ibctesting.FirstChannelID)},
+			[]types.ForwardRelayerAddress{types.NewForwardRelayerAddress(defaultAccAddress, validPacketID)},
+		)
+
+		tc.malleate()
+
+		err := genState.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
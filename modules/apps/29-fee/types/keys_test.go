@@ -0,0 +1,170 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var validPacketID = channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+
+func TestKeyPayee(t *testing.T) {
+	key := types.KeyPayee("relayer-address", ibctesting.FirstChannelID)
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s", types.PayeeKeyPrefix, "relayer-address", ibctesting.FirstChannelID))
+}
+
+func TestParseKeyFeeEnabled(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyFeeEnabled(ibctesting.MockPort, ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key prefix",
+			fmt.Sprintf("%s/%s/%s", "incorrect-prefix", ibctesting.MockPort, ibctesting.FirstChannelID),
+			false,
+		},
+		{
+			"too many key segments",
+			fmt.Sprintf("%s/%s/%s/%s", types.FeeEnabledKeyPrefix, ibctesting.MockPort, ibctesting.FirstChannelID, "extra"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		portID, channelID, err := types.ParseKeyFeeEnabled(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, ibctesting.MockPort, portID)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Empty(t, portID)
+			require.Empty(t, channelID)
+		}
+	}
+}
+
+func TestParseKeyPayee(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyPayee("relayer-address", ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key: key split has incorrect length",
+			fmt.Sprintf("%s/%s/%s/%s", types.PayeeKeyPrefix, "relayer-address", ibctesting.FirstChannelID, "extra"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		address, channelID, err := types.ParseKeyPayeeAddress(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, "relayer-address", address)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParseKeyCounterpartyPayee(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyCounterpartyPayee("relayer-address", ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key: key split has incorrect length",
+			fmt.Sprintf("%s/%s", types.CounterpartyPayeeKeyPrefix, "relayer-address"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		address, channelID, err := types.ParseKeyCounterpartyPayee(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, "relayer-address", address)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParseKeyPacketID(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		parseFn func(string) (channeltypes.PacketId, error)
+		expPass bool
+	}{
+		{
+			"success: fees in escrow",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			types.ParseKeyFeesInEscrow,
+			true,
+		},
+		{
+			"success: forward relayer address",
+			string(types.KeyRelayerAddressForAsyncAck(validPacketID)),
+			types.ParseKeyRelayerAddressForAsyncAck,
+			true,
+		},
+		{
+			"fees in escrow: key split has incorrect length",
+			string(types.KeyFeesInEscrowChannelPrefix(validPacketID.PortId, validPacketID.ChannelId)),
+			types.ParseKeyFeesInEscrow,
+			false,
+		},
+		{
+			"fees in escrow: sequence is not a number",
+			fmt.Sprintf("%s/%s", types.KeyFeesInEscrowChannelPrefix(validPacketID.PortId, validPacketID.ChannelId), "sequence"),
+			types.ParseKeyFeesInEscrow,
+			false,
+		},
+		{
+			"forward relayer address: key split has incorrect length",
+			fmt.Sprintf("%s/%s", types.ForwardRelayerPrefix, validPacketID.PortId),
+			types.ParseKeyRelayerAddressForAsyncAck,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		packetID, err := tc.parseFn(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, validPacketID, packetID)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestMsgRegisterPayeeValidation(t *testing.T) {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"validate with incorrect destination relayer address",
+			func() {
+				msg.Relayer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid payee address",
+			func() {
+				msg.Payee = "invalid-address"
+			},
+			false,
+		},
+		{
+			"payee address is equal to relayer address",
+			func() {
+				msg.Payee = msg.Relayer
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		relayerAddr := sdk.AccAddress("relayer")
+		payeeAddr := sdk.AccAddress("payee")
+
+		msg = types.NewMsgRegisterPayee(ibctesting.MockPort, ibctesting.FirstChannelID, relayerAddr.String(), payeeAddr.String())
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRegisterPayeeGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress("address")
+	msg := types.NewMsgRegisterPayee(ibctesting.MockPort, ibctesting.FirstChannelID, accAddress.String(), defaultAccAddress)
+	require.Equal(t, []sdk.AccAddress{accAddress}, msg.GetSigners())
+}
+
+func TestMsgRegisterCounterpartyPayeeValidation(t *testing.T) {
+	var msg *types.MsgRegisterCounterpartyPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"validate with incorrect destination relayer address",
+			func() {
+				msg.Relayer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid counterparty payee address",
+			func() {
+				msg.CounterpartyPayee = ""
+			},
+			false,
+		},
+		{
+			"invalid counterparty payee address: whitespaced empty string",
+			func() {
+				msg.CounterpartyPayee = "  "
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterCounterpartyPayee(ibctesting.MockPort, ibctesting.FirstChannelID, defaultAccAddress, defaultAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeValidation(t *testing.T) {
+	var msg *types.MsgPayPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.SourceChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.SourcePortId = ""
+			},
+			false,
+		},
+		{
+			"relayers is not nil",
+			func() {
+				msg.Relayers = []string{defaultAccAddress}
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid fee",
+			func() {
+				msg.Fee.RecvFee = invalidFee
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		msg = types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, nil)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestPayPacketFeeGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress("refundAddr")
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msg := types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, refundAddr.String(), nil)
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgPayPacketFee, msg.Type())
+	require.NotEmpty(t, msg.GetSignBytes())
+}
+
+func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
+	var msg *types.MsgPayPacketFeeAsync
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid packetID",
+			func() {
+				msg.PacketId = channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 0)
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.PacketFee.RefundAddress = "invalid-address"
+			},
+			false,
+		},
+		{
+			"relayers is not nil",
+			func() {
+				msg.PacketFee.Relayers = []string{defaultAccAddress}
+			},
+			false,
+		},
+		{
+			"all fees are zero",
+			func() {
+				msg.PacketFee.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		packetFee := types.NewPacketFee(fee, defaultAccAddress, nil)
+		msg = types.NewMsgPayPacketFeeAsync(validPacketID, packetFee)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress("refundAddr")
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
+	msg := types.NewMsgPayPacketFeeAsync(validPacketID, packetFee)
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgPayPacketFeeAsync, msg.Type())
+	require.NotEmpty(t, msg.GetSignBytes())
+}
@@ -0,0 +1,337 @@
+package packetforward
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward middleware given the
+// packet forward keeper and the underlying transfer application.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnChanUpgradeInit implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	return im.app.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+}
+
+// OnChanUpgradeTry implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the IBCMiddleware interface
+func (im IBCMiddleware) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) {
+	im.app.OnChanUpgradeOpen(ctx, portID, channelID, order, connectionHops, version)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface. If the ICS-20 memo of the received packet
+// contains forwarding instructions, the tokens are received by an intermediate account derived
+// from the destination channel and the original sender and are then sent to the next hop. The
+// acknowledgement of the received packet is written asynchronously once the forwarded packet is
+// acknowledged or times out. Packets without forwarding instructions are passed to the underlying
+// application untouched.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, isForward, err := types.ParseForwardMetadata(data.Memo)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	if !isForward {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	// override the receiver so that the tokens are received by an intermediate account which
+	// cannot be controlled by any user, the memo is cleared as it has been fully processed
+	receiver := types.GetReceiver(packet.GetDestChannel(), data.Sender)
+
+	overrideData := data
+	overrideData.Receiver = receiver.String()
+	overrideData.Memo = ""
+
+	overridePacket := packet
+	overridePacket.Data = overrideData.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, overridePacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	token, err := receivedToken(packet, data)
+	if err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := im.keeper.ForwardTransferPacket(
+		ctx, packet, receiver, token,
+		metadata.Port, metadata.Channel, metadata.Receiver, memo,
+		metadata.GetTimeout(), uint32(metadata.GetRetries()),
+	); err != nil {
+		return transfertypes.NewErrorAcknowledgement(err)
+	}
+
+	// NOTE: the acknowledgement is written asynchronously once the forwarded packet completes
+	return nil
+}
+
+// WrapRecvPacket implements the RecvPacketWrapper interface, forwarding to the underlying application if it wraps
+// the receipt of packets.
+func (im IBCMiddleware) WrapRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	recvPacket func(ctx sdk.Context) ibcexported.Acknowledgement,
+) ibcexported.Acknowledgement {
+	if wrapper, ok := im.app.(porttypes.RecvPacketWrapper); ok {
+		return wrapper.WrapRecvPacket(ctx, packet, relayer, recvPacket)
+	}
+
+	return recvPacket(ctx)
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface. If the acknowledged packet was
+// forwarded by this middleware, the acknowledgement is propagated back to the original packet.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	im.keeper.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	// NOTE: the underlying application callback is skipped so that tokens of failed forwarded
+	// packets are not refunded to the intermediate account
+	return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack)
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface. If the timed out packet was forwarded
+// by this middleware, it is resent if retries remain. Otherwise an error acknowledgement is
+// written for the original packet.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	inFlightPacket, found := im.keeper.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	im.keeper.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if inFlightPacket.RetriesRemaining > 0 {
+		// refund the intermediate account and resend the packet, state changes are discarded
+		// if the packet cannot be resent and the original packet is acknowledged with an error
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := im.app.OnTimeoutPacket(cacheCtx, packet, relayer)
+		if err == nil {
+			err = im.keeper.RetryTimeout(cacheCtx, packet, data, inFlightPacket)
+		}
+
+		if err == nil {
+			writeFn()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			return nil
+		}
+
+		im.keeper.Logger(ctx).Error("failed to retry forwarded packet", "error", err.Error())
+	}
+
+	ack := transfertypes.NewErrorAcknowledgement(
+		sdkerrors.Wrapf(types.ErrForwardTimeout, "port ID (%s) channel ID (%s) sequence (%d)", packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()),
+	)
+
+	return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	return im.keeper.GetICS4Wrapper().SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.keeper.GetICS4Wrapper().WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// receivedToken returns the token received on this chain for the provided packet, following the
+// denomination handling of the transfer application. The packet data is expected to have been
+// validated by the transfer application.
+func receivedToken(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, error) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	var denomTrace transfertypes.DenomTrace
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denomTrace = transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):])
+	} else {
+		sourcePrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+		denomTrace = transfertypes.ParseDenomTrace(sourcePrefix + data.Denom)
+	}
+
+	return sdk.NewCoin(denomTrace.IBCDenom(), amount), nil
+}
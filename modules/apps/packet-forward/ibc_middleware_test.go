@@ -0,0 +1,463 @@
+package packetforward_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// forwardTimeout is the relative timeout used by tests timing out forwarded packets
+const forwardTimeout = 30 * time.Second
+
+var (
+	amount        = sdk.NewInt(100)
+	timeoutHeight = clienttypes.NewHeight(0, 110)
+	successAck    = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+)
+
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	// NOTE: EndpointA of each path is the endpoint on the first chain of the path name
+	pathAB *ibctesting.Path
+	pathBC *ibctesting.Path
+}
+
+func (suite *PacketForwardTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAB = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.pathAB)
+
+	suite.pathBC = NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathBC)
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+// forwardMemo returns an ICS-20 memo forwarding the received tokens over the provided endpoint.
+func forwardMemo(endpoint *ibctesting.Endpoint, receiver, extra string) string {
+	return fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"%s}}`,
+		receiver, endpoint.ChannelConfig.PortID, endpoint.ChannelID, extra,
+	)
+}
+
+// transfer sends the provided coin over the endpoint and returns the sent packet.
+func (suite *PacketForwardTestSuite) transfer(endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver, memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		endpoint.Chain.SenderAccount.GetAddress().String(), receiver, timeoutHeight, 0,
+	)
+	msg.Memo = memo
+
+	res, err := endpoint.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// recvPacket receives the packet on the provided endpoint and returns the result.
+func (suite *PacketForwardTestSuite) recvPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *sdk.Result {
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	res, err := endpoint.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	return res
+}
+
+// acknowledgePacket acknowledges the packet on the provided endpoint.
+func (suite *PacketForwardTestSuite) acknowledgePacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) {
+	suite.Require().NoError(endpoint.UpdateClient())
+	suite.Require().NoError(endpoint.AcknowledgePacket(packet, ack))
+}
+
+// timeoutPacket times out the packet on the provided endpoint and returns the result.
+func (suite *PacketForwardTestSuite) timeoutPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *sdk.Result {
+	// advance the counterparty past the packet timeout
+	suite.coordinator.IncrementTimeBy(forwardTimeout)
+	suite.coordinator.CommitBlock(endpoint.Counterparty.Chain)
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+
+	msg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	res, err := endpoint.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	return res
+}
+
+// requireAck asserts that the provided acknowledgement has been written for the packet on the chain.
+func (suite *PacketForwardTestSuite) requireAck(chain *ibctesting.TestChain, packet channeltypes.Packet, ack []byte) {
+	commitment, found := chain.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack), commitment)
+}
+
+// requireNoAck asserts that no acknowledgement has been written for the packet on the chain.
+func (suite *PacketForwardTestSuite) requireNoAck(chain *ibctesting.TestChain, packet channeltypes.Packet) {
+	_, found := chain.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+// requireTotalEscrow asserts that the total amount in escrow tracked by the transfer keeper matches
+// the balances of the escrow accounts on each chain.
+func (suite *PacketForwardTestSuite) requireTotalEscrow() {
+	for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB, suite.chainC} {
+		transferKeeper := chain.GetSimApp().TransferKeeper
+		suite.Require().Equal(transferKeeper.GetAllEscrowBalances(chain.GetContext()), transferKeeper.GetAllTotalEscrowed(chain.GetContext()))
+
+		msg, broken := transferkeeper.TotalEscrowPerDenomInvariants(&transferKeeper)(chain.GetContext())
+		suite.Require().False(broken, msg)
+	}
+}
+
+func (suite *PacketForwardTestSuite) balance(chain *ibctesting.TestChain, addr sdk.AccAddress, denom string) sdk.Int {
+	return chain.GetSimApp().BankKeeper.GetBalance(chain.GetContext(), addr, denom).Amount
+}
+
+func (suite *PacketForwardTestSuite) voucherDenom(denomPath string) string {
+	return transfertypes.ParseDenomTrace(denomPath).IBCDenom()
+}
+
+// TestForwardPacket forwards tokens from chainA through chainB to chainC.
+func (suite *PacketForwardTestSuite) TestForwardPacket() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainC.SenderAccount.GetAddress()
+	originalBalance := suite.balance(suite.chainA, sender, sdk.DefaultBondDenom)
+
+	memo := forwardMemo(suite.pathBC.EndpointA, receiver.String(), "")
+	packet := suite.transfer(suite.pathAB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), memo)
+
+	res := suite.recvPacket(suite.pathAB.EndpointB, packet)
+
+	// the acknowledgement is written asynchronously
+	suite.requireNoAck(suite.chainB, packet)
+
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var forwardData transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(forwardPacket.GetData(), &forwardData))
+	suite.Require().Equal(types.GetReceiver(packet.GetDestChannel(), sender.String()).String(), forwardData.Sender)
+	suite.Require().Equal(receiver.String(), forwardData.Receiver)
+	suite.Require().Empty(forwardData.Memo)
+
+	pfmKeeper := suite.chainB.GetSimApp().PacketForwardKeeper
+	inFlightPacket, found := pfmKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, inFlightPacket.OriginalPacket)
+
+	suite.Require().NoError(suite.pathBC.RelayPacket(forwardPacket))
+
+	_, found = pfmKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().False(found)
+	suite.requireAck(suite.chainB, packet, successAck.Acknowledgement())
+
+	suite.acknowledgePacket(suite.pathAB.EndpointA, packet, successAck.Acknowledgement())
+
+	// tokens are received by the final receiver
+	voucherB := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)
+	voucherC := transfertypes.GetPrefixedDenom(forwardPacket.GetDestPort(), forwardPacket.GetDestChannel(), voucherB)
+
+	suite.Require().Equal(originalBalance.Sub(amount), suite.balance(suite.chainA, sender, sdk.DefaultBondDenom))
+	suite.Require().Equal(amount, suite.balance(suite.chainC, receiver, suite.voucherDenom(voucherC)))
+	suite.Require().True(suite.balance(suite.chainB, types.GetReceiver(packet.GetDestChannel(), sender.String()), suite.voucherDenom(voucherB)).IsZero())
+
+	forwardEscrow := transfertypes.GetEscrowAddress(forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel())
+	suite.Require().Equal(amount, suite.balance(suite.chainB, forwardEscrow, suite.voucherDenom(voucherB)))
+
+	suite.requireTotalEscrow()
+}
+
+// TestForwardPacketMultiHop forwards tokens from chainA through chainB and chainC and back to chainA
+// using nested forwarding instructions.
+func (suite *PacketForwardTestSuite) TestForwardPacketMultiHop() {
+	pathCA := NewTransferPath(suite.chainC, suite.chainA)
+	suite.coordinator.Setup(pathCA)
+
+	receiver := suite.chainA.SenderAccount.GetAddress()
+
+	next := forwardMemo(pathCA.EndpointA, receiver.String(), "")
+	memo := forwardMemo(suite.pathBC.EndpointA, "intermediate", fmt.Sprintf(`,"next":%s`, next))
+	packet := suite.transfer(suite.pathAB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), memo)
+
+	res := suite.recvPacket(suite.pathAB.EndpointB, packet)
+	forwardPacketBC, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.recvPacket(suite.pathBC.EndpointB, forwardPacketBC)
+	forwardPacketCA, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var forwardData transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(forwardPacketCA.GetData(), &forwardData))
+	suite.Require().Equal(receiver.String(), forwardData.Receiver)
+
+	suite.Require().NoError(pathCA.RelayPacket(forwardPacketCA))
+	suite.requireAck(suite.chainC, forwardPacketBC, successAck.Acknowledgement())
+
+	suite.acknowledgePacket(suite.pathBC.EndpointA, forwardPacketBC, successAck.Acknowledgement())
+	suite.requireAck(suite.chainB, packet, successAck.Acknowledgement())
+
+	suite.acknowledgePacket(suite.pathAB.EndpointA, packet, successAck.Acknowledgement())
+
+	voucherB := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)
+	voucherC := transfertypes.GetPrefixedDenom(forwardPacketBC.GetDestPort(), forwardPacketBC.GetDestChannel(), voucherB)
+	voucherA := transfertypes.GetPrefixedDenom(forwardPacketCA.GetDestPort(), forwardPacketCA.GetDestChannel(), voucherC)
+
+	suite.Require().Equal(amount, suite.balance(suite.chainA, receiver, suite.voucherDenom(voucherA)))
+}
+
+// TestForwardPacketError propagates the error acknowledgement of the final hop back to chainA and
+// asserts that the tokens are refunded on every chain.
+func (suite *PacketForwardTestSuite) TestForwardPacketError() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalance := suite.balance(suite.chainA, sender, sdk.DefaultBondDenom)
+
+	// the receiver on chainC is not a valid address
+	memo := forwardMemo(suite.pathBC.EndpointA, "invalid", "")
+	packet := suite.transfer(suite.pathAB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), memo)
+
+	res := suite.recvPacket(suite.pathAB.EndpointB, packet)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.recvPacket(suite.pathBC.EndpointB, forwardPacket)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.acknowledgePacket(suite.pathBC.EndpointA, forwardPacket, ack)
+	suite.requireAck(suite.chainB, packet, ack)
+
+	suite.acknowledgePacket(suite.pathAB.EndpointA, packet, ack)
+
+	// the vouchers minted on chainB are burned and the sender is refunded on chainA
+	voucherB := suite.voucherDenom(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	forwardEscrow := transfertypes.GetEscrowAddress(forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel())
+
+	suite.Require().True(suite.balance(suite.chainB, forwardEscrow, voucherB).IsZero())
+	suite.Require().True(suite.balance(suite.chainB, types.GetReceiver(packet.GetDestChannel(), sender.String()), voucherB).IsZero())
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherB).Amount.IsZero())
+	suite.Require().Equal(originalBalance, suite.balance(suite.chainA, sender, sdk.DefaultBondDenom))
+
+	suite.requireTotalEscrow()
+}
+
+// TestForwardUnescrowedTokens forwards tokens which are native to the intermediate chain, such that
+// they are unescrowed upon receive and escrowed again when forwarded.
+func (suite *PacketForwardTestSuite) TestForwardUnescrowedTokens() {
+	testCases := []struct {
+		name     string
+		receiver func() string
+		expPass  bool
+	}{
+		{
+			"success", func() string { return suite.chainA.SenderAccount.GetAddress().String() }, true,
+		},
+		{
+			"failure: invalid receiver on final chain", func() string { return "invalid" }, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// send native tokens from chainB to chainC
+			packet := suite.transfer(suite.pathBC.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainC.SenderAccount.GetAddress().String(), "")
+			suite.Require().NoError(suite.pathBC.RelayPacket(packet))
+
+			voucherC := suite.voucherDenom(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+			sender := suite.chainC.SenderAccount.GetAddress()
+			suite.Require().Equal(amount, suite.balance(suite.chainC, sender, voucherC))
+
+			// send the vouchers back to chainB and forward the unescrowed tokens to chainA
+			memo := forwardMemo(suite.pathAB.EndpointB, tc.receiver(), "")
+			packet = suite.transfer(suite.pathBC.EndpointB, sdk.NewCoin(voucherC, amount), suite.chainB.SenderAccount.GetAddress().String(), memo)
+
+			res := suite.recvPacket(suite.pathBC.EndpointA, packet)
+			forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			res = suite.recvPacket(suite.pathAB.EndpointA, forwardPacket)
+			ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			suite.acknowledgePacket(suite.pathAB.EndpointB, forwardPacket, ack)
+			suite.requireAck(suite.chainB, packet, ack)
+
+			suite.acknowledgePacket(suite.pathBC.EndpointB, packet, ack)
+
+			originalEscrow := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+			forwardEscrow := transfertypes.GetEscrowAddress(forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel())
+			voucherA := suite.voucherDenom(transfertypes.GetPrefixedDenom(forwardPacket.GetDestPort(), forwardPacket.GetDestChannel(), sdk.DefaultBondDenom))
+
+			if tc.expPass {
+				suite.Require().True(suite.balance(suite.chainB, originalEscrow, sdk.DefaultBondDenom).IsZero())
+				suite.Require().Equal(amount, suite.balance(suite.chainB, forwardEscrow, sdk.DefaultBondDenom))
+				suite.Require().Equal(amount, suite.balance(suite.chainA, suite.chainA.SenderAccount.GetAddress(), voucherA))
+				suite.Require().True(suite.balance(suite.chainC, sender, voucherC).IsZero())
+			} else {
+				// the tokens are moved back into the original escrow and the vouchers refunded on chainC
+				suite.Require().Equal(amount, suite.balance(suite.chainB, originalEscrow, sdk.DefaultBondDenom))
+				suite.Require().True(suite.balance(suite.chainB, forwardEscrow, sdk.DefaultBondDenom).IsZero())
+				suite.Require().Equal(amount, suite.balance(suite.chainC, sender, voucherC))
+			}
+
+			suite.requireTotalEscrow()
+		})
+	}
+}
+
+// TestForwardPacketTimeout times out the forwarded packet without any retries remaining and asserts
+// that an error acknowledgement is written for the original packet.
+func (suite *PacketForwardTestSuite) TestForwardPacketTimeout() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalance := suite.balance(suite.chainA, sender, sdk.DefaultBondDenom)
+
+	memo := forwardMemo(suite.pathBC.EndpointA, suite.chainC.SenderAccount.GetAddress().String(), fmt.Sprintf(`,"timeout":"%s"`, forwardTimeout))
+	packet := suite.transfer(suite.pathAB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), memo)
+
+	res := suite.recvPacket(suite.pathAB.EndpointB, packet)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.timeoutPacket(suite.pathBC.EndpointA, forwardPacket)
+
+	// no packet is resent
+	_, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.requireAck(suite.chainB, packet, ack)
+
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	suite.Require().False(acknowledgement.Success())
+
+	suite.acknowledgePacket(suite.pathAB.EndpointA, packet, ack)
+
+	voucherB := suite.voucherDenom(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherB).Amount.IsZero())
+	suite.Require().Equal(originalBalance, suite.balance(suite.chainA, sender, sdk.DefaultBondDenom))
+
+	suite.requireTotalEscrow()
+}
+
+// TestForwardPacketTimeoutRetry times out the forwarded packet once and asserts that it is resent.
+func (suite *PacketForwardTestSuite) TestForwardPacketTimeoutRetry() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainC.SenderAccount.GetAddress()
+
+	memo := forwardMemo(suite.pathBC.EndpointA, receiver.String(), fmt.Sprintf(`,"timeout":"%s","retries":1`, forwardTimeout))
+	packet := suite.transfer(suite.pathAB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), memo)
+
+	res := suite.recvPacket(suite.pathAB.EndpointB, packet)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.timeoutPacket(suite.pathBC.EndpointA, forwardPacket)
+	suite.requireNoAck(suite.chainB, packet)
+
+	retryPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(forwardPacket.GetSequence()+1, retryPacket.GetSequence())
+	suite.Require().Equal(forwardPacket.GetData(), retryPacket.GetData())
+
+	pfmKeeper := suite.chainB.GetSimApp().PacketForwardKeeper
+	inFlightPacket, found := pfmKeeper.GetInFlightPacket(suite.chainB.GetContext(), retryPacket.GetSourcePort(), retryPacket.GetSourceChannel(), retryPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Zero(inFlightPacket.RetriesRemaining)
+
+	// relay the resent packet within its timeout
+	suite.Require().NoError(suite.pathBC.EndpointB.UpdateClient())
+	_, err = suite.pathBC.EndpointB.RecvPacketWithResult(retryPacket)
+	suite.Require().NoError(err)
+	suite.acknowledgePacket(suite.pathBC.EndpointA, retryPacket, successAck.Acknowledgement())
+
+	suite.requireAck(suite.chainB, packet, successAck.Acknowledgement())
+
+	voucherB := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)
+	voucherC := transfertypes.GetPrefixedDenom(retryPacket.GetDestPort(), retryPacket.GetDestChannel(), voucherB)
+	suite.Require().Equal(amount, suite.balance(suite.chainC, receiver, suite.voucherDenom(voucherC)))
+	suite.Require().True(suite.balance(suite.chainB, types.GetReceiver(packet.GetDestChannel(), sender.String()), suite.voucherDenom(voucherB)).IsZero())
+}
+
+// TestNonForwardMemo asserts that packets without forwarding instructions are handled by the
+// transfer application as usual.
+func (suite *PacketForwardTestSuite) TestNonForwardMemo() {
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	packet := suite.transfer(suite.pathAB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), `{"wasm":{"contract":"contract"}}`)
+	suite.Require().NoError(suite.pathAB.RelayPacket(packet))
+
+	suite.requireAck(suite.chainB, packet, successAck.Acknowledgement())
+
+	voucherB := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)
+	suite.Require().Equal(amount, suite.balance(suite.chainB, receiver, suite.voucherDenom(voucherB)))
+}
+
+// TestInvalidForwardMetadata asserts that malformed forwarding instructions result in an error
+// acknowledgement.
+func (suite *PacketForwardTestSuite) TestInvalidForwardMetadata() {
+	memo := `{"forward":{"receiver":"receiver","port":"transfer"}}`
+	packet := suite.transfer(suite.pathAB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainB.SenderAccount.GetAddress().String(), memo)
+
+	res := suite.recvPacket(suite.pathAB.EndpointB, packet)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	suite.Require().False(acknowledgement.Success())
+
+	_, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+}
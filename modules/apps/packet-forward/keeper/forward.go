@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ForwardTransferPacket sends the provided token from the intermediate sender account to the
+// next hop using the transfer keeper. The original packet is stored as an in-flight packet so that
+// it can be acknowledged once the forwarded packet is acknowledged or times out.
+func (k Keeper) ForwardTransferPacket(
+	ctx sdk.Context,
+	originalPacket channeltypes.Packet,
+	sender sdk.AccAddress,
+	token sdk.Coin,
+	portID,
+	channelID,
+	receiver,
+	memo string,
+	timeout time.Duration,
+	retries uint32,
+) error {
+	// the timeout of the forward metadata is bounded, the timeout of an in-flight packet imported
+	// in genesis is not, thus the timeout timestamp is checked for overflow
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if timeout < 0 || uint64(timeout.Nanoseconds()) > math.MaxUint64-blockTime {
+		return sdkerrors.Wrapf(types.ErrInvalidForwardMetadata, "timeout %s overflows the timeout timestamp of the forwarded packet", timeout)
+	}
+
+	timeoutTimestamp := blockTime + uint64(timeout.Nanoseconds())
+
+	msg := transfertypes.NewMsgTransfer(portID, channelID, token, sender.String(), receiver, clienttypes.ZeroHeight(), timeoutTimestamp)
+	msg.Memo = memo
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to forward packet to port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	inFlightPacket := types.NewInFlightPacket(portID, channelID, res.Sequence, originalPacket, retries, uint64(timeout.Nanoseconds()))
+	k.SetInFlightPacket(ctx, inFlightPacket)
+
+	k.Logger(ctx).Info(
+		"packet forwarded",
+		"original_port_id", originalPacket.GetDestPort(),
+		"original_channel_id", originalPacket.GetDestChannel(),
+		"original_sequence", strconv.FormatUint(originalPacket.GetSequence(), 10),
+		"forward_port_id", portID,
+		"forward_channel_id", channelID,
+		"forward_sequence", strconv.FormatUint(res.Sequence, 10),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOriginalPortID, originalPacket.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyOriginalChannelID, originalPacket.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyOriginalSequence, strconv.FormatUint(originalPacket.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyForwardChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, strconv.FormatUint(uint64(retries), 10)),
+		),
+	)
+
+	return nil
+}
+
+// RetryTimeout resends a forwarded packet which has timed out. The tokens of the timed out packet
+// are expected to have been refunded to the intermediate sender account prior to this call.
+func (k Keeper) RetryTimeout(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket types.InFlightPacket,
+) error {
+	if inFlightPacket.RetriesRemaining == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidInFlightPacket, "no retries remaining for forwarded packet with sequence %d", packet.GetSequence())
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	// the denomination of the forwarded packet is the full denomination path on this chain
+	token := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRetry,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyForwardPortID, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeyForwardChannelID, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		),
+	)
+
+	return k.ForwardTransferPacket(
+		ctx, inFlightPacket.OriginalPacket, sender, token,
+		packet.GetSourcePort(), packet.GetSourceChannel(), data.Receiver, data.Memo,
+		time.Duration(inFlightPacket.Timeout), inFlightPacket.RetriesRemaining-1,
+	)
+}
+
+// WriteAcknowledgementForForwardedPacket writes the acknowledgement of the original packet once
+// the packet it was forwarded as has completed. If the forwarded packet failed, the tokens which
+// were escrowed or burned when forwarding are returned to the state they were in prior to the
+// original packet being received, such that the original sender chain may safely refund the tokens.
+func (k Keeper) WriteAcknowledgementForForwardedPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket types.InFlightPacket,
+	ack ibcexported.Acknowledgement,
+) error {
+	originalPacket := inFlightPacket.OriginalPacket
+
+	if !ack.Success() {
+		if err := k.revertForwardedTokens(ctx, packet, data, originalPacket); err != nil {
+			return err
+		}
+	}
+
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, originalPacket.GetDestPort(), originalPacket.GetDestChannel())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, originalPacket, ack); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOriginalPortID, originalPacket.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyOriginalChannelID, originalPacket.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyOriginalSequence, strconv.FormatUint(originalPacket.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+		),
+	)
+
+	return nil
+}
+
+// revertForwardedTokens undoes the token movements performed when receiving and forwarding a packet.
+// The underlying transfer application refund is intentionally skipped for failed forwarded packets,
+// as the tokens must not end up in the intermediate account. Instead:
+//
+// 1. If the tokens were escrowed when forwarding and unescrowed upon receive, they are moved from the
+// forward channel escrow account back to the original channel escrow account.
+//
+// 2. If the tokens were escrowed when forwarding and minted upon receive, they are burned.
+//
+// 3. If the tokens were burned when forwarding and unescrowed upon receive, they are minted back into
+// the original channel escrow account.
+//
+// 4. If the tokens were burned when forwarding and minted upon receive, no action is required.
+//
+// The total amount in escrow tracked by the transfer keeper is updated accordingly.
+func (k Keeper) revertForwardedTokens(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	originalPacket channeltypes.Packet,
+) error {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	token := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+	coins := sdk.NewCoins(token)
+
+	// the original packet tokens were unescrowed if this chain is the source of the denomination
+	// with respect to the channel the original packet was received on
+	unescrowedOnRecv := transfertypes.SenderChainIsSource(originalPacket.GetDestPort(), originalPacket.GetDestChannel(), data.Denom)
+	originalEscrowAddress := transfertypes.GetEscrowAddress(originalPacket.GetDestPort(), originalPacket.GetDestChannel())
+
+	if transfertypes.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		forwardEscrowAddress := transfertypes.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+
+		if unescrowedOnRecv {
+			return k.bankKeeper.SendCoins(ctx, forwardEscrowAddress, originalEscrowAddress, coins)
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwardEscrowAddress, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+
+		totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Sub(token))
+
+		return nil
+	}
+
+	if unescrowedOnRecv {
+		if err := k.bankKeeper.MintCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, originalEscrowAddress, coins); err != nil {
+			panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+		}
+
+		totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Add(token))
+	}
+
+	return nil
+}
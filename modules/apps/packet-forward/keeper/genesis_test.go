@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	pfmKeeper := suite.chainA.GetSimApp().PacketForwardKeeper
+
+	expPackets := []types.InFlightPacket{newInFlightPacket(1), newInFlightPacket(2)}
+	for _, inFlightPacket := range expPackets {
+		pfmKeeper.SetInFlightPacket(suite.chainA.GetContext(), inFlightPacket)
+	}
+
+	genesis := pfmKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(expPackets, genesis.InFlightPackets)
+
+	suite.Require().NotPanics(func() {
+		suite.chainB.GetSimApp().PacketForwardKeeper.InitGenesis(suite.chainB.GetContext(), *genesis)
+	})
+
+	suite.Require().Equal(expPackets, suite.chainB.GetSimApp().PacketForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+}
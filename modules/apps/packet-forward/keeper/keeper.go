@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Keeper defines the packet forward middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper    types.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	transferKeeper types.TransferKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper creates a new packet forward middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper, transferKeeper types.TransferKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:       key,
+		cdc:            cdc,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		transferKeeper: transferKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper used by the packet forward middleware
+func (k Keeper) GetICS4Wrapper() types.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetInFlightPacket retrieves the in-flight packet stored for the forwarded packet with the provided identifiers
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyInFlightPacket(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+
+	return inFlightPacket, true
+}
+
+// SetInFlightPacket stores the provided in-flight packet keyed by the identifiers of the forwarded packet
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&inFlightPacket)
+	store.Set(types.KeyInFlightPacket(inFlightPacket.ForwardPortId, inFlightPacket.ForwardChannelId, inFlightPacket.ForwardSequence), bz)
+}
+
+// DeleteInFlightPacket removes the in-flight packet stored for the forwarded packet with the provided identifiers
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyInFlightPacket(portID, channelID, sequence))
+}
+
+// IterateInFlightPackets iterates over all in-flight packets in the store and performs the provided callback
+func (k Keeper) IterateInFlightPackets(ctx sdk.Context, cb func(inFlightPacket types.InFlightPacket) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.InFlightPacketKeyPrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var inFlightPacket types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &inFlightPacket)
+
+		if cb(inFlightPacket) {
+			break
+		}
+	}
+}
+
+// GetAllInFlightPackets returns all in-flight packets in the store
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	inFlightPackets := []types.InFlightPacket{}
+	k.IterateInFlightPackets(ctx, func(inFlightPacket types.InFlightPacket) bool {
+		inFlightPackets = append(inFlightPackets, inFlightPacket)
+		return false
+	})
+
+	return inFlightPackets
+}
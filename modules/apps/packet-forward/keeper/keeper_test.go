@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func newInFlightPacket(sequence uint64) types.InFlightPacket {
+	originalPacket := channeltypes.NewPacket(
+		[]byte("data"), sequence, ibctesting.TransferPort, "channel-0", ibctesting.TransferPort, "channel-1", clienttypes.NewHeight(0, 100), 0,
+	)
+
+	return types.NewInFlightPacket(ibctesting.TransferPort, "channel-2", sequence, originalPacket, 1, 600)
+}
+
+func (suite *KeeperTestSuite) TestInFlightPacket() {
+	pfmKeeper := suite.chainA.GetSimApp().PacketForwardKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := pfmKeeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-2", 1)
+	suite.Require().False(found)
+
+	expPackets := []types.InFlightPacket{newInFlightPacket(1), newInFlightPacket(2), newInFlightPacket(3)}
+	for _, inFlightPacket := range expPackets {
+		pfmKeeper.SetInFlightPacket(ctx, inFlightPacket)
+	}
+
+	inFlightPacket, found := pfmKeeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-2", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(expPackets[1], inFlightPacket)
+
+	suite.Require().Equal(expPackets, pfmKeeper.GetAllInFlightPackets(ctx))
+
+	pfmKeeper.DeleteInFlightPacket(ctx, ibctesting.TransferPort, "channel-2", 2)
+
+	_, found = pfmKeeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-2", 2)
+	suite.Require().False(found)
+	suite.Require().Equal([]types.InFlightPacket{expPackets[0], expPackets[2]}, pfmKeeper.GetAllInFlightPackets(ctx))
+}
+
+func (suite *KeeperTestSuite) TestIterateInFlightPackets() {
+	pfmKeeper := suite.chainA.GetSimApp().PacketForwardKeeper
+	ctx := suite.chainA.GetContext()
+
+	for i := uint64(1); i <= 5; i++ {
+		pfmKeeper.SetInFlightPacket(ctx, newInFlightPacket(i))
+	}
+
+	var count int
+	pfmKeeper.IterateInFlightPackets(ctx, func(inFlightPacket types.InFlightPacket) bool {
+		count++
+		return count == 3
+	})
+
+	suite.Require().Equal(3, count)
+}
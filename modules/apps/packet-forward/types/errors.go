@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrInvalidInFlightPacket  = sdkerrors.Register(ModuleName, 3, "invalid in-flight packet")
+	ErrForwardTimeout         = sdkerrors.Register(ModuleName, 4, "forwarded packet timed out")
+)
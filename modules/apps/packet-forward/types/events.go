@@ -0,0 +1,18 @@
+package types
+
+// Packet forward middleware events
+const (
+	EventTypeForward      = "forward_packet"
+	EventTypeForwardRetry = "forward_packet_retry"
+	EventTypeForwardAck   = "forward_packet_acknowledgement"
+
+	AttributeKeyOriginalPortID    = "original_port_id"
+	AttributeKeyOriginalChannelID = "original_channel_id"
+	AttributeKeyOriginalSequence  = "original_sequence"
+	AttributeKeyForwardPortID     = "forward_port_id"
+	AttributeKeyForwardChannelID  = "forward_channel_id"
+	AttributeKeyForwardSequence   = "forward_sequence"
+	AttributeKeyReceiver          = "receiver"
+	AttributeKeyRetriesRemaining  = "retries_remaining"
+	AttributeKeyAckSuccess        = "success"
+)
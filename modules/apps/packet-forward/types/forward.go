@@ -0,0 +1,198 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ForwardMetadataKey is the top level memo key under which forwarding instructions are expected
+	ForwardMetadataKey = "forward"
+
+	// DefaultForwardRetries is the number of times a forwarded packet is resent upon timeout
+	// when the forward metadata does not specify the number of retries
+	DefaultForwardRetries uint8 = 0
+)
+
+// DefaultForwardTimeout is the relative timeout used for forwarded packets when the forward
+// metadata does not specify a timeout.
+var DefaultForwardTimeout = time.Duration(transfertypes.DefaultRelativePacketTimeoutTimestamp)
+
+// MaxForwardTimeout is the maximum relative timeout which may be specified by the forward
+// metadata, such that the absolute timeout timestamp of the forwarded packet cannot overflow.
+const MaxForwardTimeout = 365 * 24 * time.Hour
+
+// PacketMetadata defines the ICS-20 memo structure understood by the packet forward middleware.
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines the instructions used to forward a received ICS-20 packet to the next hop.
+type ForwardMetadata struct {
+	Receiver string   `json:"receiver"`
+	Port     string   `json:"port"`
+	Channel  string   `json:"channel"`
+	Timeout  Duration `json:"timeout,omitempty"`
+	Retries  *uint8   `json:"retries,omitempty"`
+
+	// Next is an optional memo included in the forwarded packet. It may either be a JSON object,
+	// in which case it is used verbatim, or a JSON string.
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata attempts to parse forwarding instructions from the provided ICS-20 memo.
+// The boolean return value indicates if the memo contains forwarding instructions. Memos which
+// are not JSON objects or do not contain the forward key are ignored, whereas malformed forward
+// instructions result in an error.
+func ParseForwardMetadata(memo string) (ForwardMetadata, bool, error) {
+	if strings.TrimSpace(memo) == "" {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return ForwardMetadata{}, false, nil
+	}
+
+	if _, ok := fields[ForwardMetadataKey]; !ok {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return ForwardMetadata{}, true, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "cannot unmarshal forward metadata: %s", err.Error())
+	}
+
+	if metadata.Forward == nil {
+		return ForwardMetadata{}, true, sdkerrors.Wrap(ErrInvalidForwardMetadata, "forward metadata cannot be null")
+	}
+
+	if err := metadata.Forward.Validate(); err != nil {
+		return ForwardMetadata{}, true, err
+	}
+
+	return *metadata.Forward, true, nil
+}
+
+// Validate performs basic validation of the forward metadata.
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be blank")
+	}
+
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err.Error())
+	}
+
+	if m.Timeout < 0 {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "timeout cannot be negative: %s", time.Duration(m.Timeout))
+	}
+
+	if time.Duration(m.Timeout) > MaxForwardTimeout {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "timeout %s cannot exceed %s", time.Duration(m.Timeout), MaxForwardTimeout)
+	}
+
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTimeout returns the relative timeout to be used for the forwarded packet.
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+
+	return time.Duration(m.Timeout)
+}
+
+// GetRetries returns the number of times the forwarded packet may be resent upon timeout.
+func (m ForwardMetadata) GetRetries() uint8 {
+	if m.Retries == nil {
+		return DefaultForwardRetries
+	}
+
+	return *m.Retries
+}
+
+// NextMemo returns the memo to be included in the forwarded packet.
+func (m ForwardMetadata) NextMemo() (string, error) {
+	if len(m.Next) == 0 || string(m.Next) == "null" {
+		return "", nil
+	}
+
+	var next interface{}
+	if err := json.Unmarshal(m.Next, &next); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid next memo: %s", err.Error())
+	}
+
+	switch next := next.(type) {
+	case string:
+		return next, nil
+	case map[string]interface{}:
+		return string(m.Next), nil
+	default:
+		return "", sdkerrors.Wrapf(ErrInvalidForwardMetadata, "next memo must be a JSON object or string, got %s", string(m.Next))
+	}
+}
+
+// Duration wraps time.Duration to allow for JSON decoding of either duration strings
+// (e.g. "10m") or integers representing nanoseconds.
+type Duration time.Duration
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case json.Number:
+		nanoseconds, err := value.Int64()
+		if err != nil {
+			return err
+		}
+		*d = Duration(nanoseconds)
+	case string:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*d = Duration(duration)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid duration: %s", string(bz))
+	}
+
+	return nil
+}
+
+// GetReceiver returns the deterministic intermediate account which receives the tokens of a packet
+// that is forwarded. The account is derived from the destination channel on this chain and the
+// original sender to prevent other users from interacting with the forwarded funds.
+func GetReceiver(channelID, originalSender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(ModuleName, []byte(channelID+"/"+originalSender)))
+}
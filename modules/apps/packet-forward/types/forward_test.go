@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		memo       string
+		expForward bool
+		expPass    bool
+	}{
+		{"empty memo", "", false, true},
+		{"non JSON memo", "hello world", false, true},
+		{"JSON memo without forward key", `{"wasm":{"contract":"contract"}}`, false, true},
+		{"JSON array memo", `[{"forward":{}}]`, false, true},
+		{"valid forward metadata", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0"}}`, true, true},
+		{"valid forward metadata with timeout and retries", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","timeout":"10m","retries":2}}`, true, true},
+		{"valid forward metadata with next object", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","next":{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-1"}}}}`, true, true},
+		{"valid forward metadata with next string", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","next":"memo"}}`, true, true},
+		{"null forward metadata", `{"forward":null}`, true, false},
+		{"forward metadata is not an object", `{"forward":"channel-0"}`, true, false},
+		{"blank receiver", `{"forward":{"receiver":" ","port":"transfer","channel":"channel-0"}}`, true, false},
+		{"invalid port", `{"forward":{"receiver":"receiver","port":"(invalid)","channel":"channel-0"}}`, true, false},
+		{"invalid channel", `{"forward":{"receiver":"receiver","port":"transfer","channel":""}}`, true, false},
+		{"negative timeout", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","timeout":"-1m"}}`, true, false},
+		{"invalid timeout", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","timeout":"ten minutes"}}`, true, false},
+		{"max timeout", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","timeout":"8760h"}}`, true, true},
+		{"timeout exceeds max", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","timeout":"8761h"}}`, true, false},
+		{"timeout overflows timestamp", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","timeout":9223372036854775807}}`, true, false},
+		{"retries overflow", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","retries":256}}`, true, false},
+		{"next is a number", `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","next":1}}`, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, isForward, err := types.ParseForwardMetadata(tc.memo)
+
+			require.Equal(t, tc.expForward, isForward)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestForwardMetadataDefaults(t *testing.T) {
+	metadata, isForward, err := types.ParseForwardMetadata(`{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0"}}`)
+	require.NoError(t, err)
+	require.True(t, isForward)
+
+	require.Equal(t, types.DefaultForwardTimeout, metadata.GetTimeout())
+	require.Equal(t, types.DefaultForwardRetries, metadata.GetRetries())
+
+	memo, err := metadata.NextMemo()
+	require.NoError(t, err)
+	require.Empty(t, memo)
+
+	metadata, _, err = types.ParseForwardMetadata(`{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-0","timeout":60000000000,"retries":3}}`)
+	require.NoError(t, err)
+
+	require.Equal(t, time.Minute, metadata.GetTimeout())
+	require.Equal(t, uint8(3), metadata.GetRetries())
+}
+
+func TestNextMemo(t *testing.T) {
+	testCases := []struct {
+		name    string
+		next    string
+		expMemo string
+		expPass bool
+	}{
+		{"no next memo", "", "", true},
+		{"null next memo", "null", "", true},
+		{"object next memo", `{"forward":{"receiver":"receiver"}}`, `{"forward":{"receiver":"receiver"}}`, true},
+		{"string next memo", `"memo"`, "memo", true},
+		{"array next memo", `["memo"]`, "", false},
+		{"boolean next memo", "true", "", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := types.ForwardMetadata{Next: []byte(tc.next)}
+
+			memo, err := metadata.NextMemo()
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expMemo, memo)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	var duration types.Duration
+
+	require.NoError(t, duration.UnmarshalJSON([]byte(`"1h30m"`)))
+	require.Equal(t, types.Duration(90*time.Minute), duration)
+
+	require.NoError(t, duration.UnmarshalJSON([]byte(`9007199254740993`)))
+	require.Equal(t, types.Duration(9007199254740993), duration)
+
+	require.Error(t, duration.UnmarshalJSON([]byte(`1.5`)))
+	require.Error(t, duration.UnmarshalJSON([]byte(`true`)))
+
+	bz, err := types.Duration(time.Minute).MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `"1m0s"`, string(bz))
+}
+
+func TestGetReceiver(t *testing.T) {
+	receiver := types.GetReceiver("channel-0", "sender")
+
+	require.Equal(t, receiver, types.GetReceiver("channel-0", "sender"))
+	require.NotEqual(t, receiver, types.GetReceiver("channel-1", "sender"))
+	require.NotEqual(t, receiver, types.GetReceiver("channel-0", "other"))
+}
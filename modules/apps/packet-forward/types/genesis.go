@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewGenesisState creates a new packet forward middleware GenesisState instance.
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without any in-flight packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		InFlightPackets: []InFlightPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, inFlightPacket := range gs.InFlightPackets {
+		if err := inFlightPacket.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyInFlightPacket(inFlightPacket.ForwardPortId, inFlightPacket.ForwardChannelId, inFlightPacket.ForwardSequence))
+		if seen[key] {
+			return sdkerrors.Wrapf(ErrInvalidInFlightPacket, "duplicate in-flight packet %s", key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// NewInFlightPacket creates a new InFlightPacket instance.
+func NewInFlightPacket(
+	forwardPortID, forwardChannelID string, forwardSequence uint64,
+	originalPacket channeltypes.Packet, retriesRemaining uint32, timeout uint64,
+) InFlightPacket {
+	return InFlightPacket{
+		ForwardPortId:    forwardPortID,
+		ForwardChannelId: forwardChannelID,
+		ForwardSequence:  forwardSequence,
+		OriginalPacket:   originalPacket,
+		RetriesRemaining: retriesRemaining,
+		Timeout:          timeout,
+	}
+}
+
+// Validate performs basic validation of the in-flight packet.
+func (p InFlightPacket) Validate() error {
+	if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInFlightPacket, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInFlightPacket, err.Error())
+	}
+
+	if p.ForwardSequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidInFlightPacket, "forward sequence cannot be 0")
+	}
+
+	if err := p.OriginalPacket.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInFlightPacket, "invalid original packet: %s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,738 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/packet_forward/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the packet forward middleware genesis state
+type GenesisState struct {
+	InFlightPackets []InFlightPacket `protobuf:"bytes,1,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets" yaml:"in_flight_packets"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c7d90faf2da9509, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetInFlightPackets() []InFlightPacket {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
+}
+
+// InFlightPacket contains the information required to asynchronously acknowledge a received
+// packet once the packet it was forwarded as has been acknowledged or has timed out
+type InFlightPacket struct {
+	// port identifier of the forwarded packet
+	ForwardPortId string `protobuf:"bytes,1,opt,name=forward_port_id,json=forwardPortId,proto3" json:"forward_port_id,omitempty" yaml:"forward_port_id"`
+	// channel identifier of the forwarded packet
+	ForwardChannelId string `protobuf:"bytes,2,opt,name=forward_channel_id,json=forwardChannelId,proto3" json:"forward_channel_id,omitempty" yaml:"forward_channel_id"`
+	// sequence of the forwarded packet
+	ForwardSequence uint64 `protobuf:"varint,3,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty" yaml:"forward_sequence"`
+	// packet received on this chain which is acknowledged once forwarding completes
+	OriginalPacket types.Packet `protobuf:"bytes,4,opt,name=original_packet,json=originalPacket,proto3" json:"original_packet" yaml:"original_packet"`
+	// number of times the forwarded packet may still be resent upon timeout
+	RetriesRemaining uint32 `protobuf:"varint,5,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty" yaml:"retries_remaining"`
+	// relative timeout (in nanoseconds) used when resending the forwarded packet
+	Timeout uint64 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c7d90faf2da9509, []int{1}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetForwardPortId() string {
+	if m != nil {
+		return m.ForwardPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardChannelId() string {
+	if m != nil {
+		return m.ForwardChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetOriginalPacket() types.Packet {
+	if m != nil {
+		return m.OriginalPacket
+	}
+	return types.Packet{}
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.packet_forward.v1.GenesisState")
+	proto.RegisterType((*InFlightPacket)(nil), "ibc.applications.packet_forward.v1.InFlightPacket")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/packet_forward/v1/genesis.proto", fileDescriptor_7c7d90faf2da9509)
+}
+
+var fileDescriptor_7c7d90faf2da9509 = []byte{
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0x90, 0x52, 0xc4, 0x94, 0x36, 0xad, 0x85, 0xc0, 0xa4, 0xe0, 0x98, 0x59, 0x65, 0x53,
+	0x0f, 0x49, 0x77, 0x48, 0x6c, 0x8c, 0x54, 0x14, 0xb1, 0xa9, 0xdc, 0x05, 0x12, 0x1b, 0xcb, 0x19,
+	0x4f, 0x9d, 0x11, 0xf6, 0x8c, 0x99, 0x99, 0x04, 0x75, 0xc7, 0x11, 0xe0, 0x2e, 0x1c, 0xa2, 0xcb,
+	0x2e, 0x59, 0x59, 0x28, 0xb9, 0x41, 0x4e, 0x80, 0x6c, 0xcf, 0xa8, 0xb8, 0x5d, 0xb0, 0xfb, 0x7e,
+	0x7e, 0xef, 0x7d, 0xff, 0xf7, 0xfd, 0xe1, 0x1b, 0x36, 0x27, 0x38, 0x29, 0xcb, 0x9c, 0x91, 0x44,
+	0x33, 0xc1, 0x15, 0x2e, 0x13, 0xf2, 0x85, 0xea, 0xf8, 0x52, 0xc8, 0x6f, 0x89, 0x4c, 0xf1, 0x6a,
+	0x82, 0x33, 0xca, 0xa9, 0x62, 0x2a, 0x28, 0xa5, 0xd0, 0xc2, 0x41, 0x6c, 0x4e, 0x82, 0x7f, 0x15,
+	0x41, 0x57, 0x11, 0xac, 0x26, 0xc3, 0xa7, 0x99, 0xc8, 0x44, 0x43, 0xc7, 0x75, 0xd5, 0x2a, 0x87,
+	0xaf, 0xeb, 0x5e, 0x44, 0x48, 0x8a, 0xc9, 0x22, 0xe1, 0x9c, 0xe6, 0xb5, 0xb9, 0x29, 0x5b, 0x0a,
+	0xfa, 0x09, 0xe0, 0x93, 0x0f, 0x6d, 0xbb, 0x0b, 0x9d, 0x68, 0xea, 0x7c, 0x07, 0xf0, 0x88, 0xf1,
+	0xf8, 0x32, 0x67, 0xd9, 0x42, 0xc7, 0x6d, 0x27, 0xe5, 0x02, 0xbf, 0x3f, 0xde, 0x9b, 0x4e, 0x83,
+	0xff, 0x7f, 0x4a, 0x30, 0xe3, 0x67, 0x8d, 0xf6, 0xbc, 0x79, 0x13, 0xfa, 0xd7, 0xd5, 0xa8, 0xb7,
+	0xad, 0x46, 0xee, 0x55, 0x52, 0xe4, 0x6f, 0xd1, 0x3d, 0x6b, 0x14, 0x0d, 0x58, 0x47, 0xa1, 0xd0,
+	0xaf, 0x3e, 0x3c, 0xe8, 0xba, 0x38, 0x21, 0x1c, 0x98, 0x16, 0x71, 0x29, 0xa4, 0x8e, 0x59, 0xea,
+	0x02, 0x1f, 0x8c, 0x1f, 0x87, 0xc3, 0x6d, 0x35, 0x7a, 0xd6, 0x5a, 0xdf, 0x21, 0xa0, 0x68, 0xdf,
+	0x20, 0xe7, 0x42, 0xea, 0x59, 0xea, 0x7c, 0x84, 0x8e, 0xa5, 0x98, 0x0c, 0x6a, 0x9b, 0x07, 0x8d,
+	0xcd, 0xab, 0x6d, 0x35, 0x7a, 0xd1, 0xb5, 0xb9, 0xe5, 0xa0, 0xe8, 0xd0, 0x80, 0xef, 0x5b, 0x6c,
+	0x96, 0x3a, 0x67, 0xd0, 0x62, 0xb1, 0xa2, 0x5f, 0x97, 0x94, 0x13, 0xea, 0xf6, 0x7d, 0x30, 0xde,
+	0x09, 0x8f, 0xb7, 0xd5, 0xe8, 0x79, 0xd7, 0xca, 0x32, 0x50, 0x64, 0xa7, 0xb8, 0x30, 0x88, 0x93,
+	0xc2, 0x81, 0x90, 0x2c, 0x63, 0x3c, 0xc9, 0x4d, 0x22, 0xee, 0x8e, 0x0f, 0xc6, 0x7b, 0xd3, 0xe3,
+	0x26, 0xeb, 0x7a, 0x79, 0x81, 0xdd, 0xd8, 0x6a, 0x12, 0x98, 0x50, 0x3d, 0x13, 0xaa, 0x99, 0xfc,
+	0x8e, 0x03, 0x8a, 0x0e, 0x2c, 0x62, 0xe2, 0x9b, 0xc1, 0x23, 0x49, 0xb5, 0x64, 0x54, 0xc5, 0x92,
+	0x16, 0x09, 0xe3, 0x8c, 0x67, 0xee, 0x43, 0x1f, 0x8c, 0xf7, 0xc3, 0x97, 0xb7, 0xbb, 0xb9, 0x47,
+	0x41, 0xd1, 0xa1, 0xc1, 0x22, 0x0b, 0x39, 0x2e, 0x7c, 0xa4, 0x59, 0x41, 0xc5, 0x52, 0xbb, 0xbb,
+	0xf5, 0xbc, 0x91, 0x7d, 0x0c, 0x3f, 0x5d, 0xaf, 0x3d, 0x70, 0xb3, 0xf6, 0xc0, 0x9f, 0xb5, 0x07,
+	0x7e, 0x6c, 0xbc, 0xde, 0xcd, 0xc6, 0xeb, 0xfd, 0xde, 0x78, 0xbd, 0xcf, 0xef, 0x32, 0xa6, 0x17,
+	0xcb, 0x79, 0x40, 0x44, 0x81, 0x89, 0x50, 0x85, 0x50, 0x98, 0xcd, 0xc9, 0x49, 0x26, 0xf0, 0xea,
+	0x14, 0x17, 0x22, 0x5d, 0xe6, 0x54, 0xd5, 0x37, 0x61, 0x6f, 0xe1, 0xc4, 0xde, 0x82, 0xbe, 0x2a,
+	0xa9, 0x9a, 0xef, 0x36, 0xbf, 0xea, 0xe9, 0xdf, 0x01, 0x00, 0xcb, 0x17, 0x88, 0x30, 0x3b, 0x03,
+	0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.OriginalPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ForwardSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ForwardChannelId) > 0 {
+		i -= len(m.ForwardChannelId)
+		copy(dAtA[i:], m.ForwardChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardPortId) > 0 {
+		i -= len(m.ForwardPortId)
+		copy(dAtA[i:], m.ForwardPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardPortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForwardPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ForwardChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ForwardSequence))
+	}
+	l = m.OriginalPacket.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGenesis(uint64(m.Timeout))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginalPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0,
+	)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState([]types.InFlightPacket{
+				types.NewInFlightPacket("transfer", "channel-2", 1, packet, 0, 600),
+				types.NewInFlightPacket("transfer", "channel-2", 2, packet, 1, 600),
+			}),
+			true,
+		},
+		{
+			"invalid forward port",
+			types.NewGenesisState([]types.InFlightPacket{
+				types.NewInFlightPacket("(invalid)", "channel-2", 1, packet, 0, 600),
+			}),
+			false,
+		},
+		{
+			"invalid forward channel",
+			types.NewGenesisState([]types.InFlightPacket{
+				types.NewInFlightPacket("transfer", "", 1, packet, 0, 600),
+			}),
+			false,
+		},
+		{
+			"zero forward sequence",
+			types.NewGenesisState([]types.InFlightPacket{
+				types.NewInFlightPacket("transfer", "channel-2", 0, packet, 0, 600),
+			}),
+			false,
+		},
+		{
+			"invalid original packet",
+			types.NewGenesisState([]types.InFlightPacket{
+				types.NewInFlightPacket("transfer", "channel-2", 1, channeltypes.Packet{}, 0, 600),
+			}),
+			false,
+		},
+		{
+			"duplicate in-flight packet",
+			types.NewGenesisState([]types.InFlightPacket{
+				types.NewInFlightPacket("transfer", "channel-2", 1, packet, 0, 600),
+				types.NewInFlightPacket("transfer", "channel-2", 1, packet, 1, 600),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the packet forward middleware module name
+	ModuleName = "packetforward"
+
+	// StoreKey is the store key string for the packet forward middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the packet forward middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the packet forward middleware
+	QuerierRoute = ModuleName
+)
+
+var (
+	// InFlightPacketKeyPrefix defines the key prefix used to store in-flight packets
+	InFlightPacketKeyPrefix = "inFlightPacket"
+)
+
+// KeyInFlightPacket creates and returns a new key used for in-flight packet store operations.
+// The key is constructed from the identifiers of the forwarded packet.
+func KeyInFlightPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", InFlightPacketKeyPrefix, portID, channelID, sequence))
+}
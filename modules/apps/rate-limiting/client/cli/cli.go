@@ -0,0 +1,23 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the rate limiting middleware
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "rate-limiting",
+		Short:                      "IBC transfer rate limiting query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryRateLimits(),
+		GetCmdQueryRateLimit(),
+		GetCmdQueryRateLimitsByChannel(),
+	)
+
+	return queryCmd
+}
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// NewCmdSubmitAddRateLimitProposal implements a command handler for submitting an add rate limit proposal transaction.
+func NewCmdSubmitAddRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rate-limit [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit an add rate limit proposal",
+		Long: "Submit a proposal to rate limit the transfers of a denomination on a channel along with an initial deposit.\n" +
+			"The maximum net flow in each direction is specified as a percentage of the supply of the denomination.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(args[2], args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddRateLimitProposal(title, description, types.NewPath(args[1], args[0]), quota)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitUpdateRateLimitProposal implements a command handler for submitting an update rate limit proposal transaction.
+func NewCmdSubmitUpdateRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration-hours]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit an update rate limit proposal",
+		Long: "Submit a proposal to replace the quota of an existing rate limit along with an initial deposit.\n" +
+			"The current window of the rate limit is reset if the proposal passes.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quota, err := parseQuota(args[2], args[3], args[4])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewUpdateRateLimitProposal(title, description, types.NewPath(args[1], args[0]), quota)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for submitting a remove rate limit proposal transaction.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a remove rate limit proposal",
+		Long:  "Submit a proposal to remove an existing rate limit along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRemoveRateLimitProposal(title, description, types.NewPath(args[1], args[0]))
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitResetRateLimitProposal implements a command handler for submitting a reset rate limit proposal transaction.
+func NewCmdSubmitResetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a reset rate limit proposal",
+		Long:  "Submit a proposal to reset the current window of an existing rate limit along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewResetRateLimitProposal(title, description, types.NewPath(args[1], args[0]))
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// submitProposal builds the proposal content using the title and description flags and generates
+// or broadcasts a transaction submitting it along with the deposit flag.
+func submitProposal(cmd *cobra.Command, contentFn func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	content := contentFn(title, description)
+
+	from := clientCtx.GetFromAddress()
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+func parseQuota(maxPercentSendStr, maxPercentRecvStr, durationHoursStr string) (types.Quota, error) {
+	maxPercentSend, ok := sdk.NewIntFromString(maxPercentSendStr)
+	if !ok {
+		return types.Quota{}, fmt.Errorf("invalid max percent send: %s", maxPercentSendStr)
+	}
+
+	maxPercentRecv, ok := sdk.NewIntFromString(maxPercentRecvStr)
+	if !ok {
+		return types.Quota{}, fmt.Errorf("invalid max percent recv: %s", maxPercentRecvStr)
+	}
+
+	durationHours, err := strconv.ParseUint(durationHoursStr, 10, 64)
+	if err != nil {
+		return types.Quota{}, fmt.Errorf("invalid duration hours: %w", err)
+	}
+
+	return types.NewQuota(maxPercentSend, maxPercentRecv, durationHours), nil
+}
@@ -0,0 +1,285 @@
+package ratelimiting_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	timeoutHeight = clienttypes.NewHeight(0, 110)
+	successAck    = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+)
+
+type RateLimitingTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitingTestSuite))
+}
+
+// addRateLimit adds a rate limit for the denomination on the channel of the endpoint with the
+// provided maximum percentages and returns the rate limit.
+func (suite *RateLimitingTestSuite) addRateLimit(endpoint *ibctesting.Endpoint, denom string, maxPercentSend, maxPercentRecv int64) types.RateLimit {
+	k := endpoint.Chain.GetSimApp().RateLimitingKeeper
+	ctx := endpoint.Chain.GetContext()
+
+	path := types.NewPath(denom, endpoint.ChannelID)
+	quota := types.NewQuota(sdk.NewInt(maxPercentSend), sdk.NewInt(maxPercentRecv), 24)
+	suite.Require().NoError(k.AddRateLimit(ctx, path, quota))
+
+	return suite.getRateLimit(endpoint, denom)
+}
+
+func (suite *RateLimitingTestSuite) getRateLimit(endpoint *ibctesting.Endpoint, denom string) types.RateLimit {
+	rateLimit, found := endpoint.Chain.GetSimApp().RateLimitingKeeper.GetRateLimit(endpoint.Chain.GetContext(), denom, endpoint.ChannelID)
+	suite.Require().True(found)
+
+	return rateLimit
+}
+
+// transfer sends the provided coin over the endpoint and returns the sent packet.
+func (suite *RateLimitingTestSuite) transfer(endpoint *ibctesting.Endpoint, coin sdk.Coin, receiver string, timeout clienttypes.Height) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		endpoint.Chain.SenderAccount.GetAddress().String(), receiver, timeout, 0,
+	)
+
+	res, err := endpoint.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// recvPacket receives the packet on the provided endpoint and returns the written acknowledgement.
+func (suite *RateLimitingTestSuite) recvPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) channeltypes.Acknowledgement {
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	res, err := endpoint.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+
+	return ack
+}
+
+// TestSendRateLimitedPacket sends tokens up to the send quota and asserts that exceeding the quota
+// is rejected.
+func (suite *RateLimitingTestSuite) TestSendRateLimitedPacket() {
+	rateLimit := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1, 1)
+	threshold := rateLimit.Flow.ChannelValue.QuoRaw(100)
+
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, threshold), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+
+	rateLimit = suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom)
+	suite.Require().Equal(threshold, rateLimit.Flow.Outflow)
+	suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	pendingSendPacket, found := k.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit.WindowStart, pendingSendPacket.WindowStart)
+
+	// sending a single additional token exceeds the quota
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0,
+	)
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// successful acknowledgements remove the pending packet and keep the outflow
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	_, found = k.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+	suite.Require().Equal(threshold, suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+}
+
+// TestSendUnlimitedPacket asserts that packets without a rate limited path are not tracked.
+func (suite *RateLimitingTestSuite) TestSendUnlimitedPacket() {
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	_, found := k.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+	suite.Require().Empty(k.GetAllRateLimits(suite.chainA.GetContext()))
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+}
+
+// TestRecvRateLimitedPacket receives vouchers up to the receive quota and asserts that exceeding
+// the quota results in an error acknowledgement.
+func (suite *RateLimitingTestSuite) TestRecvRateLimitedPacket() {
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	// the voucher requires a supply on chainB before it can be rate limited
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)), receiver.String(), timeoutHeight)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	rateLimit := suite.addRateLimit(suite.path.EndpointB, voucherDenom, 10, 10)
+	suite.Require().Equal(sdk.NewInt(1000), rateLimit.Flow.ChannelValue)
+
+	packet = suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101)), receiver.String(), timeoutHeight)
+	ack := suite.recvPacket(suite.path.EndpointB, packet)
+	suite.Require().Equal(transfertypes.NewErrorAcknowledgement(types.ErrQuotaExceeded), ack)
+
+	suite.Require().True(suite.getRateLimit(suite.path.EndpointB, voucherDenom).Flow.Inflow.IsZero())
+	suite.Require().Equal(sdk.NewInt(1000), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom).Amount)
+
+	packet = suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), receiver.String(), timeoutHeight)
+	ack = suite.recvPacket(suite.path.EndpointB, packet)
+	suite.Require().True(ack.Success())
+
+	suite.Require().Equal(sdk.NewInt(100), suite.getRateLimit(suite.path.EndpointB, voucherDenom).Flow.Inflow)
+	suite.Require().Equal(sdk.NewInt(1100), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom).Amount)
+}
+
+// TestRecvNetFlow asserts that the receive quota is applied to the net inflow.
+func (suite *RateLimitingTestSuite) TestRecvNetFlow() {
+	rateLimit := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 1, 1)
+	threshold := rateLimit.Flow.ChannelValue.QuoRaw(100)
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, threshold)
+
+	// send the tokens to chainB and back again, the net flow is zero
+	packet := suite.transfer(suite.path.EndpointA, amount, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	voucher := sdk.NewCoin(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom), threshold)
+	packet = suite.transfer(suite.path.EndpointB, sdk.NewCoin(transfertypes.ParseDenomTrace(voucher.Denom).IBCDenom(), threshold), suite.chainA.SenderAccount.GetAddress().String(), timeoutHeight)
+	ack := suite.recvPacket(suite.path.EndpointA, packet)
+	suite.Require().True(ack.Success())
+
+	rateLimit = suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom)
+	suite.Require().Equal(threshold, rateLimit.Flow.Inflow)
+	suite.Require().Equal(threshold, rateLimit.Flow.Outflow)
+}
+
+// TestUndoSendOnFailedAck asserts that the outflow is reverted when a packet is acknowledged with an error.
+func (suite *RateLimitingTestSuite) TestUndoSendOnFailedAck() {
+	suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 10, 10)
+
+	// the invalid receiver results in an error acknowledgement
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), "invalid", timeoutHeight)
+	suite.Require().Equal(sdk.NewInt(100), suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.Require().True(suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow.IsZero())
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+// TestUndoSendOnTimeout asserts that the outflow is reverted when a packet times out.
+func (suite *RateLimitingTestSuite) TestUndoSendOnTimeout() {
+	suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 10, 10)
+
+	timeout := clienttypes.NewHeight(0, uint64(suite.chainB.GetContext().BlockHeight())+1)
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainB.SenderAccount.GetAddress().String(), timeout)
+	suite.Require().Equal(sdk.NewInt(100), suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	// advance chainB past the timeout height
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().True(suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow.IsZero())
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+// TestUndoSendPreviousWindow asserts that the outflow of a packet sent within a previous window is
+// not reverted from the current window.
+func (suite *RateLimitingTestSuite) TestUndoSendPreviousWindow() {
+	suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 10, 10)
+
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), "invalid", timeoutHeight)
+
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	suite.Require().NoError(k.ResetRateLimit(suite.chainA.GetContext(), types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+	suite.Require().Equal(sdk.NewInt(50), suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	suite.Require().Equal(sdk.NewInt(50), suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	_, found := k.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+// TestWindowExpiry asserts that the flow of a rate limit is reset once its window has ended.
+func (suite *RateLimitingTestSuite) TestWindowExpiry() {
+	rateLimit := suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 10, 10)
+
+	suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+	suite.Require().Equal(sdk.NewInt(100), suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	suite.coordinator.IncrementTimeBy(rateLimit.Quota.Duration())
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	expired := suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom)
+	suite.Require().True(expired.Flow.Outflow.IsZero())
+	suite.Require().True(expired.WindowStart.After(rateLimit.WindowStart))
+}
+
+// TestOnAcknowledgementPacketSuccessAck asserts that successful acknowledgements are passed to the
+// underlying application.
+func (suite *RateLimitingTestSuite) TestOnAcknowledgementPacketSuccessAck() {
+	suite.addRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom, 10, 10)
+
+	packet := suite.transfer(suite.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight)
+	suite.Require().True(suite.recvPacket(suite.path.EndpointB, packet).Success())
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, successAck.Acknowledgement()))
+
+	suite.Require().Equal(sdk.NewInt(100), suite.getRateLimit(suite.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// InitGenesis initializes the rate limiting middleware state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, rateLimit := range state.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, pendingSendPacket := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, pendingSendPacket)
+	}
+}
+
+// ExportGenesis exports the rate limiting middleware state as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllRateLimits(ctx), k.GetAllPendingSendPackets(ctx))
+}
@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	windowStart := time.Unix(1_000_000, 0).UTC()
+	expGenesis := types.NewGenesisState(
+		[]types.RateLimit{newRateLimit("usei", "channel-0"), newRateLimit("usei", "channel-1")},
+		[]types.PendingSendPacket{
+			types.NewPendingSendPacket("channel-0", 1, windowStart),
+			types.NewPendingSendPacket("channel-1", 3, windowStart),
+		},
+	)
+
+	suite.Require().NotPanics(func() {
+		suite.chainA.GetSimApp().RateLimitingKeeper.InitGenesis(suite.chainA.GetContext(), *expGenesis)
+	})
+
+	genesis := suite.chainA.GetSimApp().RateLimitingKeeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().Equal(expGenesis, genesis)
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no rate limits",
+			func() {
+				req = &types.QueryRateLimitsRequest{}
+				expRateLimits = nil
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expRateLimits = []types.RateLimit{newRateLimit("usei", "channel-0"), newRateLimit("usei", "channel-1")}
+				for _, rateLimit := range expRateLimits {
+					suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+				}
+
+				req = &types.QueryRateLimitsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.RateLimits(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(expRateLimits, res.RateLimits)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req          *types.QueryRateLimitRequest
+		expRateLimit types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryRateLimitRequest{}
+			},
+			false,
+		},
+		{
+			"invalid channel",
+			func() {
+				req = &types.QueryRateLimitRequest{Denom: "usei", ChannelId: "(invalid)"}
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req = &types.QueryRateLimitRequest{Denom: "usei", ChannelId: "channel-0"}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				expRateLimit = newRateLimit("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "channel-0")
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), expRateLimit)
+
+				req = &types.QueryRateLimitRequest{Denom: expRateLimit.Path.Denom, ChannelId: expRateLimit.Path.ChannelId}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.RateLimit(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expRateLimit, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimitsByChannel() {
+	rateLimits := []types.RateLimit{
+		newRateLimit("uatom", "channel-0"),
+		newRateLimit("usei", "channel-0"),
+		newRateLimit("usei", "channel-1"),
+	}
+	for _, rateLimit := range rateLimits {
+		suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+	}
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	_, err := suite.queryClient.RateLimitsByChannel(ctx, &types.QueryRateLimitsByChannelRequest{ChannelId: "(invalid)"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.RateLimitsByChannel(ctx, &types.QueryRateLimitsByChannelRequest{ChannelId: "channel-0"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rateLimits[:2], res.RateLimits)
+
+	res, err = suite.queryClient.RateLimitsByChannel(ctx, &types.QueryRateLimitsByChannelRequest{ChannelId: "channel-2"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.RateLimits)
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Keeper defines the rate limiting middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper   types.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates a new rate limiting middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, ics4Wrapper types.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper wrapped by the rate limiting middleware
+func (k Keeper) GetICS4Wrapper() types.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetRateLimit retrieves the rate limit of the provided denomination on the given channel
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit stores the provided rate limit keyed by its path
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.KeyRateLimit(rateLimit.Path.ChannelId, rateLimit.Path.Denom), bz)
+}
+
+// DeleteRateLimit removes the rate limit of the provided denomination on the given channel
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(channelID, denom))
+}
+
+// IterateRateLimits iterates over all rate limits in the store and performs the provided callback
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) bool) {
+	k.iterateRateLimits(ctx, []byte(types.RateLimitKeyPrefix), cb)
+}
+
+// GetAllRateLimits returns all rate limits in the store
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// GetRateLimitsByChannel returns all rate limits of the provided channel
+func (k Keeper) GetRateLimitsByChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.iterateRateLimits(ctx, types.KeyRateLimitsByChannel(channelID), func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+func (k Keeper) iterateRateLimits(ctx sdk.Context, prefix []byte, cb func(rateLimit types.RateLimit) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetPendingSendPacket retrieves the pending send packet with the provided channel and sequence
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingSendPacket(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var pendingSendPacket types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &pendingSendPacket)
+
+	return pendingSendPacket, true
+}
+
+// SetPendingSendPacket stores the provided pending send packet keyed by its channel and sequence
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingSendPacket types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pendingSendPacket)
+	store.Set(types.KeyPendingSendPacket(pendingSendPacket.ChannelId, pendingSendPacket.Sequence), bz)
+}
+
+// DeletePendingSendPacket removes the pending send packet with the provided channel and sequence
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingSendPacket(channelID, sequence))
+}
+
+// IteratePendingSendPackets iterates over all pending send packets in the store and performs the provided callback
+func (k Keeper) IteratePendingSendPackets(ctx sdk.Context, cb func(pendingSendPacket types.PendingSendPacket) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PendingSendPacketKeyPrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSendPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSendPacket)
+
+		if cb(pendingSendPacket) {
+			break
+		}
+	}
+}
+
+// GetAllPendingSendPackets returns all pending send packets in the store
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	pendingSendPackets := []types.PendingSendPacket{}
+	k.IteratePendingSendPackets(ctx, func(pendingSendPacket types.PendingSendPacket) bool {
+		pendingSendPackets = append(pendingSendPackets, pendingSendPacket)
+		return false
+	})
+
+	return pendingSendPackets
+}
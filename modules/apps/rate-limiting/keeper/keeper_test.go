@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().RateLimitingKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func newRateLimit(denom, channelID string) types.RateLimit {
+	return types.NewRateLimit(
+		types.NewPath(denom, channelID), types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24),
+		types.NewFlow(sdk.NewInt(1000)), time.Unix(1_000_000, 0).UTC(),
+	)
+}
+
+func (suite *KeeperTestSuite) TestRateLimit() {
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := k.GetRateLimit(ctx, "usei", "channel-0")
+	suite.Require().False(found)
+
+	expRateLimits := []types.RateLimit{
+		newRateLimit("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "channel-0"),
+		newRateLimit("usei", "channel-0"),
+		newRateLimit("usei", "channel-1"),
+		newRateLimit("usei", "channel-10"),
+	}
+	for _, rateLimit := range expRateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, "usei", "channel-0")
+	suite.Require().True(found)
+	suite.Require().Equal(expRateLimits[1], rateLimit)
+
+	suite.Require().Equal(expRateLimits, k.GetAllRateLimits(ctx))
+	suite.Require().Equal(expRateLimits[:2], k.GetRateLimitsByChannel(ctx, "channel-0"))
+	suite.Require().Equal(expRateLimits[2:3], k.GetRateLimitsByChannel(ctx, "channel-1"))
+
+	k.DeleteRateLimit(ctx, "usei", "channel-0")
+
+	_, found = k.GetRateLimit(ctx, "usei", "channel-0")
+	suite.Require().False(found)
+	suite.Require().Len(k.GetAllRateLimits(ctx), 3)
+}
+
+func (suite *KeeperTestSuite) TestPendingSendPacket() {
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := k.GetPendingSendPacket(ctx, "channel-0", 1)
+	suite.Require().False(found)
+
+	windowStart := time.Unix(1_000_000, 0).UTC()
+	expPackets := []types.PendingSendPacket{
+		types.NewPendingSendPacket("channel-0", 1, windowStart),
+		types.NewPendingSendPacket("channel-0", 2, windowStart),
+		types.NewPendingSendPacket("channel-1", 1, windowStart),
+	}
+	for _, pendingSendPacket := range expPackets {
+		k.SetPendingSendPacket(ctx, pendingSendPacket)
+	}
+
+	pendingSendPacket, found := k.GetPendingSendPacket(ctx, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(expPackets[1], pendingSendPacket)
+	suite.Require().Equal(expPackets, k.GetAllPendingSendPackets(ctx))
+
+	k.DeletePendingSendPacket(ctx, "channel-0", 2)
+
+	_, found = k.GetPendingSendPacket(ctx, "channel-0", 2)
+	suite.Require().False(found)
+	suite.Require().Len(k.GetAllPendingSendPackets(ctx), 2)
+}
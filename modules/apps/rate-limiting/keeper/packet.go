@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// SendPacket implements the ICS4Wrapper interface. The outflow of the packet is checked against the
+// rate limit of its path before the packet is passed to the wrapped ICS4Wrapper.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := k.SendRateLimitedPacket(ctx, packet); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// SendRateLimitedPacket adds the amount of the provided ICS-20 packet to the outflow of the rate limit
+// of its denomination on the source channel. An error is returned if the quota is exceeded. The packet
+// is stored as pending such that the outflow may be reverted if the packet fails. Packets which are
+// not ICS-20 packets or which do not have a rate limited path are ignored.
+func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	// the denomination of a sent packet is the full denomination path on this chain
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	channelID := packet.GetSourceChannel()
+
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.Flow.AddOutflow(amount, rateLimit.Quota); err != nil {
+		emitQuotaExceededEvent(ctx, types.PacketSend, denom, channelID, amount)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(channelID, packet.GetSequence(), rateLimit.WindowStart))
+
+	return nil
+}
+
+// ReceiveRateLimitedPacket adds the amount of the provided ICS-20 packet to the inflow of the rate
+// limit of its denomination on the destination channel. An error is returned if the quota is exceeded.
+// Packets which are not ICS-20 packets or which do not have a rate limited path are ignored.
+func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	denom := receivedDenom(packet, data)
+	channelID := packet.GetDestChannel()
+
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.Flow.AddInflow(amount, rateLimit.Quota); err != nil {
+		emitQuotaExceededEvent(ctx, types.PacketRecv, denom, channelID, amount)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// UndoSendPacket removes the pending send packet for the provided packet. The outflow of the packet
+// is reverted if the packet was sent within the current window of its rate limit. It should be
+// called when a sent packet times out or is acknowledged with an error.
+func (k Keeper) UndoSendPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	pendingSendPacket, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+
+	rateLimit, found := k.GetRateLimit(ctx, denom, packet.GetSourceChannel())
+	if !found {
+		return nil
+	}
+
+	// the flow of previous windows has already been cleared
+	if !rateLimit.WindowStart.Equal(pendingSendPacket.WindowStart) {
+		return nil
+	}
+
+	rateLimit.Flow.UndoOutflow(amount)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// receivedDenom returns the denomination received on this chain for the provided packet, following
+// the denomination handling of the transfer application.
+func receivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	sourcePrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return transfertypes.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+}
+
+func emitQuotaExceededEvent(ctx sdk.Context, direction types.PacketDirection, denom, channelID string, amount sdk.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// AddRateLimit adds a rate limit with the provided quota for the given path. The channel must exist
+// on the transfer port and the denomination must have a non-zero supply. The window of the rate
+// limit starts at the current block time.
+func (k Keeper) AddRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId); found {
+		return sdkerrors.Wrapf(types.ErrRateLimitAlreadyExists, "denom (%s) channel ID (%s)", path.Denom, path.ChannelId)
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, path.ChannelId); !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", transfertypes.PortID, path.ChannelId)
+	}
+
+	rateLimit := types.NewRateLimit(path, quota, types.Flow{}, ctx.BlockTime())
+	if err := k.resetWindow(ctx, &rateLimit); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	emitRateLimitEvent(ctx, types.EventTypeAddRateLimit, path)
+
+	return nil
+}
+
+// UpdateRateLimit replaces the quota of the existing rate limit for the given path and resets its window.
+func (k Keeper) UpdateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom (%s) channel ID (%s)", path.Denom, path.ChannelId)
+	}
+
+	rateLimit.Quota = quota
+	if err := k.resetWindow(ctx, &rateLimit); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	emitRateLimitEvent(ctx, types.EventTypeUpdateRateLimit, path)
+
+	return nil
+}
+
+// RemoveRateLimit removes the existing rate limit for the given path.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, path types.Path) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom (%s) channel ID (%s)", path.Denom, path.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, path.Denom, path.ChannelId)
+	emitRateLimitEvent(ctx, types.EventTypeRemoveRateLimit, path)
+
+	return nil
+}
+
+// ResetRateLimit resets the window of the existing rate limit for the given path.
+func (k Keeper) ResetRateLimit(ctx sdk.Context, path types.Path) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom (%s) channel ID (%s)", path.Denom, path.ChannelId)
+	}
+
+	if err := k.resetWindow(ctx, &rateLimit); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	emitRateLimitEvent(ctx, types.EventTypeResetRateLimit, path)
+
+	return nil
+}
+
+// BeginBlocker resets the window of every rate limit whose window has ended at the current block time.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	var expired []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+			expired = append(expired, rateLimit)
+		}
+		return false
+	})
+
+	for _, rateLimit := range expired {
+		rateLimit := rateLimit
+		if err := k.resetWindow(ctx, &rateLimit); err != nil {
+			// the quota remains enforced against the channel value of the previous window
+			k.Logger(ctx).Error("failed to reset rate limit window", "denom", rateLimit.Path.Denom, "channel_id", rateLimit.Path.ChannelId, "error", err.Error())
+			continue
+		}
+
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// resetWindow clears the flow of the provided rate limit, recomputes the channel value from the
+// current supply of the denomination and starts a new window at the current block time.
+func (k Keeper) resetWindow(ctx sdk.Context, rateLimit *types.RateLimit) error {
+	channelValue := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+	if channelValue.IsZero() {
+		return sdkerrors.Wrapf(types.ErrZeroChannelValue, "denom (%s) has no supply", rateLimit.Path.Denom)
+	}
+
+	rateLimit.Flow = types.NewFlow(channelValue)
+	rateLimit.WindowStart = ctx.BlockTime()
+
+	return nil
+}
+
+func emitRateLimitEvent(ctx sdk.Context, eventType string, path types.Path) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDenom, path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, path.ChannelId),
+		),
+	)
+}
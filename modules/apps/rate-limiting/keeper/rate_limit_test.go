@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	var path types.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"rate limit already exists",
+			func() {
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(suite.chainA.GetContext(), newRateLimit(path.Denom, path.ChannelId))
+			},
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"channel does not exist",
+			func() {
+				path.ChannelId = "channel-100"
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"denom has no supply",
+			func() {
+				path.Denom = "nosupply"
+			},
+			types.ErrZeroChannelValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+			quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24)
+
+			tc.malleate()
+
+			k := suite.chainA.GetSimApp().RateLimitingKeeper
+			ctx := suite.chainA.GetContext()
+
+			err := k.AddRateLimit(ctx, path, quota)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(quota, rateLimit.Quota)
+				suite.Require().Equal(ctx.BlockTime(), rateLimit.WindowStart)
+				suite.Require().Equal(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, path.Denom).Amount, rateLimit.Flow.ChannelValue)
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimit() {
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	path := types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	quota := types.NewQuota(sdk.NewInt(50), sdk.NewInt(50), 1)
+
+	suite.Require().ErrorIs(k.UpdateRateLimit(ctx, path, quota), types.ErrRateLimitNotFound)
+
+	rateLimit := newRateLimit(path.Denom, path.ChannelId)
+	rateLimit.Flow.Outflow = sdk.NewInt(100)
+	k.SetRateLimit(ctx, rateLimit)
+
+	suite.Require().NoError(k.UpdateRateLimit(ctx, path, quota))
+
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	suite.Require().True(found)
+	suite.Require().Equal(quota, rateLimit.Quota)
+	suite.Require().Equal(ctx.BlockTime(), rateLimit.WindowStart)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRemoveRateLimit() {
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	path := types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().ErrorIs(k.RemoveRateLimit(ctx, path), types.ErrRateLimitNotFound)
+
+	k.SetRateLimit(ctx, newRateLimit(path.Denom, path.ChannelId))
+	suite.Require().NoError(k.RemoveRateLimit(ctx, path))
+
+	_, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestResetRateLimit() {
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	path := types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().ErrorIs(k.ResetRateLimit(ctx, path), types.ErrRateLimitNotFound)
+
+	expRateLimit := newRateLimit(path.Denom, path.ChannelId)
+	expRateLimit.Flow.Inflow = sdk.NewInt(50)
+	expRateLimit.Flow.Outflow = sdk.NewInt(100)
+	k.SetRateLimit(ctx, expRateLimit)
+
+	suite.Require().NoError(k.ResetRateLimit(ctx, path))
+
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	suite.Require().True(found)
+	suite.Require().Equal(expRateLimit.Quota, rateLimit.Quota)
+	suite.Require().Equal(ctx.BlockTime(), rateLimit.WindowStart)
+	suite.Require().Equal(types.NewFlow(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, path.Denom).Amount), rateLimit.Flow)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	expired := newRateLimit(sdk.DefaultBondDenom, "channel-0")
+	expired.WindowStart = ctx.BlockTime().Add(-expired.Quota.Duration())
+	expired.Flow.Outflow = sdk.NewInt(100)
+	k.SetRateLimit(ctx, expired)
+
+	active := newRateLimit(sdk.DefaultBondDenom, "channel-1")
+	active.WindowStart = ctx.BlockTime().Add(-expired.Quota.Duration() + time.Second)
+	active.Flow.Outflow = sdk.NewInt(100)
+	k.SetRateLimit(ctx, active)
+
+	k.BeginBlocker(ctx)
+
+	rateLimit, found := k.GetRateLimit(ctx, sdk.DefaultBondDenom, "channel-0")
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime(), rateLimit.WindowStart)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	rateLimit, found = k.GetRateLimit(ctx, sdk.DefaultBondDenom, "channel-1")
+	suite.Require().True(found)
+	suite.Require().Equal(active, rateLimit)
+}
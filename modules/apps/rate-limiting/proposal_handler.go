@@ -0,0 +1,29 @@
+package ratelimiting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+// NewRateLimitProposalHandler defines the rate limiting middleware proposal handler
+func NewRateLimitProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddRateLimitProposal:
+			return k.AddRateLimit(ctx, c.Path, c.Quota)
+		case *types.UpdateRateLimitProposal:
+			return k.UpdateRateLimit(ctx, c.Path, c.Quota)
+		case *types.RemoveRateLimitProposal:
+			return k.RemoveRateLimit(ctx, c.Path)
+		case *types.ResetRateLimitProposal:
+			return k.ResetRateLimit(ctx, c.Path)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized rate limiting proposal content type: %T", c)
+		}
+	}
+}
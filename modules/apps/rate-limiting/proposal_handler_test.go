@@ -0,0 +1,45 @@
+package ratelimiting_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	ratelimiting "github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting"
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func (suite *RateLimitingTestSuite) TestNewRateLimitProposalHandler() {
+	handler := ratelimiting.NewRateLimitProposalHandler(suite.chainA.GetSimApp().RateLimitingKeeper)
+	k := suite.chainA.GetSimApp().RateLimitingKeeper
+	ctx := suite.chainA.GetContext()
+
+	path := types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24)
+
+	suite.Require().NoError(handler(ctx, types.NewAddRateLimitProposal("title", "description", path, quota)))
+	suite.Require().Error(handler(ctx, types.NewAddRateLimitProposal("title", "description", path, quota)))
+
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	suite.Require().True(found)
+	suite.Require().Equal(quota, rateLimit.Quota)
+
+	updatedQuota := types.NewQuota(sdk.NewInt(20), sdk.NewInt(5), 1)
+	suite.Require().NoError(handler(ctx, types.NewUpdateRateLimitProposal("title", "description", path, updatedQuota)))
+
+	rateLimit, found = k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	suite.Require().True(found)
+	suite.Require().Equal(updatedQuota, rateLimit.Quota)
+
+	suite.Require().NoError(handler(ctx, types.NewResetRateLimitProposal("title", "description", path)))
+	suite.Require().NoError(handler(ctx, types.NewRemoveRateLimitProposal("title", "description", path)))
+
+	_, found = k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	suite.Require().False(found)
+
+	suite.Require().Error(handler(ctx, types.NewResetRateLimitProposal("title", "description", path)))
+	suite.Require().Error(handler(ctx, types.NewRemoveRateLimitProposal("title", "description", path)))
+
+	// unsupported proposal content
+	content := distrtypes.NewCommunityPoolSpendProposal("title", "description", suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins())
+	suite.Require().Error(handler(ctx, content))
+}
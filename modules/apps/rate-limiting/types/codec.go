@@ -0,0 +1,17 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterInterfaces registers the rate limiting governance proposals to protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddRateLimitProposal{},
+		&UpdateRateLimitProposal{},
+		&RemoveRateLimitProposal{},
+		&ResetRateLimitProposal{},
+	)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Rate limiting middleware sentinel errors
+var (
+	ErrInvalidPath              = sdkerrors.Register(ModuleName, 2, "invalid rate limit path")
+	ErrInvalidQuota             = sdkerrors.Register(ModuleName, 3, "invalid rate limit quota")
+	ErrRateLimitAlreadyExists   = sdkerrors.Register(ModuleName, 4, "rate limit already exists")
+	ErrRateLimitNotFound        = sdkerrors.Register(ModuleName, 5, "rate limit not found")
+	ErrQuotaExceeded            = sdkerrors.Register(ModuleName, 6, "rate limit quota exceeded")
+	ErrZeroChannelValue         = sdkerrors.Register(ModuleName, 7, "channel value is zero")
+	ErrInvalidPendingSendPacket = sdkerrors.Register(ModuleName, 8, "invalid pending send packet")
+	ErrChannelNotFound          = sdkerrors.Register(ModuleName, 9, "channel not found")
+)
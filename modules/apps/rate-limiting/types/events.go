@@ -0,0 +1,15 @@
+package types
+
+// Rate limiting middleware events
+const (
+	EventTypeQuotaExceeded   = "rate_limit_exceeded"
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+
+	AttributeKeyDirection = "direction"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeyAmount    = "amount"
+)
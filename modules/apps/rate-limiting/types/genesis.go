@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new rate limiting middleware GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without any rate limits or pending send packets.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyRateLimit(rateLimit.Path.ChannelId, rateLimit.Path.Denom))
+		if seenRateLimits[key] {
+			return sdkerrors.Wrapf(ErrRateLimitAlreadyExists, "duplicate rate limit %s", key)
+		}
+		seenRateLimits[key] = true
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, pendingSendPacket := range gs.PendingSendPackets {
+		if err := pendingSendPacket.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyPendingSendPacket(pendingSendPacket.ChannelId, pendingSendPacket.Sequence))
+		if seenPackets[key] {
+			return sdkerrors.Wrapf(ErrInvalidPendingSendPacket, "duplicate pending send packet %s", key)
+		}
+		seenPackets[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	windowStart := time.Unix(1_000_000, 0).UTC()
+	quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24)
+	flow := types.NewFlow(sdk.NewInt(1000))
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				[]types.RateLimit{
+					types.NewRateLimit(types.NewPath("usei", "channel-0"), quota, flow, windowStart),
+					types.NewRateLimit(types.NewPath("usei", "channel-1"), quota, flow, windowStart),
+				},
+				[]types.PendingSendPacket{
+					types.NewPendingSendPacket("channel-0", 1, windowStart),
+					types.NewPendingSendPacket("channel-0", 2, windowStart),
+				},
+			),
+			true,
+		},
+		{
+			"invalid rate limit path",
+			types.NewGenesisState(
+				[]types.RateLimit{types.NewRateLimit(types.NewPath("usei", "(invalid)"), quota, flow, windowStart)},
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid rate limit quota",
+			types.NewGenesisState(
+				[]types.RateLimit{types.NewRateLimit(types.NewPath("usei", "channel-0"), types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 0), flow, windowStart)},
+				nil,
+			),
+			false,
+		},
+		{
+			"negative rate limit flow",
+			types.NewGenesisState(
+				[]types.RateLimit{types.NewRateLimit(types.NewPath("usei", "channel-0"), quota, types.NewFlow(sdk.NewInt(-1)), windowStart)},
+				nil,
+			),
+			false,
+		},
+		{
+			"duplicate rate limit",
+			types.NewGenesisState(
+				[]types.RateLimit{
+					types.NewRateLimit(types.NewPath("usei", "channel-0"), quota, flow, windowStart),
+					types.NewRateLimit(types.NewPath("usei", "channel-0"), quota, flow, windowStart),
+				},
+				nil,
+			),
+			false,
+		},
+		{
+			"invalid pending send packet sequence",
+			types.NewGenesisState(nil, []types.PendingSendPacket{types.NewPendingSendPacket("channel-0", 0, windowStart)}),
+			false,
+		},
+		{
+			"duplicate pending send packet",
+			types.NewGenesisState(nil, []types.PendingSendPacket{
+				types.NewPendingSendPacket("channel-0", 1, windowStart),
+				types.NewPendingSendPacket("channel-0", 1, windowStart),
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
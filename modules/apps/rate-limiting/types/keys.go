@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the rate limiting middleware module name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limiting middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limiting middleware
+	QuerierRoute = ModuleName
+)
+
+var (
+	// RateLimitKeyPrefix defines the key prefix used to store rate limits
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix defines the key prefix used to store pending send packets
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+)
+
+// KeyRateLimit creates and returns a new key used for rate limit store operations.
+// The channel identifier precedes the denomination so that the rate limits of a
+// channel may be iterated over.
+func KeyRateLimit(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RateLimitKeyPrefix, channelID, denom))
+}
+
+// KeyRateLimitsByChannel creates and returns the key prefix of all rate limits of the provided channel.
+func KeyRateLimitsByChannel(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", RateLimitKeyPrefix, channelID))
+}
+
+// KeyPendingSendPacket creates and returns a new key used for pending send packet store operations.
+func KeyPendingSendPacket(channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", PendingSendPacketKeyPrefix, channelID, sequence))
+}
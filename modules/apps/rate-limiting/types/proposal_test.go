@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func TestProposalValidateBasic(t *testing.T) {
+	path := types.NewPath("usei", "channel-0")
+	quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24)
+	invalidPath := types.NewPath("usei", "(invalid)")
+	invalidQuota := types.NewQuota(sdk.ZeroInt(), sdk.ZeroInt(), 24)
+
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{"valid add proposal", types.NewAddRateLimitProposal("title", "description", path, quota), true},
+		{"add proposal with invalid path", types.NewAddRateLimitProposal("title", "description", invalidPath, quota), false},
+		{"add proposal with invalid quota", types.NewAddRateLimitProposal("title", "description", path, invalidQuota), false},
+		{"add proposal without title", types.NewAddRateLimitProposal("", "description", path, quota), false},
+		{"valid update proposal", types.NewUpdateRateLimitProposal("title", "description", path, quota), true},
+		{"update proposal with invalid quota", types.NewUpdateRateLimitProposal("title", "description", path, invalidQuota), false},
+		{"valid remove proposal", types.NewRemoveRateLimitProposal("title", "description", path), true},
+		{"remove proposal with invalid path", types.NewRemoveRateLimitProposal("title", "description", invalidPath), false},
+		{"valid reset proposal", types.NewResetRateLimitProposal("title", "description", path), true},
+		{"reset proposal without description", types.NewResetRateLimitProposal("title", "", path), false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute(), tc.name)
+
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
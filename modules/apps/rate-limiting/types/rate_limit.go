@@ -0,0 +1,221 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// PacketDirection defines the direction of a packet relative to this chain
+type PacketDirection int
+
+const (
+	// PacketSend defines a packet sent from this chain
+	PacketSend PacketDirection = iota
+	// PacketRecv defines a packet received by this chain
+	PacketRecv
+)
+
+// String implements the Stringer interface
+func (d PacketDirection) String() string {
+	if d == PacketSend {
+		return "send"
+	}
+	return "recv"
+}
+
+// NewPath creates a new Path instance.
+func NewPath(denom, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of the rate limit path.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPath, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPath, err.Error())
+	}
+
+	return nil
+}
+
+// NewQuota creates a new Quota instance.
+func NewQuota(maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate performs basic validation of the rate limit quota. The maximum percentages must be
+// between 0 and 100 and at least one of them must be non-zero. A maximum percentage of 0
+// blocks any net flow in the respective direction.
+func (q Quota) Validate() error {
+	if err := validatePercent(q.MaxPercentSend); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max percent send: %s", err.Error())
+	}
+
+	if err := validatePercent(q.MaxPercentRecv); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidQuota, "max percent recv: %s", err.Error())
+	}
+
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidQuota, "max percent send and max percent recv cannot both be 0")
+	}
+
+	if q.DurationHours == 0 {
+		return sdkerrors.Wrap(ErrInvalidQuota, "duration hours cannot be 0")
+	}
+
+	return nil
+}
+
+// Duration returns the duration of a rate limit window.
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// ExceedsQuota returns true if the provided net flow in the given direction exceeds the maximum
+// percentage of the channel value permitted by the quota.
+func (q Quota) ExceedsQuota(direction PacketDirection, netFlow, channelValue sdk.Int) bool {
+	maxPercent := q.MaxPercentSend
+	if direction == PacketRecv {
+		maxPercent = q.MaxPercentRecv
+	}
+
+	threshold := channelValue.Mul(maxPercent).QuoRaw(100)
+	return netFlow.GT(threshold)
+}
+
+func validatePercent(percent sdk.Int) error {
+	if percent.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "percentage cannot be nil")
+	}
+
+	if percent.IsNegative() || percent.GT(sdk.NewInt(100)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "percentage must be between 0 and 100, got %s", percent)
+	}
+
+	return nil
+}
+
+// NewFlow creates a new Flow instance without any inflow or outflow.
+func NewFlow(channelValue sdk.Int) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// Validate performs basic validation of the flow.
+func (f Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "inflow must be non-negative")
+	}
+
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "outflow must be non-negative")
+	}
+
+	if f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "channel value must be non-negative")
+	}
+
+	return nil
+}
+
+// AddInflow adds the provided amount to the inflow. An error is returned if the resulting net
+// inflow exceeds the quota.
+func (f *Flow) AddInflow(amount sdk.Int, quota Quota) error {
+	netInflow := f.Inflow.Add(amount).Sub(f.Outflow)
+	if quota.ExceedsQuota(PacketRecv, netInflow, f.ChannelValue) {
+		return sdkerrors.Wrapf(
+			ErrQuotaExceeded, "net inflow (%s) exceeds %s%% of the channel value (%s)",
+			netInflow, quota.MaxPercentRecv, f.ChannelValue,
+		)
+	}
+
+	f.Inflow = f.Inflow.Add(amount)
+	return nil
+}
+
+// AddOutflow adds the provided amount to the outflow. An error is returned if the resulting net
+// outflow exceeds the quota.
+func (f *Flow) AddOutflow(amount sdk.Int, quota Quota) error {
+	netOutflow := f.Outflow.Add(amount).Sub(f.Inflow)
+	if quota.ExceedsQuota(PacketSend, netOutflow, f.ChannelValue) {
+		return sdkerrors.Wrapf(
+			ErrQuotaExceeded, "net outflow (%s) exceeds %s%% of the channel value (%s)",
+			netOutflow, quota.MaxPercentSend, f.ChannelValue,
+		)
+	}
+
+	f.Outflow = f.Outflow.Add(amount)
+	return nil
+}
+
+// UndoOutflow subtracts the provided amount from the outflow. The outflow will not become negative.
+func (f *Flow) UndoOutflow(amount sdk.Int) {
+	f.Outflow = sdk.MaxInt(f.Outflow.Sub(amount), sdk.ZeroInt())
+}
+
+// NewRateLimit creates a new RateLimit instance.
+func NewRateLimit(path Path, quota Quota, flow Flow, windowStart time.Time) RateLimit {
+	return RateLimit{
+		Path:        path,
+		Quota:       quota,
+		Flow:        flow,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs basic validation of the rate limit.
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	return rl.Flow.Validate()
+}
+
+// IsWindowExpired returns true if the current window of the rate limit has ended at the provided time.
+func (rl RateLimit) IsWindowExpired(blockTime time.Time) bool {
+	return !blockTime.Before(rl.WindowStart.Add(rl.Quota.Duration()))
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance.
+func NewPendingSendPacket(channelID string, sequence uint64, windowStart time.Time) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		WindowStart: windowStart,
+	}
+}
+
+// Validate performs basic validation of the pending send packet.
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPendingSendPacket, err.Error())
+	}
+
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidPendingSendPacket, "sequence cannot be 0")
+	}
+
+	return nil
+}
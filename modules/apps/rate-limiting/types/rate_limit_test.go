@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limiting/types"
+)
+
+func TestPathValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		path    types.Path
+		expPass bool
+	}{
+		{"valid path", types.NewPath("usei", "channel-0"), true},
+		{"valid ibc denom", types.NewPath("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "channel-0"), true},
+		{"invalid denom", types.NewPath("", "channel-0"), false},
+		{"invalid channel", types.NewPath("usei", "(invalid)"), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.path.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.Quota
+		expPass bool
+	}{
+		{"valid quota", types.NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24), true},
+		{"send blocked", types.NewQuota(sdk.ZeroInt(), sdk.NewInt(100), 1), true},
+		{"recv blocked", types.NewQuota(sdk.NewInt(100), sdk.ZeroInt(), 1), true},
+		{"both zero", types.NewQuota(sdk.ZeroInt(), sdk.ZeroInt(), 24), false},
+		{"send above 100", types.NewQuota(sdk.NewInt(101), sdk.NewInt(10), 24), false},
+		{"negative recv", types.NewQuota(sdk.NewInt(10), sdk.NewInt(-1), 24), false},
+		{"nil percent", types.Quota{MaxPercentRecv: sdk.NewInt(10), DurationHours: 24}, false},
+		{"zero duration", types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 0), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.quota.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestFlow(t *testing.T) {
+	// thresholds of 100 sent and 50 received
+	quota := types.NewQuota(sdk.NewInt(10), sdk.NewInt(5), 24)
+	flow := types.NewFlow(sdk.NewInt(1000))
+
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(100), quota))
+	require.ErrorIs(t, flow.AddOutflow(sdk.OneInt(), quota), types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), flow.Outflow)
+
+	// inflow is netted against the outflow
+	require.NoError(t, flow.AddInflow(sdk.NewInt(150), quota))
+	require.ErrorIs(t, flow.AddInflow(sdk.OneInt(), quota), types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(150), flow.Inflow)
+
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(150), quota))
+	require.Equal(t, sdk.NewInt(250), flow.Outflow)
+
+	flow.UndoOutflow(sdk.NewInt(50))
+	require.Equal(t, sdk.NewInt(200), flow.Outflow)
+
+	// the outflow does not become negative
+	flow.UndoOutflow(sdk.NewInt(500))
+	require.True(t, flow.Outflow.IsZero())
+}
+
+func TestFlowBlockedDirection(t *testing.T) {
+	quota := types.NewQuota(sdk.ZeroInt(), sdk.NewInt(10), 24)
+	flow := types.NewFlow(sdk.NewInt(1000))
+
+	require.ErrorIs(t, flow.AddOutflow(sdk.OneInt(), quota), types.ErrQuotaExceeded)
+
+	// tokens which have been received may be sent back
+	require.NoError(t, flow.AddInflow(sdk.NewInt(100), quota))
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(100), quota))
+	require.ErrorIs(t, flow.AddOutflow(sdk.OneInt(), quota), types.ErrQuotaExceeded)
+}
+
+func TestIsWindowExpired(t *testing.T) {
+	windowStart := time.Unix(1_000_000, 0).UTC()
+	rateLimit := types.NewRateLimit(
+		types.NewPath("usei", "channel-0"), types.NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24),
+		types.NewFlow(sdk.NewInt(1000)), windowStart,
+	)
+
+	require.False(t, rateLimit.IsWindowExpired(windowStart))
+	require.False(t, rateLimit.IsWindowExpired(windowStart.Add(24*time.Hour-time.Nanosecond)))
+	require.True(t, rateLimit.IsWindowExpired(windowStart.Add(24*time.Hour)))
+}
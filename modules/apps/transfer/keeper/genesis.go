@@ -29,6 +29,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	k.SetParams(ctx, state.Params)
 
+	for _, denomEscrow := range state.TotalEscrowed {
+		k.SetTotalEscrowForDenom(ctx, denomEscrow)
+	}
+
 	// check if the module account exists
 	moduleAcc := k.GetTransferAccount(ctx)
 	if moduleAcc == nil {
@@ -36,11 +40,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info and the total amount
+// in escrow per denomination into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:        k.GetPort(ctx),
+		DenomTraces:   k.GetAllDenomTraces(ctx),
+		Params:        k.GetParams(ctx),
+		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
 	}
 }
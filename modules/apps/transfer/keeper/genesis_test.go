@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 )
 
@@ -12,6 +14,7 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		traces types.Traces
 	)
 
+	escrow := sdk.NewCoins()
 	for i := 0; i < 5; i++ {
 		prefix := fmt.Sprintf("transfer/channelToChain%d", i)
 		if i == 0 {
@@ -26,14 +29,23 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		}
 		traces = append(types.Traces{denomTrace}, traces...)
 		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+		coin := sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(int64(i+1)*100))
+		escrow = escrow.Add(coin)
+		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 	}
 
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal(escrow, genesis.TotalEscrowed)
 
 	suite.Require().NotPanics(func() {
-		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+		suite.chainB.GetSimApp().TransferKeeper.InitGenesis(suite.chainB.GetContext(), *genesis)
 	})
+
+	for _, coin := range escrow {
+		suite.Require().Equal(coin, suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.Denom))
+	}
 }
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all transfer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom",
+		TotalEscrowPerDenomInvariants(k))
+}
+
+// TotalEscrowPerDenomInvariants checks that the total amount escrowed for each denomination is
+// backed by the balances of the escrow accounts of all transfer channels. The escrow balances may
+// exceed the tracked total, as anyone can send tokens to an escrow address directly, but they must
+// never fall below it. Such a shortfall indicates the escrow has been drained, for instance by a
+// malicious counterparty module returning more tokens than were escrowed.
+func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expectedTotalEscrowed := k.GetAllTotalEscrowed(ctx)
+		actualTotalEscrowed := k.GetAllEscrowBalances(ctx)
+
+		// the actual escrowed amount must be greater than or equal to the expected amount for all denominations
+		if !actualTotalEscrowed.IsAllGTE(expectedTotalEscrowed) {
+			return sdk.FormatInvariant(
+				types.ModuleName,
+				"total escrow per denom invariance",
+				fmt.Sprintf("found denom(s) with total escrow amount lower than expected:\nactual total escrowed: %s\nexpected total escrowed: %s", actualTotalEscrowed, expectedTotalEscrowed)), true
+		}
+
+		return "", false
+	}
+}
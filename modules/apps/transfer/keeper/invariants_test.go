@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success: no tokens in escrow",
+			func() {},
+			false,
+		},
+		{
+			"success: escrowed tokens are backed by the escrow account",
+			func() {
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0)
+
+				_, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"success: escrow account balance exceeds the total escrow",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			},
+			false,
+		},
+		{
+			"failure: total escrow exceeds the escrow account balance",
+			func() {
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			msg, broken := keeper.TotalEscrowPerDenomInvariants(&transferKeeper)(suite.chainA.GetContext())
+
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -162,6 +164,97 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// GetTotalEscrowForDenom gets the total amount of tokens of the provided denomination held in escrow
+// across all transfer channels. If the denomination is not found, a zero coin is returned.
+func (k Keeper) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalEscrowForDenomKey(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+// SetTotalEscrowForDenom stores the total amount of tokens held in escrow for the denomination of
+// the provided coin. The entry is removed from the store if the amount is zero. It panics if the
+// amount is negative.
+func (k Keeper) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {
+	if coin.Amount.IsNegative() {
+		panic(fmt.Sprintf("amount in escrow cannot be negative: %s", coin))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.TotalEscrowForDenomKey(coin.Denom)
+
+	if coin.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(key, bz)
+}
+
+// GetAllTotalEscrowed returns the total amount of tokens held in escrow for all denominations.
+func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	var escrowed sdk.Coins
+	k.IterateTokensInEscrow(ctx, func(coin sdk.Coin) bool {
+		escrowed = escrowed.Add(coin)
+		return false
+	})
+
+	return escrowed
+}
+
+// IterateTokensInEscrow iterates over the total amounts of tokens held in escrow per denomination
+// and performs a callback function.
+func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, cb func(coin sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TotalEscrowForDenomKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.TotalEscrowForDenomKeyPrefix):])
+
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(sdk.NewCoin(denom, amount)) {
+			break
+		}
+	}
+}
+
+// GetAllEscrowBalances returns the sum of the balances of the escrow accounts of all channels
+// bound to the transfer port.
+func (k Keeper) GetAllEscrowBalances(ctx sdk.Context) sdk.Coins {
+	portID := k.GetPort(ctx)
+
+	var balances sdk.Coins
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(portID, channel.ChannelId)
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return balances
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
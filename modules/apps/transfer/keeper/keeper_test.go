@@ -59,3 +59,94 @@ func (suite *KeeperTestSuite) TestGetTransferAccount() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestSetGetTotalEscrowForDenom() {
+	const denom = "atom"
+	var expAmount sdk.Int
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: with non-zero escrow amount",
+			func() {},
+			true,
+		},
+		{
+			"success: with escrow amount > 2^63",
+			func() {
+				expAmount, _ = sdk.NewIntFromString("100000000000000000000")
+			},
+			true,
+		},
+		{
+			"success: escrow amount 0 is not stored",
+			func() {
+				expAmount = sdk.ZeroInt()
+			},
+			true,
+		},
+		{
+			"failure: setter panics with negative escrow amount",
+			func() {
+				expAmount = sdk.NewInt(-1)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expAmount = sdk.NewInt(100)
+			ctx := suite.chainA.GetContext()
+
+			tc.malleate()
+
+			if tc.expPass {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(denom, expAmount))
+				total := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, denom)
+				suite.Require().Equal(expAmount, total.Amount)
+
+				storeKey := suite.chainA.GetSimApp().GetKey(types.ModuleName)
+				store := ctx.KVStore(storeKey)
+				key := types.TotalEscrowForDenomKey(denom)
+				if expAmount.IsZero() {
+					suite.Require().False(store.Has(key))
+				} else {
+					suite.Require().True(store.Has(key))
+				}
+			} else {
+				suite.Require().Panics(func() {
+					suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.Coin{Denom: denom, Amount: expAmount})
+				})
+				total := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, denom)
+				suite.Require().Equal(sdk.ZeroInt(), total.Amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetAllTotalEscrowed() {
+	ctx := suite.chainA.GetContext()
+	expEscrowed := sdk.NewCoins(
+		sdk.NewCoin("atom", sdk.NewInt(100)),
+		sdk.NewCoin("osmo", sdk.NewInt(50)),
+		sdk.NewCoin("usei", sdk.NewInt(25)),
+	)
+
+	for _, coin := range expEscrowed {
+		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, coin)
+	}
+
+	suite.Require().Equal(expEscrowed, suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(ctx))
+
+	// zero amounts are removed from the store
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin("osmo", sdk.ZeroInt()))
+	suite.Require().Equal(expEscrowed.Sub(sdk.NewCoins(sdk.NewCoin("osmo", sdk.NewInt(50)))), suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(ctx))
+}
@@ -53,6 +53,33 @@ func (m Migrator) MigrateTraces(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateTotalEscrowForDenom sets the total amount in escrow for each denomination from the
+// balances of the escrow accounts of all channels bound to the transfer port.
+func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
+	totalEscrowed := m.keeper.GetAllEscrowBalances(ctx)
+	for _, escrow := range totalEscrowed {
+		m.keeper.SetTotalEscrowForDenom(ctx, escrow)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully set total escrow for denominations", "total escrowed", totalEscrowed.String())
+	return nil
+}
+
+// MigrateParams sets the channel overrides and the send and receive denomination blocklists
+// introduced to the transfer parameters to their defaults. The existing send and receive
+// enabled parameters are retained.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	params := types.NewParams(m.keeper.GetSendEnabled(ctx), m.keeper.GetReceiveEnabled(ctx), nil, nil, nil)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+
+	m.keeper.Logger(ctx).Info("successfully migrated transfer params", "send enabled", params.SendEnabled, "receive enabled", params.ReceiveEnabled)
+	return nil
+}
+
 func equalTraces(dtA, dtB types.DenomTrace) bool {
 	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
 }
@@ -3,8 +3,14 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
 	transferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
 
 func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
@@ -119,3 +125,95 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTracesCorruptionDetection() {
 		migrator.MigrateTraces(suite.chainA.GetContext())
 	})
 }
+
+func (suite *KeeperTestSuite) TestMigrateTotalEscrowForDenom() {
+	var (
+		path              *ibctesting.Path
+		expectedEscrowAmt sdk.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: one native denom escrowed in one channel",
+			func() {
+				escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowedCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				// funds the escrow account to have balance
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, sdk.NewCoins(escrowedCoin)))
+			},
+		},
+		{
+			"success: one native denom escrowed in two channels",
+			func() {
+				extraPath := NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(extraPath)
+
+				escrowAddress1 := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowAddress2 := transfertypes.GetEscrowAddress(extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID)
+				escrowedCoin1 := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				escrowedCoin2 := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				// funds the escrow accounts to have balance
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress1, sdk.NewCoins(escrowedCoin1)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress2, sdk.NewCoins(escrowedCoin2)))
+
+				expectedEscrowAmt = sdk.NewInt(200)
+			},
+		},
+		{
+			"success: escrow account of a channel on another port is ignored",
+			func() {
+				escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				otherEscrowAddress := transfertypes.GetEscrowAddress(ibctesting.MockPort, path.EndpointA.ChannelID)
+				escrowedCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrowAddress, sdk.NewCoins(escrowedCoin)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), otherEscrowAddress, sdk.NewCoins(escrowedCoin)))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			expectedEscrowAmt = sdk.NewInt(100)
+
+			tc.malleate() // explicitly fund escrow account
+
+			migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			suite.Require().NoError(migrator.MigrateTotalEscrowForDenom(suite.chainA.GetContext()))
+
+			// check that the migration set the expected amount for the denom
+			amount := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+			suite.Require().Equal(expectedEscrowAmt, amount.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(ctx, transfertypes.NewParams(false, true, nil, nil, nil))
+
+	// remove the parameters introduced in consensus version 4 to mimic the previous parameter set
+	paramsStore := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(transfertypes.ModuleName+"/"))
+	paramsStore.Delete(transfertypes.KeyChannelOverrides)
+	paramsStore.Delete(transfertypes.KeySendBlockedDenoms)
+	paramsStore.Delete(transfertypes.KeyReceiveBlockedDenoms)
+
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().TransferKeeper.GetParams(ctx)
+	})
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.MigrateParams(ctx))
+
+	params := suite.chainA.GetSimApp().TransferKeeper.GetParams(ctx)
+	suite.Require().Equal(transfertypes.NewParams(false, true, nil, nil, nil), params)
+}
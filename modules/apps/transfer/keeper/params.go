@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 )
@@ -20,12 +21,104 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetChannelOverrides retrieves the channel overrides from the paramstore
+func (k Keeper) GetChannelOverrides(ctx sdk.Context) []types.ChannelOverride {
+	var res []types.ChannelOverride
+	k.paramSpace.Get(ctx, types.KeyChannelOverrides, &res)
+	return res
+}
+
+// GetSendBlockedDenoms retrieves the denominations which cannot be sent from the paramstore
+func (k Keeper) GetSendBlockedDenoms(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeySendBlockedDenoms, &res)
+	return res
+}
+
+// GetReceiveBlockedDenoms retrieves the denominations which cannot be received from the paramstore
+func (k Keeper) GetReceiveBlockedDenoms(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyReceiveBlockedDenoms, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	return types.NewParams(
+		k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.GetChannelOverrides(ctx),
+		k.GetSendBlockedDenoms(ctx), k.GetReceiveBlockedDenoms(ctx),
+	)
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// checkSendEnabled returns an error if the transfer parameters disable sending the denomination
+// over the provided channel. A channel override takes precedence over the global send enabled
+// parameter. An event naming the rule which blocked the transfer is emitted.
+func (k Keeper) checkSendEnabled(ctx sdk.Context, channelID, denom string) error {
+	params := k.GetParams(ctx)
+
+	enabled, rule := params.SendEnabled, types.RuleSendDisabled
+	if override, found := params.GetChannelOverride(channelID); found {
+		enabled, rule = override.SendEnabled, types.RuleChannelSendDisabled
+	}
+
+	if enabled && containsDenom(params.SendBlockedDenoms, denom) {
+		enabled, rule = false, types.RuleSendBlockedDenom
+	}
+
+	if !enabled {
+		emitTransferBlockedEvent(ctx, rule, channelID, denom)
+		return sdkerrors.Wrapf(types.ErrSendDisabled, "blocked by rule %s for channel %s and denom %s", rule, channelID, denom)
+	}
+
+	return nil
+}
+
+// checkReceiveEnabled returns an error if the transfer parameters disable receiving the denomination
+// over the provided channel. A channel override takes precedence over the global receive enabled
+// parameter. An event naming the rule which blocked the transfer is emitted.
+func (k Keeper) checkReceiveEnabled(ctx sdk.Context, channelID, denom string) error {
+	params := k.GetParams(ctx)
+
+	enabled, rule := params.ReceiveEnabled, types.RuleReceiveDisabled
+	if override, found := params.GetChannelOverride(channelID); found {
+		enabled, rule = override.ReceiveEnabled, types.RuleChannelReceiveDisabled
+	}
+
+	if enabled && containsDenom(params.ReceiveBlockedDenoms, denom) {
+		enabled, rule = false, types.RuleReceiveBlockedDenom
+	}
+
+	if !enabled {
+		emitTransferBlockedEvent(ctx, rule, channelID, denom)
+		return sdkerrors.Wrapf(types.ErrReceiveDisabled, "blocked by rule %s for channel %s and denom %s", rule, channelID, denom)
+	}
+
+	return nil
+}
+
+func containsDenom(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+func emitTransferBlockedEvent(ctx sdk.Context, rule, channelID, denom string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlocked,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRule, rule),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+}
@@ -86,8 +86,8 @@ func (k Keeper) sendTransfer(
 	timeoutTimestamp uint64,
 	memo string,
 ) (uint64, error) {
-	if !k.GetSendEnabled(ctx) {
-		return 0, types.ErrSendDisabled
+	if err := k.checkSendEnabled(ctx, sourceChannel, token.Denom); err != nil {
+		return 0, err
 	}
 
 	if !k.bankKeeper.IsSendEnabledCoin(ctx, token) {
@@ -158,6 +158,11 @@ func (k Keeper) sendTransfer(
 			return 0, err
 		}
 
+		// track the total amount in escrow keyed by denomination
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		newTotalEscrow := currentTotalEscrow.Add(token)
+		k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
+
 	} else {
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
 
@@ -228,8 +233,8 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
-	if !k.GetReceiveEnabled(ctx) {
-		return types.ErrReceiveDisabled
+	if err := k.checkReceiveEnabled(ctx, packet.GetDestChannel(), receivedDenom(packet, data)); err != nil {
+		return err
 	}
 
 	// decode the receiver address
@@ -289,6 +294,11 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		// track the total amount in escrow keyed by denomination
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		newTotalEscrow := currentTotalEscrow.Sub(token)
+		k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
+
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -425,6 +435,11 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		// track the total amount in escrow keyed by denomination
+		currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		newTotalEscrow := currentTotalEscrow.Sub(token)
+		k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
+
 		return nil
 	}
 
@@ -461,3 +476,16 @@ func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	fullDenomPath := denomTrace.GetFullDenomPath()
 	return fullDenomPath, nil
 }
+
+// receivedDenom returns the denomination the tokens of the provided packet are received as on
+// this chain. Native denominations are returned as is and all other denominations are returned
+// as the hash of their denomination trace.
+func receivedDenom(packet channeltypes.Packet, data types.FungibleTokenPacketData) string {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return types.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	return types.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+}
@@ -76,6 +76,57 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				sender = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
 			}, true, false,
 		},
+		{
+			"transfer failed - send disabled",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, nil, nil, nil))
+			}, true, false,
+		},
+		{
+			"successful transfer - channel override enables send",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				overrides := []types.ChannelOverride{types.NewChannelOverride(path.EndpointA.ChannelID, true, false)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, overrides, nil, nil))
+			}, true, true,
+		},
+		{
+			"transfer failed - channel override disables send",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				overrides := []types.ChannelOverride{types.NewChannelOverride(path.EndpointA.ChannelID, false, true)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, overrides, nil, nil))
+			}, true, false,
+		},
+		{
+			"successful transfer - channel override for another channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				overrides := []types.ChannelOverride{types.NewChannelOverride("channel-100", false, false)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, overrides, nil, nil))
+			}, true, true,
+		},
+		{
+			"transfer failed - denom blocked for send",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, nil, []string{sdk.DefaultBondDenom}, nil))
+			}, true, false,
+		},
+		{
+			"transfer failed - voucher denom blocked for send",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, nil, []string{amount.Denom}, nil))
+			}, false, false,
+		},
 		// createOutgoingPacket tests
 		// - source chain
 		{
@@ -144,10 +195,21 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				sender, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0,
 			)
 
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), amount.Denom)
+
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				if tc.sendFromSource {
+					// escrowed tokens are tracked
+					suite.Require().Equal(amount, totalEscrow)
+				} else {
+					// vouchers are burned and not tracked
+					suite.Require().True(totalEscrow.IsZero())
+				}
 			} else {
 				suite.Require().Error(err)
+				suite.Require().True(totalEscrow.IsZero())
 			}
 		})
 	}
@@ -159,6 +221,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 // malleate function allows for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	var (
+		path     *ibctesting.Path
 		trace    types.DenomTrace
 		amount   sdk.Int
 		receiver string
@@ -207,6 +270,26 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"failure: receive on module account on source chain", func() {
 			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
 		}, true, false},
+
+		// - transfer params
+		{"failure: receive disabled", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, nil, nil, nil))
+		}, false, false},
+		{"success: channel override enables receive", func() {
+			overrides := []types.ChannelOverride{types.NewChannelOverride(path.EndpointB.ChannelID, false, true)}
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, overrides, nil, nil))
+		}, false, true},
+		{"failure: channel override disables receive", func() {
+			overrides := []types.ChannelOverride{types.NewChannelOverride(path.EndpointB.ChannelID, true, false)}
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, overrides, nil, nil))
+		}, true, false},
+		{"failure: native denom blocked for receive", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, nil, nil, []string{sdk.DefaultBondDenom}))
+		}, true, false},
+		{"failure: voucher denom blocked for receive", func() {
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, nil, nil, []string{voucherDenom}))
+		}, false, false},
 	}
 
 	for _, tc := range testCases {
@@ -215,7 +298,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := NewTransferPath(suite.chainA, suite.chainB)
+			path = NewTransferPath(suite.chainA, suite.chainB)
 			suite.coordinator.Setup(path)
 			receiver = suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
 
@@ -258,10 +341,18 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
 
+			// the tokens escrowed on chainB are unescrowed when received back from chainA
+			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
+
 			if tc.expPass {
 				suite.Require().NoError(err)
+				suite.Require().True(totalEscrow.IsZero())
 			} else {
 				suite.Require().Error(err)
+
+				if tc.recvIsSource {
+					suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), totalEscrow)
+				}
 			}
 		})
 	}
@@ -296,6 +387,9 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+
+			// set escrow amount that would have been stored after successful execution of MsgTransfer
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 		}, false, true},
 		{
 			"unsuccessful refund from source", failedAck,
@@ -343,6 +437,10 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 					suite.Require().Equal(amount, deltaAmount, "failed ack did not trigger refund")
 				}
 
+				// refunded tokens are no longer tracked as escrowed
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+				suite.Require().True(totalEscrow.IsZero())
+
 			} else {
 				suite.Require().Error(err)
 			}
@@ -375,6 +473,9 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+
+				// set escrow amount that would have been stored after successful execution of MsgTransfer
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 			}, true,
 		},
 		{
@@ -435,9 +536,101 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().Equal(amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
+
+				// refunded tokens are no longer tracked as escrowed
+				totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
+				suite.Require().True(totalEscrow.IsZero())
 			} else {
 				suite.Require().Error(err)
 			}
 		})
 	}
 }
+
+// TestTransferBlockedEvents tests that transfers blocked by the transfer parameters return
+// the expected error and emit an event naming the rule which blocked the transfer.
+func (suite *KeeperTestSuite) TestTransferBlockedEvents() {
+	var (
+		path   *ibctesting.Path
+		params types.Params
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		send     bool
+		expRule  string
+	}{
+		{"send disabled", func() {
+			params = types.NewParams(false, true, nil, nil, nil)
+		}, true, types.RuleSendDisabled},
+		{"channel send disabled", func() {
+			params = types.NewParams(true, true, []types.ChannelOverride{types.NewChannelOverride(path.EndpointA.ChannelID, false, true)}, nil, nil)
+		}, true, types.RuleChannelSendDisabled},
+		{"send blocked denom", func() {
+			params = types.NewParams(true, true, nil, []string{sdk.DefaultBondDenom}, nil)
+		}, true, types.RuleSendBlockedDenom},
+		{"receive disabled", func() {
+			params = types.NewParams(true, false, nil, nil, nil)
+		}, false, types.RuleReceiveDisabled},
+		{"channel receive disabled", func() {
+			params = types.NewParams(true, true, []types.ChannelOverride{types.NewChannelOverride(path.EndpointA.ChannelID, true, false)}, nil, nil)
+		}, false, types.RuleChannelReceiveDisabled},
+		{"receive blocked denom", func() {
+			params = types.NewParams(true, true, nil, nil, []string{sdk.DefaultBondDenom})
+		}, false, types.RuleReceiveBlockedDenom},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(ctx, params)
+
+			var err error
+			if tc.send {
+				err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+					ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+					suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0,
+				)
+				suite.Require().ErrorIs(err, types.ErrSendDisabled)
+			} else {
+				// a packet returning native tokens of chainA over the channel
+				data := types.NewFungibleTokenPacketData(
+					types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom), "100",
+					suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+				)
+				packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+				err = suite.chainA.GetSimApp().TransferKeeper.OnRecvPacket(ctx, packet, data)
+				suite.Require().ErrorIs(err, types.ErrReceiveDisabled)
+			}
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeBlocked {
+					continue
+				}
+
+				found = true
+				attributes := make(map[string]string)
+				for _, attr := range event.Attributes {
+					attributes[string(attr.Key)] = string(attr.Value)
+				}
+
+				suite.Require().Equal(tc.expRule, attributes[types.AttributeKeyRule])
+				suite.Require().Equal(path.EndpointA.ChannelID, attributes[types.AttributeKeyChannelID])
+				suite.Require().Equal(sdk.DefaultBondDenom, attributes[types.AttributeKeyDenom])
+			}
+			suite.Require().True(found)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/stretchr/testify/require"
 
@@ -21,6 +22,9 @@ func TestDecodeStore(t *testing.T) {
 		Path:      "transfer/channelToA",
 	}
 
+	escrowAmount, err := sdk.NewInt(100).Marshal()
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
@@ -31,6 +35,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.TotalEscrowForDenomKey("uatom"),
+				Value: escrowAmount,
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +51,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"TotalEscrow", "TotalEscrow A: 100uatom\nTotalEscrow B: 100uatom"},
 		{"other", ""},
 	}
 
@@ -7,6 +7,7 @@ const (
 	EventTypeTransfer     = "ibc_transfer"
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeBlocked      = "transfer_blocked"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -19,4 +20,16 @@ const (
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
 	AttributeKeyMemo           = "memo"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyRule           = "rule"
+)
+
+// Rules of the transfer parameters which may block a transfer
+const (
+	RuleSendDisabled           = "send_disabled"
+	RuleReceiveDisabled        = "receive_disabled"
+	RuleChannelSendDisabled    = "channel_send_disabled"
+	RuleChannelReceiveDisabled = "channel_receive_disabled"
+	RuleSendBlockedDenom       = "send_blocked_denom"
+	RuleReceiveBlockedDenom    = "receive_blocked_denom"
 )
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -33,6 +34,21 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid total escrowed",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))),
+			true,
+		},
+		{
+			"invalid total escrowed: negative amount",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-100)}}),
+			false,
+		},
+		{
+			"invalid total escrowed: duplicate denom",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewCoin("uatom", sdk.NewInt(100)), sdk.NewCoin("uatom", sdk.NewInt(100))}),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -36,8 +36,16 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// TotalEscrowForDenomKeyPrefix defines the key prefix to store the total amount escrowed per denomination in store
+	TotalEscrowForDenomKeyPrefix = []byte{0x03}
 )
 
+// TotalEscrowForDenomKey returns the store key under which the total amount of tokens in escrow
+// for the provided denomination is stored.
+func TotalEscrowForDenomKey(denom string) []byte {
+	return append(append([]byte{}, TotalEscrowForDenomKeyPrefix...), []byte(denom)...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -3,7 +3,10 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 const (
@@ -18,6 +21,12 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyChannelOverrides is store's key for ChannelOverrides Params
+	KeyChannelOverrides = []byte("ChannelOverrides")
+	// KeySendBlockedDenoms is store's key for SendBlockedDenoms Params
+	KeySendBlockedDenoms = []byte("SendBlockedDenoms")
+	// KeyReceiveBlockedDenoms is store's key for ReceiveBlockedDenoms Params
+	KeyReceiveBlockedDenoms = []byte("ReceiveBlockedDenoms")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,16 +35,31 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(
+	enableSend, enableReceive bool, channelOverrides []ChannelOverride,
+	sendBlockedDenoms, receiveBlockedDenoms []string,
+) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:          enableSend,
+		ReceiveEnabled:       enableReceive,
+		ChannelOverrides:     channelOverrides,
+		SendBlockedDenoms:    sendBlockedDenoms,
+		ReceiveBlockedDenoms: receiveBlockedDenoms,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, nil, nil, nil)
+}
+
+// NewChannelOverride creates a new ChannelOverride instance.
+func NewChannelOverride(channelID string, enableSend, enableReceive bool) ChannelOverride {
+	return ChannelOverride{
+		ChannelId:      channelID,
+		SendEnabled:    enableSend,
+		ReceiveEnabled: enableReceive,
+	}
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +68,24 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	if err := validateChannelOverrides(p.ChannelOverrides); err != nil {
+		return err
+	}
+
+	if err := validateBlockedDenoms(p.SendBlockedDenoms); err != nil {
+		return err
+	}
+
+	return validateBlockedDenoms(p.ReceiveBlockedDenoms)
+}
+
+// GetChannelOverride returns the override of the provided channel if it exists.
+func (p Params) GetChannelOverride(channelID string) (ChannelOverride, bool) {
+	return getChannelOverride(p.ChannelOverrides, channelID)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,9 +93,22 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyChannelOverrides, &p.ChannelOverrides, validateChannelOverrides),
+		paramtypes.NewParamSetPair(KeySendBlockedDenoms, &p.SendBlockedDenoms, validateBlockedDenoms),
+		paramtypes.NewParamSetPair(KeyReceiveBlockedDenoms, &p.ReceiveBlockedDenoms, validateBlockedDenoms),
 	}
 }
 
+func getChannelOverride(channelOverrides []ChannelOverride, channelID string) (ChannelOverride, bool) {
+	for _, override := range channelOverrides {
+		if override.ChannelId == channelID {
+			return override, true
+		}
+	}
+
+	return ChannelOverride{}, false
+}
+
 func validateEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -63,3 +117,45 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateChannelOverrides(i interface{}) error {
+	channelOverrides, ok := i.([]ChannelOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, override := range channelOverrides {
+		if err := host.ChannelIdentifierValidator(override.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel override: %w", err)
+		}
+
+		if seen[override.ChannelId] {
+			return fmt.Errorf("duplicate channel override for channel %s", override.ChannelId)
+		}
+		seen[override.ChannelId] = true
+	}
+
+	return nil
+}
+
+func validateBlockedDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid blocked denom: %w", err)
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate blocked denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
@@ -7,6 +7,47 @@ import (
 )
 
 func TestValidateParams(t *testing.T) {
-	require.NoError(t, DefaultParams().Validate())
-	require.NoError(t, NewParams(true, false).Validate())
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default params", DefaultParams(), true},
+		{"send enabled, receive disabled", NewParams(true, false, nil, nil, nil), true},
+		{
+			"valid channel overrides and blocked denoms",
+			NewParams(true, true,
+				[]ChannelOverride{NewChannelOverride("channel-0", false, true), NewChannelOverride("channel-1", true, false)},
+				[]string{"uatom", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"},
+				[]string{"stake"},
+			),
+			true,
+		},
+		{"invalid channel override identifier", NewParams(true, true, []ChannelOverride{NewChannelOverride("channel", true, true)}, nil, nil), false},
+		{"duplicate channel override", NewParams(true, true, []ChannelOverride{NewChannelOverride("channel-0", true, true), NewChannelOverride("channel-0", false, false)}, nil, nil), false},
+		{"invalid send blocked denom", NewParams(true, true, nil, []string{"1atom"}, nil), false},
+		{"duplicate send blocked denom", NewParams(true, true, nil, []string{"uatom", "uatom"}, nil), false},
+		{"invalid receive blocked denom", NewParams(true, true, nil, nil, []string{""}), false},
+		{"duplicate receive blocked denom", NewParams(true, true, nil, nil, []string{"stake", "stake"}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestGetChannelOverride(t *testing.T) {
+	params := NewParams(true, true, []ChannelOverride{NewChannelOverride("channel-0", false, true)}, nil, nil)
+
+	override, found := params.GetChannelOverride("channel-0")
+	require.True(t, found)
+	require.Equal(t, NewChannelOverride("channel-0", false, true), override)
+
+	_, found = params.GetChannelOverride("channel-1")
+	require.False(t, found)
 }
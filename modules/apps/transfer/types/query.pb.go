@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -505,6 +506,98 @@ func (m *QueryEscrowAddressResponse) GetEscrowAddress() string {
 	return ""
 }
 
+// QueryTotalEscrowForDenomRequest is the request type for TotalEscrowForDenom RPC method.
+type QueryTotalEscrowForDenomRequest struct {
+	// denomination of the escrowed tokens, either a native denom or an ibc denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTotalEscrowForDenomRequest) Reset()         { *m = QueryTotalEscrowForDenomRequest{} }
+func (m *QueryTotalEscrowForDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalEscrowForDenomRequest) ProtoMessage()    {}
+func (*QueryTotalEscrowForDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{10}
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalEscrowForDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalEscrowForDenomRequest.Merge(m, src)
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalEscrowForDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalEscrowForDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalEscrowForDenomRequest proto.InternalMessageInfo
+
+func (m *QueryTotalEscrowForDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryTotalEscrowForDenomResponse is the response type for TotalEscrowForDenom RPC method.
+type QueryTotalEscrowForDenomResponse struct {
+	// the total amount of tokens of the given denom held in escrow
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Reset()         { *m = QueryTotalEscrowForDenomResponse{} }
+func (m *QueryTotalEscrowForDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalEscrowForDenomResponse) ProtoMessage()    {}
+func (*QueryTotalEscrowForDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{11}
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalEscrowForDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalEscrowForDenomResponse.Merge(m, src)
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalEscrowForDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalEscrowForDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalEscrowForDenomResponse proto.InternalMessageInfo
+
+func (m *QueryTotalEscrowForDenomResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryDenomTraceRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTraceRequest")
 	proto.RegisterType((*QueryDenomTraceResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTraceResponse")
@@ -516,6 +609,8 @@ func init() {
 	proto.RegisterType((*QueryDenomHashResponse)(nil), "ibc.applications.transfer.v1.QueryDenomHashResponse")
 	proto.RegisterType((*QueryEscrowAddressRequest)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressRequest")
 	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
+	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
+	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
 }
 
 func init() {
@@ -523,52 +618,59 @@ func init() {
 }
 
 var fileDescriptor_a638e2800a01538c = []byte{
-	// 715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xfb, 0x7d, 0x0d, 0xca, 0x0d, 0xed, 0x62, 0x28, 0xb4, 0x58, 0xc5, 0xad, 0xac, 0x02,
-	0xa5, 0x3f, 0x1e, 0xd2, 0x16, 0xca, 0x82, 0x0d, 0xe5, 0xb7, 0x88, 0x45, 0x9b, 0xb2, 0x82, 0x45,
-	0x35, 0xb6, 0x07, 0xc7, 0x52, 0xe2, 0x71, 0x3d, 0x4e, 0x50, 0x55, 0x65, 0xc3, 0x13, 0x20, 0xf5,
-	0x25, 0x50, 0xc5, 0x43, 0xb0, 0xec, 0xb2, 0x12, 0x12, 0x62, 0x05, 0xa8, 0xe5, 0x35, 0x90, 0x90,
-	0x67, 0x26, 0x89, 0x4d, 0xa3, 0x34, 0xde, 0x8d, 0x67, 0xee, 0xb9, 0xf7, 0x9c, 0x73, 0xef, 0x95,
-	0x61, 0xde, 0xb7, 0x1d, 0x4c, 0xc2, 0xb0, 0xee, 0x3b, 0x24, 0xf6, 0x59, 0xc0, 0x71, 0x1c, 0x91,
-	0x80, 0xbf, 0xa3, 0x11, 0x6e, 0x55, 0xf0, 0x5e, 0x93, 0x46, 0xfb, 0x56, 0x18, 0xb1, 0x98, 0xa1,
-	0x69, 0xdf, 0x76, 0xac, 0x74, 0xa4, 0xd5, 0x89, 0xb4, 0x5a, 0x15, 0x7d, 0xc2, 0x63, 0x1e, 0x13,
-	0x81, 0x38, 0x39, 0x49, 0x8c, 0xbe, 0xe0, 0x30, 0xde, 0x60, 0x1c, 0xdb, 0x84, 0x53, 0x99, 0x0c,
-	0xb7, 0x2a, 0x36, 0x8d, 0x49, 0x05, 0x87, 0xc4, 0xf3, 0x03, 0x91, 0x48, 0xc5, 0x2e, 0x0e, 0x64,
-	0xd2, 0xad, 0x25, 0x83, 0xa7, 0x3d, 0xc6, 0xbc, 0x3a, 0xc5, 0x24, 0xf4, 0x31, 0x09, 0x02, 0x16,
-	0x2b, 0x4a, 0xe2, 0xd5, 0x5c, 0x82, 0x6b, 0xdb, 0x49, 0xb1, 0x27, 0x34, 0x60, 0x8d, 0xd7, 0x11,
-	0x71, 0x68, 0x95, 0xee, 0x35, 0x29, 0x8f, 0x11, 0x82, 0xff, 0x6b, 0x84, 0xd7, 0xa6, 0xb4, 0x59,
-	0x6d, 0xbe, 0x54, 0x15, 0x67, 0xd3, 0x85, 0xc9, 0x73, 0xd1, 0x3c, 0x64, 0x01, 0xa7, 0x68, 0x13,
-	0xca, 0x6e, 0x72, 0xbb, 0x1b, 0x27, 0xd7, 0x02, 0x55, 0x5e, 0x99, 0xb7, 0x06, 0x39, 0x61, 0xa5,
-	0xd2, 0x80, 0xdb, 0x3d, 0x9b, 0xe4, 0x5c, 0x15, 0xde, 0x21, 0xf5, 0x0c, 0xa0, 0xe7, 0x86, 0x2a,
-	0x72, 0xcb, 0x92, 0xd6, 0x59, 0x89, 0x75, 0x96, 0xec, 0x83, 0xb2, 0xce, 0xda, 0x22, 0x5e, 0x47,
-	0x50, 0x35, 0x85, 0x34, 0xbf, 0x68, 0x30, 0x75, 0xbe, 0x86, 0x92, 0xf2, 0x16, 0x2e, 0xa7, 0xa4,
-	0xf0, 0x29, 0x6d, 0xf6, 0xbf, 0x3c, 0x5a, 0x36, 0xc6, 0x8f, 0x7f, 0xcc, 0x14, 0x8e, 0x7e, 0xce,
-	0x14, 0x55, 0xde, 0x72, 0x4f, 0x1b, 0x47, 0xcf, 0x33, 0x0a, 0x46, 0x84, 0x82, 0xdb, 0x17, 0x2a,
-	0x90, 0xcc, 0x32, 0x12, 0x26, 0x00, 0x09, 0x05, 0x5b, 0x24, 0x22, 0x8d, 0x8e, 0x41, 0xe6, 0x0e,
-	0x5c, 0xc9, 0xdc, 0x2a, 0x49, 0x0f, 0xa1, 0x18, 0x8a, 0x1b, 0xe5, 0xd9, 0xdc, 0x60, 0x31, 0x0a,
-	0xad, 0x30, 0xe6, 0x32, 0x5c, 0xed, 0x99, 0xf5, 0x82, 0xf0, 0x5a, 0xa7, 0x1d, 0x13, 0x30, 0xda,
-	0x6b, 0x77, 0xa9, 0x2a, 0x3f, 0xb2, 0x33, 0x25, 0xc3, 0x15, 0x8d, 0x7e, 0x33, 0xb5, 0x03, 0xd7,
-	0x45, 0xf4, 0x53, 0xee, 0x44, 0xec, 0xfd, 0x23, 0xd7, 0x8d, 0x28, 0xef, 0xf6, 0x7b, 0x12, 0x2e,
-	0x85, 0x2c, 0x8a, 0x77, 0x7d, 0x57, 0x61, 0x8a, 0xc9, 0xe7, 0xa6, 0x8b, 0x6e, 0x00, 0x38, 0x35,
-	0x12, 0x04, 0xb4, 0x9e, 0xbc, 0x8d, 0x88, 0xb7, 0x92, 0xba, 0xd9, 0x74, 0xcd, 0xc7, 0xa0, 0xf7,
-	0x4b, 0xaa, 0x68, 0xdc, 0x84, 0x71, 0x2a, 0x1e, 0x76, 0x89, 0x7c, 0x51, 0xc9, 0xc7, 0x68, 0x3a,
-	0x7c, 0xe5, 0x4f, 0x11, 0x46, 0x45, 0x16, 0xf4, 0x59, 0x03, 0xe8, 0x35, 0x18, 0xad, 0x0d, 0x76,
-	0xaf, 0xff, 0x42, 0xe9, 0xf7, 0x72, 0xa2, 0x24, 0x59, 0xb3, 0xf2, 0xe1, 0xeb, 0xef, 0xc3, 0x91,
-	0x45, 0x74, 0x07, 0xab, 0xad, 0xcf, 0x6e, 0x7b, 0x7a, 0x52, 0xf1, 0x41, 0xe2, 0x68, 0x1b, 0x7d,
-	0xd2, 0xa0, 0x9c, 0x1a, 0x6c, 0x94, 0xaf, 0x72, 0xc7, 0x7c, 0xfd, 0x7e, 0x5e, 0x98, 0x62, 0xbc,
-	0x20, 0x18, 0xcf, 0x21, 0xf3, 0x62, 0xc6, 0xe8, 0x50, 0x83, 0xa2, 0x9c, 0x36, 0x74, 0x77, 0x88,
-	0x72, 0x99, 0x61, 0xd7, 0x2b, 0x39, 0x10, 0x8a, 0xdb, 0x9c, 0xe0, 0x66, 0xa0, 0xe9, 0xfe, 0xdc,
-	0xe4, 0xc0, 0xa3, 0x23, 0x0d, 0x4a, 0xdd, 0xe9, 0x45, 0xab, 0xc3, 0xfa, 0x90, 0x5a, 0x0d, 0x7d,
-	0x2d, 0x1f, 0x48, 0xd1, 0x5b, 0x11, 0xf4, 0x96, 0xd0, 0xc2, 0x20, 0xeb, 0x92, 0x26, 0x27, 0xcd,
-	0x16, 0x16, 0xb6, 0xd1, 0x37, 0x0d, 0xc6, 0x32, 0x73, 0x8e, 0xd6, 0x87, 0xa8, 0xdd, 0x6f, 0xdd,
-	0xf4, 0x07, 0xf9, 0x81, 0x8a, 0x78, 0x55, 0x10, 0x7f, 0x85, 0x5e, 0xf6, 0x27, 0xae, 0x36, 0x93,
-	0xe3, 0x83, 0xde, 0xd6, 0xb6, 0x71, 0xb2, 0xcb, 0x1c, 0x1f, 0xa8, 0x0d, 0x6f, 0xe3, 0xec, 0x52,
-	0x6e, 0x6c, 0x1f, 0x9f, 0x1a, 0xda, 0xc9, 0xa9, 0xa1, 0xfd, 0x3a, 0x35, 0xb4, 0x8f, 0x67, 0x46,
-	0xe1, 0xe4, 0xcc, 0x28, 0x7c, 0x3f, 0x33, 0x0a, 0x6f, 0xd6, 0x3d, 0x3f, 0xae, 0x35, 0x6d, 0xcb,
-	0x61, 0x0d, 0xac, 0xfe, 0x9b, 0xbe, 0xed, 0x2c, 0x7b, 0x0c, 0xb7, 0x56, 0x71, 0x83, 0xb9, 0xcd,
-	0x3a, 0xe5, 0xff, 0x90, 0x88, 0xf7, 0x43, 0xca, 0xed, 0xa2, 0xf8, 0xeb, 0xad, 0xfe, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x32, 0xbb, 0x42, 0xe2, 0xcc, 0x07, 0x00, 0x00,
+	// 824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x29, 0xa4, 0xcd, 0x4b, 0xe1, 0x30, 0xd0, 0x02, 0x16, 0x35, 0xc8, 0xa2, 0x2d, 0x0d,
+	0xe0, 0x69, 0x80, 0x92, 0x1e, 0xa0, 0x52, 0x81, 0xd2, 0x52, 0xf5, 0x00, 0x81, 0x53, 0x39, 0x44,
+	0x13, 0x7b, 0xea, 0x58, 0x4a, 0x3c, 0xc6, 0xe3, 0xa4, 0x42, 0x51, 0x2e, 0xfd, 0x05, 0x95, 0xf8,
+	0x13, 0x15, 0xea, 0x8f, 0xe8, 0x91, 0x23, 0xa2, 0xd2, 0x6a, 0x4f, 0xbb, 0x2b, 0xd8, 0x1f, 0xb2,
+	0xf2, 0x78, 0x9c, 0xd8, 0x4b, 0x08, 0xc9, 0x9e, 0xe2, 0x99, 0x79, 0xdf, 0x9b, 0xef, 0xfb, 0xde,
+	0xbc, 0xa7, 0xc0, 0x8a, 0x53, 0x35, 0x31, 0xf1, 0xbc, 0xba, 0x63, 0x92, 0xc0, 0x61, 0x2e, 0xc7,
+	0x81, 0x4f, 0x5c, 0xfe, 0x07, 0xf5, 0x71, 0xab, 0x88, 0x2f, 0x9a, 0xd4, 0xbf, 0x34, 0x3c, 0x9f,
+	0x05, 0x0c, 0x2d, 0x38, 0x55, 0xd3, 0x48, 0x46, 0x1a, 0x71, 0xa4, 0xd1, 0x2a, 0xaa, 0x33, 0x36,
+	0xb3, 0x99, 0x08, 0xc4, 0xe1, 0x57, 0x84, 0x51, 0x0b, 0x26, 0xe3, 0x0d, 0xc6, 0x71, 0x95, 0x70,
+	0x1a, 0x25, 0xc3, 0xad, 0x62, 0x95, 0x06, 0xa4, 0x88, 0x3d, 0x62, 0x3b, 0xae, 0x48, 0x24, 0x63,
+	0xb5, 0x64, 0x6c, 0x1c, 0x65, 0x32, 0x27, 0x3e, 0x5f, 0x1d, 0xc8, 0xb4, 0xcb, 0x25, 0x0a, 0x5e,
+	0xb0, 0x19, 0xb3, 0xeb, 0x14, 0x13, 0xcf, 0xc1, 0xc4, 0x75, 0x59, 0x20, 0x29, 0x8b, 0x53, 0x7d,
+	0x0d, 0x3e, 0x3f, 0x09, 0xc9, 0x1c, 0x50, 0x97, 0x35, 0xce, 0x7c, 0x62, 0xd2, 0x32, 0xbd, 0x68,
+	0x52, 0x1e, 0x20, 0x04, 0xe3, 0x35, 0xc2, 0x6b, 0x73, 0xca, 0x92, 0xb2, 0x92, 0x2b, 0x8b, 0x6f,
+	0xdd, 0x82, 0xd9, 0x47, 0xd1, 0xdc, 0x63, 0x2e, 0xa7, 0xe8, 0x08, 0xf2, 0x56, 0xb8, 0x5b, 0x09,
+	0xc2, 0x6d, 0x81, 0xca, 0x6f, 0xac, 0x18, 0x83, 0x9c, 0x32, 0x12, 0x69, 0xc0, 0xea, 0x7e, 0xeb,
+	0xe4, 0xd1, 0x2d, 0x3c, 0x26, 0x75, 0x08, 0xd0, 0x73, 0x4b, 0x5e, 0xf2, 0x95, 0x11, 0xd9, 0x65,
+	0x84, 0x76, 0x19, 0x51, 0x9d, 0xa4, 0x69, 0xc6, 0x31, 0xb1, 0x63, 0x41, 0xe5, 0x04, 0x52, 0xff,
+	0x4f, 0x81, 0xb9, 0xc7, 0x77, 0x48, 0x29, 0xe7, 0xf0, 0x69, 0x42, 0x0a, 0x9f, 0x53, 0x96, 0x3e,
+	0x1a, 0x45, 0xcb, 0xde, 0xd4, 0xcd, 0xab, 0xc5, 0xcc, 0xf5, 0xeb, 0xc5, 0xac, 0xcc, 0x9b, 0xef,
+	0x69, 0xe3, 0xe8, 0xe7, 0x94, 0x82, 0x31, 0xa1, 0xe0, 0xeb, 0x67, 0x15, 0x44, 0xcc, 0x52, 0x12,
+	0x66, 0x00, 0x09, 0x05, 0xc7, 0xc4, 0x27, 0x8d, 0xd8, 0x20, 0xfd, 0x14, 0xa6, 0x53, 0xbb, 0x52,
+	0xd2, 0x0e, 0x64, 0x3d, 0xb1, 0x23, 0x3d, 0x5b, 0x1e, 0x2c, 0x46, 0xa2, 0x25, 0x46, 0x5f, 0x87,
+	0xcf, 0x7a, 0x66, 0xfd, 0x42, 0x78, 0x2d, 0x2e, 0xc7, 0x0c, 0x4c, 0xf4, 0xca, 0x9d, 0x2b, 0x47,
+	0x8b, 0xf4, 0x9b, 0x8a, 0xc2, 0x25, 0x8d, 0x7e, 0x6f, 0xea, 0x14, 0xe6, 0x45, 0xf4, 0x4f, 0xdc,
+	0xf4, 0xd9, 0x9f, 0x3f, 0x5a, 0x96, 0x4f, 0x79, 0xb7, 0xde, 0xb3, 0xf0, 0xb1, 0xc7, 0xfc, 0xa0,
+	0xe2, 0x58, 0x12, 0x93, 0x0d, 0x97, 0x47, 0x16, 0xfa, 0x02, 0xc0, 0xac, 0x11, 0xd7, 0xa5, 0xf5,
+	0xf0, 0x6c, 0x4c, 0x9c, 0xe5, 0xe4, 0xce, 0x91, 0xa5, 0xef, 0x83, 0xda, 0x2f, 0xa9, 0xa4, 0xf1,
+	0x25, 0x4c, 0x51, 0x71, 0x50, 0x21, 0xd1, 0x89, 0x4c, 0x3e, 0x49, 0x93, 0xe1, 0x7a, 0x09, 0x16,
+	0x45, 0x92, 0x33, 0x16, 0x90, 0x7a, 0x94, 0xe9, 0x90, 0xf9, 0x42, 0x55, 0xc2, 0x00, 0x51, 0xdc,
+	0xd8, 0x00, 0xb1, 0xd0, 0xcf, 0x61, 0xe9, 0x69, 0xa0, 0xe4, 0x50, 0x82, 0x2c, 0x69, 0xb0, 0xa6,
+	0x1b, 0xc8, 0x8a, 0xcc, 0xa7, 0xde, 0x40, 0x5c, 0xfd, 0x7d, 0xe6, 0xb8, 0x7b, 0xe3, 0xe1, 0x7b,
+	0x2a, 0xcb, 0xf0, 0x8d, 0xbb, 0x4f, 0x60, 0x42, 0x64, 0x47, 0xff, 0x2a, 0x00, 0xbd, 0x67, 0x87,
+	0xb6, 0x06, 0xd7, 0xb4, 0x7f, 0x9b, 0xab, 0xdf, 0x8d, 0x88, 0x8a, 0xe8, 0xeb, 0xc5, 0xbf, 0xfe,
+	0x7f, 0x7b, 0x35, 0xb6, 0x8a, 0xbe, 0xc1, 0x72, 0x16, 0xa5, 0x67, 0x50, 0xb2, 0x7f, 0x70, 0x3b,
+	0xac, 0x73, 0x07, 0xfd, 0xa3, 0x40, 0xfe, 0x20, 0xd1, 0x09, 0xa3, 0xdd, 0x1c, 0x3f, 0x09, 0x75,
+	0x7b, 0x54, 0x98, 0x64, 0x5c, 0x10, 0x8c, 0x97, 0x91, 0xfe, 0x3c, 0x63, 0x74, 0xa5, 0x40, 0x36,
+	0xea, 0x01, 0xf4, 0xed, 0x10, 0xd7, 0xa5, 0x5a, 0x50, 0x2d, 0x8e, 0x80, 0x90, 0xdc, 0x96, 0x05,
+	0x37, 0x0d, 0x2d, 0xf4, 0xe7, 0x16, 0xb5, 0x21, 0xba, 0x56, 0x20, 0xd7, 0xed, 0x29, 0xb4, 0x39,
+	0xac, 0x0f, 0x89, 0x86, 0x55, 0xb7, 0x46, 0x03, 0x49, 0x7a, 0x1b, 0x82, 0xde, 0x1a, 0x2a, 0x0c,
+	0xb2, 0x2e, 0x2c, 0x72, 0x58, 0x6c, 0x61, 0x61, 0x07, 0xbd, 0x50, 0x60, 0x32, 0xd5, 0x7d, 0xa8,
+	0x34, 0xc4, 0xdd, 0xfd, 0x86, 0x80, 0xfa, 0xfd, 0xe8, 0x40, 0x49, 0xbc, 0x2c, 0x88, 0xff, 0x86,
+	0x7e, 0xed, 0x4f, 0x5c, 0xce, 0x0b, 0x8e, 0xdb, 0xbd, 0x59, 0xd2, 0xc1, 0xe1, 0x84, 0xe1, 0xb8,
+	0x2d, 0xe7, 0x4e, 0x07, 0xa7, 0x47, 0x05, 0xba, 0x53, 0x60, 0xba, 0x4f, 0x63, 0xa3, 0xdd, 0x21,
+	0x58, 0x3e, 0x3d, 0x49, 0xd4, 0x1f, 0x3e, 0x14, 0x2e, 0xa5, 0xee, 0x08, 0xa9, 0xdb, 0x68, 0x6b,
+	0x40, 0x8d, 0x38, 0x6e, 0x8b, 0xdf, 0xdd, 0x42, 0xa1, 0x83, 0x83, 0x30, 0x59, 0x25, 0x12, 0xb7,
+	0x77, 0x72, 0x73, 0xaf, 0x29, 0xb7, 0xf7, 0x9a, 0xf2, 0xe6, 0x5e, 0x53, 0xfe, 0x7e, 0xd0, 0x32,
+	0xb7, 0x0f, 0x5a, 0xe6, 0xe5, 0x83, 0x96, 0xf9, 0xbd, 0x64, 0x3b, 0x41, 0xad, 0x59, 0x35, 0x4c,
+	0xd6, 0xc0, 0xf2, 0x6f, 0x89, 0x53, 0x35, 0xd7, 0x6d, 0x86, 0x5b, 0x9b, 0xb8, 0xc1, 0xac, 0x66,
+	0x9d, 0xf2, 0xf7, 0xae, 0x0b, 0x2e, 0x3d, 0xca, 0xab, 0x59, 0xf1, 0x07, 0x63, 0xf3, 0xdd, 0x00,
+	0x29, 0x11, 0x5f, 0x0e, 0x57, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -593,6 +695,8 @@ type QueryClient interface {
 	DenomHash(ctx context.Context, in *QueryDenomHashRequest, opts ...grpc.CallOption) (*QueryDenomHashResponse, error)
 	// EscrowAddress returns the escrow address for a particular port and channel id.
 	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
 }
 
 type queryClient struct {
@@ -648,6 +752,15 @@ func (c *queryClient) EscrowAddress(ctx context.Context, in *QueryEscrowAddressR
 	return out, nil
 }
 
+func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error) {
+	out := new(QueryTotalEscrowForDenomResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// DenomTrace queries a denomination trace information.
@@ -660,6 +773,8 @@ type QueryServer interface {
 	DenomHash(context.Context, *QueryDenomHashRequest) (*QueryDenomHashResponse, error)
 	// EscrowAddress returns the escrow address for a particular port and channel id.
 	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
+	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
+	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -681,6 +796,9 @@ func (*UnimplementedQueryServer) DenomHash(ctx context.Context, req *QueryDenomH
 func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EscrowAddress not implemented")
 }
+func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -776,6 +894,24 @@ func _Query_EscrowAddress_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalEscrowForDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/TotalEscrowForDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalEscrowForDenom(ctx, req.(*QueryTotalEscrowForDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -800,6 +936,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EscrowAddress",
 			Handler:    _Query_EscrowAddress_Handler,
 		},
+		{
+			MethodName: "TotalEscrowForDenom",
+			Handler:    _Query_TotalEscrowForDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/query.proto",
@@ -1139,6 +1279,69 @@ func (m *QueryEscrowAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTotalEscrowForDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1286,6 +1489,30 @@ func (m *QueryEscrowAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTotalEscrowForDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2162,6 +2389,171 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
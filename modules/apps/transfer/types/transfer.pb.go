@@ -81,9 +81,8 @@ func (m *DenomTrace) GetBaseDenom() string {
 }
 
 // Params defines the set of IBC transfer parameters.
-// NOTE: To prevent a single token from being transferred, set the
-// TransfersEnabled parameter to true and then set the bank module's SendEnabled
-// parameter for the denomination to false.
+// NOTE: To prevent a single token from being transferred, add the denomination
+// to send_blocked_denoms and receive_blocked_denoms.
 type Params struct {
 	// send_enabled enables or disables all cross-chain token transfers from this
 	// chain.
@@ -91,6 +90,16 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// channel_overrides replaces send_enabled and receive_enabled for the listed
+	// channels, allowing transfers over individual channels to be enabled or
+	// disabled.
+	ChannelOverrides []ChannelOverride `protobuf:"bytes,3,rep,name=channel_overrides,json=channelOverrides,proto3" json:"channel_overrides" yaml:"channel_overrides"`
+	// send_blocked_denoms lists the denominations which cannot be sent from this
+	// chain over any channel.
+	SendBlockedDenoms []string `protobuf:"bytes,4,rep,name=send_blocked_denoms,json=sendBlockedDenoms,proto3" json:"send_blocked_denoms,omitempty" yaml:"send_blocked_denoms"`
+	// receive_blocked_denoms lists the denominations which cannot be received by
+	// this chain over any channel.
+	ReceiveBlockedDenoms []string `protobuf:"bytes,5,rep,name=receive_blocked_denoms,json=receiveBlockedDenoms,proto3" json:"receive_blocked_denoms,omitempty" yaml:"receive_blocked_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,9 +149,98 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetChannelOverrides() []ChannelOverride {
+	if m != nil {
+		return m.ChannelOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetSendBlockedDenoms() []string {
+	if m != nil {
+		return m.SendBlockedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetReceiveBlockedDenoms() []string {
+	if m != nil {
+		return m.ReceiveBlockedDenoms
+	}
+	return nil
+}
+
+// ChannelOverride defines whether cross-chain token transfers from and to this
+// chain are enabled over a specific channel.
+type ChannelOverride struct {
+	// channel identifier the override applies to
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// send_enabled enables or disables token transfers from this chain over the
+	// channel.
+	SendEnabled bool `protobuf:"varint,2,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled"`
+	// receive_enabled enables or disables token transfers to this chain over the
+	// channel.
+	ReceiveEnabled bool `protobuf:"varint,3,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+}
+
+func (m *ChannelOverride) Reset()         { *m = ChannelOverride{} }
+func (m *ChannelOverride) String() string { return proto.CompactTextString(m) }
+func (*ChannelOverride) ProtoMessage()    {}
+func (*ChannelOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *ChannelOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOverride.Merge(m, src)
+}
+func (m *ChannelOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOverride proto.InternalMessageInfo
+
+func (m *ChannelOverride) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelOverride) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelOverride) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*ChannelOverride)(nil), "ibc.applications.transfer.v1.ChannelOverride")
 }
 
 func init() {
@@ -150,26 +248,36 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0xe9, 0x42, 0xe8, 0x46, 0xa8, 0xba, 0xd7, 0xe2, 0xca, 0x4d, 0x49, 0x32, 0xc7, 0x69, 0x60,
-	0x32, 0x19, 0x92, 0x74, 0xa0, 0x8f, 0xe0, 0xce, 0xc7, 0x72, 0xd9, 0xa5, 0xab, 0x22, 0xed, 0x1b,
-	0xf4, 0x09, 0x64, 0xd2, 0x52, 0x06, 0x77, 0xff, 0x39, 0xe7, 0xfb, 0xce, 0xe2, 0xc7, 0x37, 0x4a,
-	0x48, 0xc6, 0x8b, 0x22, 0x53, 0x92, 0x7b, 0x65, 0x72, 0xc7, 0xbc, 0xe5, 0xb9, 0x7b, 0x07, 0xcb,
-	0xca, 0xfe, 0x3e, 0xc7, 0x85, 0x35, 0xde, 0x90, 0x0b, 0x25, 0x64, 0x5c, 0x87, 0xe3, 0x3d, 0x50,
-	0xf6, 0xbb, 0xa7, 0xa9, 0x49, 0x4d, 0x00, 0x59, 0x95, 0xb6, 0x4e, 0x74, 0x8f, 0xf1, 0x23, 0xe4,
-	0x46, 0xbf, 0x5a, 0x2e, 0x81, 0x10, 0xfc, 0xaf, 0xe0, 0x7e, 0xda, 0x41, 0x57, 0xe8, 0xba, 0x3d,
-	0x0e, 0x99, 0x5c, 0x62, 0x2c, 0xb8, 0x83, 0x49, 0x52, 0x61, 0x9d, 0x66, 0xb8, 0xb4, 0xab, 0x4d,
-	0xf0, 0xa2, 0x0f, 0x84, 0x5b, 0xcf, 0xdc, 0x72, 0xed, 0xc8, 0x10, 0x1f, 0x3a, 0xc8, 0x93, 0x09,
-	0xe4, 0x5c, 0x64, 0x90, 0x84, 0x2f, 0xff, 0x47, 0xe7, 0x9b, 0x65, 0xef, 0x64, 0xce, 0x75, 0x36,
-	0x8c, 0xea, 0xd7, 0x68, 0x7c, 0x50, 0x8d, 0x4f, 0xdb, 0x89, 0x3c, 0xe0, 0x63, 0x0b, 0x12, 0x54,
-	0x09, 0x7b, 0xbd, 0x19, 0xf4, 0xee, 0x66, 0xd9, 0x3b, 0xdb, 0xea, 0x7f, 0x80, 0x68, 0x7c, 0xb4,
-	0xdb, 0xec, 0x9e, 0x8c, 0x5e, 0xbe, 0x56, 0x14, 0x2d, 0x56, 0x14, 0xfd, 0xac, 0x28, 0xfa, 0x5c,
-	0xd3, 0xc6, 0x62, 0x4d, 0x1b, 0xdf, 0x6b, 0xda, 0x78, 0xbb, 0x4b, 0x95, 0x9f, 0xce, 0x44, 0x2c,
-	0x8d, 0x66, 0xd2, 0x38, 0x6d, 0x1c, 0x53, 0x42, 0xde, 0xa6, 0x86, 0x95, 0x03, 0xa6, 0x4d, 0x32,
-	0xcb, 0xc0, 0x55, 0x3d, 0xd7, 0xfa, 0xf5, 0xf3, 0x02, 0x9c, 0x68, 0x85, 0x9a, 0x06, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0xdf, 0x93, 0x8e, 0x89, 0x01, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xb6, 0xac, 0x34, 0x54, 0x9b, 0xd2, 0xd4, 0x9b, 0x34, 0x15, 0xa6, 0x91, 0xdc, 0x3d, 0x19,
+	0x4a, 0x24, 0xd2, 0x14, 0x0a, 0xb9, 0x14, 0x94, 0xf6, 0xd0, 0x4b, 0x7f, 0x44, 0xa1, 0xd0, 0x8b,
+	0x59, 0xad, 0xa6, 0xf6, 0x52, 0x49, 0x2b, 0xb4, 0x8a, 0x20, 0xd0, 0x87, 0xe8, 0xa3, 0xf4, 0x31,
+	0x7c, 0xcc, 0xb1, 0x27, 0x51, 0xec, 0x37, 0xd0, 0x13, 0x14, 0xad, 0x6c, 0x63, 0xc9, 0x50, 0x28,
+	0xb9, 0xed, 0xcc, 0x7c, 0xdf, 0xb7, 0xcc, 0xf7, 0x31, 0xe8, 0x39, 0x0f, 0x98, 0x4b, 0xd3, 0x34,
+	0xe2, 0x8c, 0xe6, 0x5c, 0x24, 0xd2, 0xcd, 0x33, 0x9a, 0xc8, 0x6f, 0x90, 0xb9, 0xc5, 0xf9, 0xe6,
+	0xed, 0xa4, 0x99, 0xc8, 0x05, 0x7e, 0xca, 0x03, 0xe6, 0x6c, 0x83, 0x9d, 0x0d, 0xa0, 0x38, 0x1f,
+	0x1e, 0x4f, 0xc5, 0x54, 0x28, 0xa0, 0x5b, 0xbf, 0x1a, 0x0e, 0x79, 0x8d, 0xd0, 0x1b, 0x48, 0x44,
+	0xfc, 0x39, 0xa3, 0x0c, 0x30, 0x46, 0x7b, 0x29, 0xcd, 0x67, 0xa6, 0x36, 0xd2, 0xc6, 0x86, 0xaf,
+	0xde, 0xf8, 0x14, 0xa1, 0x80, 0x4a, 0x98, 0x84, 0x35, 0xcc, 0xec, 0xab, 0x89, 0x51, 0x77, 0x14,
+	0x8f, 0xfc, 0xd2, 0xd1, 0xfe, 0x47, 0x9a, 0xd1, 0x58, 0xe2, 0x4b, 0xf4, 0x40, 0x42, 0x12, 0x4e,
+	0x20, 0xa1, 0x41, 0x04, 0xa1, 0x52, 0xb9, 0xef, 0x3d, 0xa9, 0x4a, 0xfb, 0xe8, 0x86, 0xc6, 0xd1,
+	0x25, 0xd9, 0x9e, 0x12, 0xff, 0xa0, 0x2e, 0xdf, 0x36, 0x15, 0xbe, 0x42, 0x87, 0x19, 0x30, 0xe0,
+	0x05, 0x6c, 0xe8, 0x7d, 0x45, 0x1f, 0x56, 0xa5, 0x7d, 0xd2, 0xd0, 0x3b, 0x00, 0xe2, 0x3f, 0x5c,
+	0x75, 0xd6, 0x22, 0x3f, 0xd0, 0x80, 0xcd, 0x68, 0x92, 0x40, 0x34, 0x11, 0x05, 0x64, 0x19, 0x0f,
+	0x41, 0x9a, 0xfa, 0x48, 0x1f, 0x1f, 0xbc, 0x38, 0x73, 0xfe, 0x65, 0x8e, 0x73, 0xd5, 0xd0, 0x3e,
+	0xac, 0x58, 0xde, 0x68, 0x5e, 0xda, 0xbd, 0xaa, 0xb4, 0xcd, 0xe6, 0xe7, 0x1d, 0x55, 0xe2, 0x3f,
+	0x62, 0x6d, 0x8a, 0xc4, 0xef, 0xd1, 0x91, 0x5a, 0x30, 0x88, 0x04, 0xfb, 0x0e, 0x61, 0x63, 0x98,
+	0x34, 0xf7, 0x46, 0xfa, 0xd8, 0xf0, 0xac, 0xaa, 0xb4, 0x87, 0x5b, 0x2e, 0xb4, 0x41, 0xc4, 0x1f,
+	0xd4, 0x5d, 0xaf, 0x69, 0x2a, 0x63, 0x25, 0xfe, 0x82, 0x4e, 0xd6, 0x1b, 0x77, 0x24, 0xef, 0x29,
+	0xc9, 0x67, 0x55, 0x69, 0x9f, 0xb6, 0x9d, 0xe9, 0xaa, 0x1e, 0xaf, 0x06, 0x2d, 0x61, 0x32, 0xd7,
+	0xd0, 0x61, 0x67, 0x61, 0xfc, 0x12, 0xa1, 0xf5, 0x92, 0xbc, 0x49, 0xce, 0xf0, 0x1e, 0x57, 0xa5,
+	0x3d, 0x68, 0x1b, 0xc0, 0x43, 0xe2, 0x1b, 0xab, 0xe2, 0x5d, 0xb8, 0x93, 0x78, 0xff, 0x6e, 0x89,
+	0xeb, 0xff, 0x9b, 0xb8, 0xf7, 0x69, 0xbe, 0xb0, 0xb4, 0xdb, 0x85, 0xa5, 0xfd, 0x59, 0x58, 0xda,
+	0xcf, 0xa5, 0xd5, 0xbb, 0x5d, 0x5a, 0xbd, 0xdf, 0x4b, 0xab, 0xf7, 0xf5, 0xd5, 0x94, 0xe7, 0xb3,
+	0xeb, 0xc0, 0x61, 0x22, 0x76, 0x99, 0x90, 0xb1, 0x90, 0x2e, 0x0f, 0xd8, 0xd9, 0x54, 0xb8, 0xc5,
+	0x85, 0x1b, 0x8b, 0xf0, 0x3a, 0x02, 0x59, 0x5f, 0xd6, 0xd6, 0x45, 0xe5, 0x37, 0x29, 0xc8, 0x60,
+	0x5f, 0x1d, 0xc6, 0xc5, 0xdf, 0x01, 0x00, 0xe7, 0x44, 0x78, 0x60, 0x7b, 0x03, 0x00, 0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -229,6 +337,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiveBlockedDenoms) > 0 {
+		for iNdEx := len(m.ReceiveBlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiveBlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.ReceiveBlockedDenoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.ReceiveBlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SendBlockedDenoms) > 0 {
+		for iNdEx := len(m.SendBlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SendBlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.SendBlockedDenoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.SendBlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelOverrides) > 0 {
+		for iNdEx := len(m.ChannelOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -252,6 +392,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -292,6 +482,43 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if len(m.ChannelOverrides) > 0 {
+		for _, e := range m.ChannelOverrides {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.SendBlockedDenoms) > 0 {
+		for _, s := range m.SendBlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.ReceiveBlockedDenoms) > 0 {
+		for _, s := range m.ReceiveBlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -484,6 +711,226 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOverrides = append(m.ChannelOverrides, ChannelOverride{})
+			if err := m.ChannelOverrides[len(m.ChannelOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendBlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendBlockedDenoms = append(m.SendBlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveBlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveBlockedDenoms = append(m.ReceiveBlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -34,4 +34,6 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client is not active")
+	ErrFailedChannelUpgradeVerification       = sdkerrors.Register(SubModuleName, 30, "channel upgrade verification failed")
+	ErrFailedChannelUpgradeErrorVerification  = sdkerrors.Register(SubModuleName, 31, "channel upgrade error receipt verification failed")
 )
@@ -138,6 +138,74 @@ func (k Keeper) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies the proposed upgrade stored for the
+// specified channel end on the target machine.
+func (k Keeper) VerifyChannelUpgrade(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	if err := clientState.VerifyChannelUpgrade(
+		clientStore, k.cdc, height,
+		connection.GetCounterparty().GetPrefix(), proof,
+		portID, channelID, upgrade,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed channel upgrade verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies the error receipt of the last failed
+// upgrade attempt stored for the specified channel end on the target machine.
+func (k Keeper) VerifyChannelUpgradeError(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	if err := clientState.VerifyChannelUpgradeError(
+		clientStore, k.cdc, height,
+		connection.GetCounterparty().GetPrefix(), proof,
+		portID, channelID, errorReceipt,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed channel upgrade error receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (k Keeper) VerifyPacketCommitment(
@@ -218,6 +286,84 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketCommitments verifies a batch proof of the outgoing packet commitments at the specified
+// port and specified channel, keyed by their sequence.
+func (k Keeper) VerifyPacketCommitments(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketCommitments(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		commitments,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet commitment batch verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgements verifies a batch proof of the incoming packet acknowledgements at the specified
+// port and specified channel, keyed by their sequence.
+func (k Keeper) VerifyPacketAcknowledgements(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	acknowledgements map[uint64][]byte,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketAcknowledgements(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		acknowledgements,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet acknowledgement batch verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
@@ -258,6 +404,86 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketCommitmentAbsence verifies a proof of the absence of an
+// outgoing packet commitment at the specified port, specified channel, and
+// specified sequence.
+func (k Keeper) VerifyPacketCommitmentAbsence(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketCommitmentAbsence(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		sequence,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet commitment absence verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (k Keeper) VerifyPacketReceipt(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketReceipt(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		sequence, receipt,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (k Keeper) VerifyNextSequenceRecv(
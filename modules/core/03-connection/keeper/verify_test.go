@@ -582,6 +582,208 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 	}
 }
 
+// TestVerifyPacketCommitmentAbsence has chainA verify the packet commitment
+// absence on channelB. The channels on chainA and chainB are fully opened and
+// no packet is sent from chainB to chainA.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentAbsence() {
+	var (
+		path            *ibctesting.Path
+		packet          channeltypes.Packet
+		heightDiff      uint64
+		delayTimePeriod uint64
+		timePerBlock    uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: delay period passed", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+		}, true},
+		{"delay time period has not passed", func() {
+			delayTimePeriod = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"delay block period has not passed", func() {
+			// make timePerBlock 1 nanosecond so that block delay is not passed.
+			// must also set a non-zero time delay to ensure block delay is enforced.
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			timePerBlock = 1
+		}, false},
+		{"client state not found - changed client ID", func() {
+			connection := path.EndpointA.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointA.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - packet commitment exists", func() {
+			err := path.EndpointB.SendPacket(packet)
+			suite.Require().NoError(err)
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// only send in malleate if applicable
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, 0)
+
+			// reset variables
+			heightDiff = 0
+			delayTimePeriod = 0
+			timePerBlock = 0
+			tc.malleate()
+
+			connection := path.EndpointA.GetConnection()
+			connection.DelayPeriod = delayTimePeriod
+
+			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			if clientState.FrozenHeight.IsZero() {
+				// need to update height to prove absence or receipt
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+				path.EndpointA.UpdateClient()
+			}
+
+			packetCommitmentKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := suite.chainB.QueryProof(packetCommitmentKey)
+
+			// set time per block param
+			if timePerBlock != 0 {
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock))
+			}
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitmentAbsence(
+				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestVerifyPacketReceipt has chainA verify the timeout receipt on channelB.
+// The ORDERED_ALLOW_TIMEOUT channels on chainA and chainB are fully opened and
+// a packet is sent from chainA to chainB and received after its timeout.
+func (suite *KeeperTestSuite) TestVerifyPacketReceipt() {
+	var (
+		path            *ibctesting.Path
+		receipt         []byte
+		heightDiff      uint64
+		delayTimePeriod uint64
+		timePerBlock    uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: delay period passed", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+		}, true},
+		{"delay time period has not passed", func() {
+			delayTimePeriod = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"delay block period has not passed", func() {
+			// make timePerBlock 1 nanosecond so that block delay is not passed.
+			// must also set a non-zero time delay to ensure block delay is enforced.
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			timePerBlock = 1
+		}, false},
+		{"client state not found - changed client ID", func() {
+			connection := path.EndpointA.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointA.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - changed receipt", func() {
+			receipt = []byte{byte(1)}
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()                           // reset
+			receipt = channeltypes.PacketTimeoutReceipt // must be explicitly changed
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			// send packet and receive it after its timeout
+			timeoutTimestamp := uint64(suite.chainB.LastHeader.GetTime().Add(time.Hour).UnixNano())
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// increment receiving chain's (chainB) time by 2 hour to always time out the packet
+			suite.coordinator.IncrementTimeBy(time.Hour * 2)
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			packetReceiptKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight := suite.chainB.QueryProof(packetReceiptKey)
+
+			// reset variables
+			heightDiff = 0
+			delayTimePeriod = 0
+			timePerBlock = 0
+			tc.malleate()
+
+			connection := path.EndpointA.GetConnection()
+			connection.DelayPeriod = delayTimePeriod
+
+			// set time per block param
+			if timePerBlock != 0 {
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock))
+			}
+
+			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketReceipt(
+				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestVerifyNextSequenceRecv has chainA verify the next sequence receive on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
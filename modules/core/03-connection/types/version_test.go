@@ -125,7 +125,7 @@ func TestVerifyProposedVersion(t *testing.T) {
 		supportedVersion *types.Version
 		expPass          bool
 	}{
-		{"entire feature set supported", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_DAG"}), true},
+		{"entire feature set supported", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT", "ORDER_DAG"}), true},
 		{"empty feature sets not supported", types.NewVersion("1", []string{}), types.DefaultIBCVersion, false},
 		{"one feature missing", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_UNORDERED", "ORDER_DAG"}), false},
 		{"both features missing", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_DAG"}), false},
@@ -154,6 +154,7 @@ func TestVerifySupportedFeature(t *testing.T) {
 	}{
 		{"check ORDERED supported", ibctesting.ConnectionVersion, "ORDER_ORDERED", true},
 		{"check UNORDERED supported", ibctesting.ConnectionVersion, "ORDER_UNORDERED", true},
+		{"check ORDERED_ALLOW_TIMEOUT supported", ibctesting.ConnectionVersion, "ORDER_ORDERED_ALLOW_TIMEOUT", true},
 		{"check DAG unsupported", ibctesting.ConnectionVersion, "ORDER_DAG", false},
 		{"check empty feature set returns false", nilFeatures, "ORDER_ORDERED", false},
 	}
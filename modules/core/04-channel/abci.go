@@ -0,0 +1,54 @@
+package channel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// BeginBlocker prunes the packet acknowledgements and receipts of channels with prunable
+// packet sequences. The channels are swept from the pruning cursor stored by the previous
+// block, visiting at most types.PruningChannelsPerBlock channels and pruning at most
+// types.PruningLimitPerBlock sequences per block.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	type prunableChannel struct {
+		portID    string
+		channelID string
+	}
+
+	// collect the channels to prune before pruning, as pruning writes to the iterated store
+	var (
+		channels  []prunableChannel
+		visited   uint64
+		remaining uint64
+	)
+	cursor := k.IteratePruningSequenceStartsFrom(ctx, k.GetPruningCursor(ctx), func(portID, channelID string, _ uint64) bool {
+		visited++
+
+		start, end, err := k.GetPruningSequences(ctx, portID, channelID)
+		if err == nil && start < end {
+			channels = append(channels, prunableChannel{portID: portID, channelID: channelID})
+			remaining += end - start
+		}
+
+		return visited >= types.PruningChannelsPerBlock || remaining >= types.PruningLimitPerBlock
+	})
+
+	// the sweep of the next block starts at the first channel once the last channel is visited
+	k.SetPruningCursor(ctx, cursor)
+
+	limit := types.PruningLimitPerBlock
+	for _, channel := range channels {
+		totalPruned, _, err := k.PruneAcknowledgements(ctx, channel.portID, channel.channelID, limit)
+		if err != nil {
+			k.Logger(ctx).Error("failed to prune acknowledgements", "port-id", channel.portID, "channel-id", channel.channelID, "error", err.Error())
+			continue
+		}
+
+		limit -= totalPruned
+		if limit == 0 {
+			return
+		}
+	}
+}
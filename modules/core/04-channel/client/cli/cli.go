@@ -29,6 +29,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
+		GetCmdQueryUpgrade(),
+		GetCmdQueryUpgradeError(),
+		GetCmdQueryPrunableAcknowledgements(),
+		GetCmdQueryFrozenChannels(),
+		GetCmdQueryChannelStatistics(),
+		GetCmdQueryPacketStatus(),
+		GetCmdQueryPacketStatuses(),
 		// TODO: next sequence Send ?
 	)
 
@@ -45,7 +52,9 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		NewPruneAcknowledgementsTxCmd(),
+	)
 
 	return txCmd
 }
@@ -455,3 +455,285 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryUpgrade defines the command to query for the upgrade of a channel
+func GetCmdQueryUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [port-id] [channel-id]",
+		Short: "Query the upgrade of a channel",
+		Long:  "Query the upgrade in progress for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			upgradeRes, err := utils.QueryUpgrade(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(upgradeRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(upgradeRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpgradeError defines the command to query for the upgrade error receipt of a channel
+func GetCmdQueryUpgradeError() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-error [port-id] [channel-id]",
+		Short: "Query the upgrade error receipt of a channel",
+		Long:  "Query the error receipt of the last aborted upgrade for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade-error [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			errorReceiptRes, err := utils.QueryUpgradeError(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(errorReceiptRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(errorReceiptRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrunableAcknowledgements defines the command to query for the prunable acknowledgements of a channel
+func GetCmdQueryPrunableAcknowledgements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prunable-acknowledgements [port-id] [channel-id]",
+		Short: "Query the prunable acknowledgements of a channel",
+		Long:  "Query the range of packet sequences whose acknowledgements and receipts may be pruned for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s prunable-acknowledgements [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPrunableAcknowledgementsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.PrunableAcknowledgements(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFrozenChannels defines the command to query all the channels frozen by governance.
+func GetCmdQueryFrozenChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-channels",
+		Short:   "Query all frozen channels",
+		Long:    "Query all channels frozen by governance from a chain",
+		Example: fmt.Sprintf("%s query %s %s frozen-channels", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFrozenChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FrozenChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen channels")
+
+	return cmd
+}
+
+// GetCmdQueryChannelStatistics defines the command to query the packet lifecycle statistics of all channels.
+func GetCmdQueryChannelStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "statistics",
+		Short:   "Query the packet statistics of all channels",
+		Long:    "Query the number of packets sent, received, acknowledged and timed out and the last activity heights of all channels from a chain",
+		Example: fmt.Sprintf("%s query %s %s statistics", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelStatisticsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelStatistics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel statistics")
+
+	return cmd
+}
+
+// GetCmdQueryPacketStatus defines the command to query the lifecycle status of a packet.
+func GetCmdQueryPacketStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-status [port-id] [channel-id] [sequence]",
+		Short: "Query the lifecycle status of a packet",
+		Long: `Query the lifecycle status of a packet sequence on a channel end, resolved from the packet state stored on the queried chain.
+
+The status is one of:
+- committed: the packet was sent on the channel end and is pending its acknowledgement or timeout
+- received: the packet was received on the channel end and its acknowledgement has not been written yet
+- acknowledged: the packet was received on the channel end and its acknowledgement has been written
+- timed out: the packet was received after its timeout on an ORDERED_ALLOW_TIMEOUT channel end
+- unknown: no packet state is stored for the sequence
+`,
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-status [port-id] [channel-id] [sequence]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketStatusRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.PacketStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacketStatuses defines the command to query the lifecycle status of a range of packets.
+func GetCmdQueryPacketStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-statuses [port-id] [channel-id] [start-sequence] [end-sequence]",
+		Short: "Query the lifecycle status of a range of packets",
+		Long:  fmt.Sprintf("Query the lifecycle status of the packet sequences from start-sequence to end-sequence inclusive on a channel end. At most %d sequences may be queried at once.", types.MaxPacketStatusesRange),
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-statuses [port-id] [channel-id] [start-sequence] [end-sequence]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startSeq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endSeq, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketStatusesRequest{
+				PortId:        args[0],
+				ChannelId:     args[1],
+				StartSequence: startSeq,
+				EndSequence:   endSeq,
+			}
+
+			res, err := queryClient.PacketStatuses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewCmdSubmitChannelUpgradeProposal implements a command handler for submitting a channel upgrade proposal transaction.
+func NewCmdSubmitChannelUpgradeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-upgrade [port-id] [channel-id] [ordering] [connection-id] [version] [flags]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a channel upgrade proposal",
+		Long: "Submit a channel upgrade proposal along with an initial deposit.\n" +
+			"Please specify the port and channel identifiers of the channel to be upgraded.\n" +
+			"Please specify the ordering (ORDERED, UNORDERED or ORDERED_ALLOW_TIMEOUT), connection identifier and version the channel will be upgraded to.",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal channel-upgrade transfer channel-0 UNORDERED connection-0 '{\"fee_version\":\"ics29-1\",\"app_version\":\"ics20-1\"}' --from=<key_or_address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ordering, found := types.Order_value[fmt.Sprintf("ORDER_%s", strings.ToUpper(args[2]))]
+			if !found {
+				return fmt.Errorf("invalid channel ordering %s, expected one of [%s, %s, %s]", args[2], types.ORDERED, types.UNORDERED, types.ORDERED_ALLOW_TIMEOUT)
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				fields := types.NewUpgradeFields(types.Order(ordering), []string{args[3]}, args[4])
+				return types.NewChannelUpgradeProposal(title, description, args[0], args[1], fields)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitChannelCloseProposal implements a command handler for submitting a channel close proposal transaction.
+func NewCmdSubmitChannelCloseProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-close [port-id] [channel-id] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a channel close proposal",
+		Long: "Submit a proposal to force-close a channel along with an initial deposit.\n" +
+			"The channel is closed without the consent of the module owning it, packets in-flight on the counterparty may be timed out once the channel is closed.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal channel-close transfer channel-0 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewChannelCloseProposal(title, description, args[0], args[1])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitChannelFreezeProposal implements a command handler for submitting a channel freeze proposal transaction.
+func NewCmdSubmitChannelFreezeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-freeze [port-id] [channel-id] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a channel freeze proposal",
+		Long: "Submit a proposal to freeze a channel along with an initial deposit.\n" +
+			"Packets can neither be sent nor received on a frozen channel until it is unfrozen, the channel state is left unchanged.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal channel-freeze transfer channel-0 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewChannelFreezeProposal(title, description, args[0], args[1])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitChannelUnfreezeProposal implements a command handler for submitting a channel unfreeze proposal transaction.
+func NewCmdSubmitChannelUnfreezeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-unfreeze [port-id] [channel-id] [flags]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a channel unfreeze proposal",
+		Long:    "Submit a proposal to unfreeze a frozen channel along with an initial deposit.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal channel-unfreeze transfer channel-0 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewChannelUnfreezeProposal(title, description, args[0], args[1])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal builds the proposal content using the title and description flags and generates
+// or broadcasts a transaction submitting it along with the deposit flag.
+func submitProposal(cmd *cobra.Command, contentFn func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	content := contentFn(title, description)
+
+	from := clientCtx.GetFromAddress()
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// NewPruneAcknowledgementsTxCmd returns the command to create a new MsgPruneAcknowledgements transaction
+func NewPruneAcknowledgementsTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune-acknowledgements [port-id] [channel-id] [limit]",
+		Short: "Prune the acknowledgements and receipts of a channel",
+		Long: "Prune the packet acknowledgements and receipts of up to [limit] packet sequences of a channel.\n" +
+			"Only the acknowledgements and receipts of packets sent by the counterparty before the last channel upgrade may be pruned.",
+		Example: fmt.Sprintf("%s tx %s %s prune-acknowledgements transfer channel-0 100 --from=<key_or_address>", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneAcknowledgements(args[0], args[1], limit, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
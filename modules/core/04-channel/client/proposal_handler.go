@@ -0,0 +1,32 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/client/cli"
+)
+
+var (
+	// ChannelUpgradeProposalHandler is the proposal handler used to submit channel upgrade proposals.
+	ChannelUpgradeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitChannelUpgradeProposal, emptyRestHandler)
+	// ChannelCloseProposalHandler is the proposal handler used to submit channel close proposals.
+	ChannelCloseProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitChannelCloseProposal, emptyRestHandler)
+	// ChannelFreezeProposalHandler is the proposal handler used to submit channel freeze proposals.
+	ChannelFreezeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitChannelFreezeProposal, emptyRestHandler)
+	// ChannelUnfreezeProposalHandler is the proposal handler used to submit channel unfreeze proposals.
+	ChannelUnfreezeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitChannelUnfreezeProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-channel",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC proposals")
+		},
+	}
+}
@@ -32,19 +32,35 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, fc := range gs.FrozenChannels {
+		k.SetChannelFrozen(ctx, fc.PortId, fc.ChannelId)
+	}
+	for _, cs := range gs.Statistics {
+		k.SetChannelStatistics(ctx, cs)
+	}
+	for _, ps := range gs.PruningSequenceStarts {
+		k.SetPruningSequenceStart(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
+	for _, rs := range gs.RecvStartSequences {
+		k.SetRecvStartSequence(ctx, rs.PortId, rs.ChannelId, rs.Sequence)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		Channels:              k.GetAllChannels(ctx),
+		Acknowledgements:      k.GetAllPacketAcks(ctx),
+		Commitments:           k.GetAllPacketCommitments(ctx),
+		Receipts:              k.GetAllPacketReceipts(ctx),
+		SendSequences:         k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:         k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:          k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:   k.GetNextChannelSequence(ctx),
+		FrozenChannels:        k.GetAllFrozenChannels(ctx),
+		Statistics:            k.GetAllChannelStatistics(ctx),
+		PruningSequenceStarts: k.GetAllPruningSequenceStarts(ctx),
+		RecvStartSequences:    k.GetAllRecvStartSequences(ctx),
 	}
 }
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// ChanForceClose is called by governance to close a channel end without the capability
+// of the module owning it. The channel must exist and must not be CLOSED already.
+// The channel state is only written by WriteForceCloseChannel once the application
+// callback has succeeded.
+func (k Keeper) ChanForceClose(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State == types.UNINITIALIZED || channel.State == types.CLOSED {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel cannot be force closed (got %s)", channel.State.String())
+	}
+
+	return nil
+}
+
+// WriteForceCloseChannel closes the channel end. Any upgrade in progress is discarded and
+// the channel is unfrozen. The counterparty may close its end with ChanCloseConfirm and
+// time out its in-flight packets with TimeoutOnClose.
+func (k Keeper) WriteForceCloseChannel(ctx sdk.Context, portID, channelID string) types.Channel {
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "force-close")
+	}()
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", "CLOSED")
+
+	channel.State = types.CLOSED
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+	k.deleteChannelFrozen(ctx, portID, channelID)
+
+	EmitChannelForceCloseEvent(ctx, portID, channelID, channel)
+
+	return channel
+}
+
+// FreezeChannel is called by governance to freeze a channel end. Packets can neither be
+// sent nor received on a frozen channel, while acknowledgements and timeouts of packets
+// already sent are still processed. The channel state is left unchanged.
+func (k Keeper) FreezeChannel(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State == types.UNINITIALIZED || channel.State == types.CLOSED {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel cannot be frozen (got %s)", channel.State.String())
+	}
+
+	if k.IsChannelFrozen(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelFrozen, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.SetChannelFrozen(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel frozen", "port-id", portID, "channel-id", channelID)
+
+	EmitChannelFrozenEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
+
+// UnfreezeChannel is called by governance to unfreeze a channel end previously frozen
+// with FreezeChannel.
+func (k Keeper) UnfreezeChannel(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !k.IsChannelFrozen(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelNotFrozen, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.deleteChannelFrozen(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel unfrozen", "port-id", portID, "channel-id", channelID)
+
+	EmitChannelUnfrozenEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestChanForceClose() {
+	var path *ibctesting.Path
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"success: channel is frozen", func() {
+			suite.Require().NoError(path.EndpointA.FreezeChannel())
+		}, true},
+		{"success: channel is being upgraded", func() {
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+		}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"channel is already CLOSED", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = suite.newUpgradePath(types.UNORDERED)
+
+			tc.malleate()
+
+			err := path.EndpointA.ChanForceClose()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+				suite.Require().False(channelKeeper.IsChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				_, found := channelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanForceCloseTimeoutOnClose tests that packets in-flight on the counterparty of a channel
+// closed by governance can be timed out once the counterparty channel end is closed.
+func (suite *KeeperTestSuite) TestChanForceCloseTimeoutOnClose() {
+	for _, order := range []types.Order{types.UNORDERED, types.ORDERED} {
+		suite.Run(order.String(), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = order
+			path.EndpointB.ChannelConfig.Order = order
+			suite.coordinator.Setup(path)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointB.SendPacket(packet))
+
+			suite.Require().NoError(path.EndpointA.ChanForceClose())
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			suite.Require().NoError(path.EndpointB.TimeoutOnClose(packet))
+
+			commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainB.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Nil(commitment)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFreezeChannel() {
+	var path *ibctesting.Path
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"channel is CLOSED", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, false},
+		{"channel is already frozen", func() {
+			suite.Require().NoError(path.EndpointA.FreezeChannel())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+
+			err := path.EndpointA.FreezeChannel()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				// the channel state is left unchanged
+				suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnfreezeChannel() {
+	var path *ibctesting.Path
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"channel is not frozen", func() {
+			suite.Require().NoError(path.EndpointA.UnfreezeChannel())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.Require().NoError(path.EndpointA.FreezeChannel())
+
+			tc.malleate()
+
+			err := path.EndpointA.UnfreezeChannel()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestFrozenChannelPacketFlow tests that packets sent before a channel is frozen may still be
+// acknowledged, and that packets can be sent and received again once the channel is unfrozen.
+func (suite *KeeperTestSuite) TestFrozenChannelPacketFlow() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+	suite.Require().NoError(path.EndpointA.FreezeChannel())
+
+	// the acknowledgement of a packet sent before the channel was frozen is processed
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement))
+
+	packet = types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().ErrorIs(path.EndpointA.SendPacket(packet), types.ErrChannelFrozen)
+
+	suite.Require().NoError(path.EndpointA.UnfreezeChannel())
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+}
+
+func (suite *KeeperTestSuite) TestGetAllFrozenChannels() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	suite.Require().Empty(channelKeeper.GetAllFrozenChannels(suite.chainA.GetContext()))
+
+	suite.Require().NoError(path.EndpointA.FreezeChannel())
+
+	expFrozenChannels := []types.FrozenChannel{types.NewFrozenChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)}
+	suite.Require().Equal(expFrozenChannels, channelKeeper.GetAllFrozenChannels(suite.chainA.GetContext()))
+}
@@ -420,7 +420,9 @@ func (q Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceivedP
 				unreceivedSequences = append(unreceivedSequences, seq)
 			}
 		}
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
+		// packets received after their timeout on ORDERED_ALLOW_TIMEOUT channels have
+		// been processed by writing a timeout receipt and are not unreceived
 		nextSequenceRecv, found := q.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
@@ -529,6 +531,230 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSeque
 	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, selfHeight), nil
 }
 
+// Upgrade implements the Query/Upgrade gRPC method
+func (q Keeper) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*types.QueryUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	upgrade, found := q.GetUpgrade(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
+}
+
+// UpgradeError implements the Query/UpgradeError gRPC method
+func (q Keeper) UpgradeError(c context.Context, req *types.QueryUpgradeErrorRequest) (*types.QueryUpgradeErrorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	errorReceipt, found := q.GetUpgradeErrorReceipt(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrUpgradeErrorNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryUpgradeErrorResponse(errorReceipt, nil, selfHeight), nil
+}
+
+// PrunableAcknowledgements implements the Query/PrunableAcknowledgements gRPC method
+func (q Keeper) PrunableAcknowledgements(c context.Context, req *types.QueryPrunableAcknowledgementsRequest) (*types.QueryPrunableAcknowledgementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	start, end, err := q.GetPruningSequences(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	var totalRemaining uint64
+	if end > start {
+		totalRemaining = end - start
+	}
+
+	return &types.QueryPrunableAcknowledgementsResponse{
+		PruningSequenceStart:    start,
+		PruningSequenceEnd:      end,
+		TotalRemainingSequences: totalRemaining,
+	}, nil
+}
+
+// FrozenChannels implements the Query/FrozenChannels gRPC method
+func (q Keeper) FrozenChannels(c context.Context, req *types.QueryFrozenChannelsRequest) (*types.QueryFrozenChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	frozenChannels := []types.FrozenChannel{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelFrozen))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		portID, channelID, err := host.ParseChannelPath(string(key))
+		if err != nil {
+			return err
+		}
+
+		frozenChannels = append(frozenChannels, types.NewFrozenChannel(portID, channelID))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryFrozenChannelsResponse{
+		FrozenChannels: frozenChannels,
+		Pagination:     pageRes,
+		Height:         selfHeight,
+	}, nil
+}
+
+// ChannelStatistics implements the Query/ChannelStatistics gRPC method
+func (q Keeper) ChannelStatistics(c context.Context, req *types.QueryChannelStatisticsRequest) (*types.QueryChannelStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	statistics := []types.ChannelStatistics{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyChannelStatistics))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.ChannelStatistics
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		statistics = append(statistics, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryChannelStatisticsResponse{
+		Statistics: statistics,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// PacketStatus implements the Query/PacketStatus gRPC method
+func (q Keeper) PacketStatus(c context.Context, req *types.QueryPacketStatusRequest) (*types.QueryPacketStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	nextSequenceSend, _ := q.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	nextSequenceRecv, _ := q.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketStatusResponse{
+		PacketStatus:     q.GetPacketStatus(ctx, channel, req.PortId, req.ChannelId, req.Sequence),
+		NextSequenceSend: nextSequenceSend,
+		NextSequenceRecv: nextSequenceRecv,
+		Height:           selfHeight,
+	}, nil
+}
+
+// PacketStatuses implements the Query/PacketStatuses gRPC method
+func (q Keeper) PacketStatuses(c context.Context, req *types.QueryPacketStatusesRequest) (*types.QueryPacketStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.StartSequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	if req.EndSequence < req.StartSequence {
+		return nil, status.Errorf(codes.InvalidArgument, "end sequence (%d) cannot be less than start sequence (%d)", req.EndSequence, req.StartSequence)
+	}
+
+	if req.EndSequence-req.StartSequence >= types.MaxPacketStatusesRange {
+		return nil, status.Errorf(codes.InvalidArgument, "sequence range cannot exceed %d sequences", types.MaxPacketStatusesRange)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	// the sequences are iterated by offset from the start sequence, as the end sequence may be the maximum sequence
+	packetStatuses := make([]types.PacketStatusInfo, 0, req.EndSequence-req.StartSequence+1)
+	for i := uint64(0); i <= req.EndSequence-req.StartSequence; i++ {
+		packetStatuses = append(packetStatuses, q.GetPacketStatus(ctx, channel, req.PortId, req.ChannelId, req.StartSequence+i))
+	}
+
+	nextSequenceSend, _ := q.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	nextSequenceRecv, _ := q.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketStatusesResponse{
+		PacketStatuses:   packetStatuses,
+		NextSequenceSend: nextSequenceSend,
+		NextSequenceRecv: nextSequenceRecv,
+		Height:           selfHeight,
+	}, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
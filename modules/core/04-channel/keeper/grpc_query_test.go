@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
 )
 
 func (suite *KeeperTestSuite) TestQueryChannel() {
@@ -1311,6 +1313,27 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 			},
 			true,
 		},
+		{
+			"basic success multiple unreceived packet commitments, ordered allow timeout channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrderedAllowTimeout()
+				suite.coordinator.Setup(path)
+
+				// Packet sequence 3 timed out and has a timeout receipt, it is considered received.
+				expSeq = []uint64{7, 9, 10}
+				packetCommitments := []uint64{3, 7, 9, 10}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 5)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketTimeoutReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 3)
+
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: packetCommitments,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1546,3 +1569,535 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPrunableAcknowledgements() {
+	var (
+		req    *types.QueryPrunableAcknowledgementsRequest
+		expRes *types.QueryPrunableAcknowledgementsResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPrunableAcknowledgementsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPrunableAcknowledgementsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success: timeout horizon has not been advanced",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				expRes = &types.QueryPrunableAcknowledgementsResponse{
+					PruningSequenceStart:    1,
+					PruningSequenceEnd:      0,
+					TotalRemainingSequences: 0,
+				}
+
+				req = &types.QueryPrunableAcknowledgementsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 3)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+				expRes = &types.QueryPrunableAcknowledgementsResponse{
+					PruningSequenceStart:    3,
+					PruningSequenceEnd:      10,
+					TotalRemainingSequences: 7,
+				}
+
+				req = &types.QueryPrunableAcknowledgementsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PrunableAcknowledgements(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFrozenChannels() {
+	var (
+		req               *types.QueryFrozenChannelsRequest
+		expFrozenChannels []types.FrozenChannel
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no frozen channels",
+			func() {
+				expFrozenChannels = []types.FrozenChannel{}
+				req = &types.QueryFrozenChannelsRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path1)
+
+				suite.Require().NoError(path.EndpointA.FreezeChannel())
+				suite.Require().NoError(path1.EndpointA.FreezeChannel())
+
+				expFrozenChannels = []types.FrozenChannel{
+					types.NewFrozenChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+					types.NewFrozenChannel(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID),
+				}
+
+				req = &types.QueryFrozenChannelsRequest{
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.FrozenChannels(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expFrozenChannels, res.FrozenChannels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelStatistics() {
+	var (
+		req           *types.QueryChannelStatisticsRequest
+		expStatistics []types.ChannelStatistics
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no statistics",
+			func() {
+				expStatistics = []types.ChannelStatistics{}
+				req = &types.QueryChannelStatisticsRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path1)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+				packet1 := types.NewPacket(ibctesting.MockPacketData, 1, path1.EndpointB.ChannelConfig.PortID, path1.EndpointB.ChannelID, path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path1.EndpointB.SendPacket(packet1))
+				suite.Require().NoError(path1.EndpointA.RecvPacket(packet1))
+
+				statistics, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelStatistics(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), statistics.PacketsSent)
+
+				statistics1, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelStatistics(suite.chainA.GetContext(), path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), statistics1.PacketsReceived)
+				suite.Require().Equal(uint64(1), statistics1.AcknowledgementsSuccess)
+
+				expStatistics = []types.ChannelStatistics{statistics, statistics1}
+
+				req = &types.QueryChannelStatisticsRequest{
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ChannelStatistics(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatistics, res.Statistics)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketStatus() {
+	var (
+		path      *ibctesting.Path
+		req       *types.QueryPacketStatusRequest
+		expStatus types.PacketStatus
+	)
+
+	// recvPacket sends a packet from chainB and receives it on chainA
+	recvPacket := func(data []byte, timeoutTimestamp uint64) types.Packet {
+		packet := types.NewPacket(data, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, timeoutTimestamp)
+		suite.Require().NoError(path.EndpointB.SendPacket(packet))
+		suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+		return packet
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req.Sequence = 0
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"success: unknown",
+			func() {
+				expStatus = types.UNKNOWN
+			},
+			true,
+		},
+		{
+			"success: committed",
+			func() {
+				packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointA.SendPacket(packet))
+				expStatus = types.COMMITTED
+			},
+			true,
+		},
+		{
+			"success: unknown once acknowledged on the sending chain",
+			func() {
+				packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointA.SendPacket(packet))
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+				suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement))
+				expStatus = types.UNKNOWN
+			},
+			true,
+		},
+		{
+			"success: received",
+			func() {
+				recvPacket(ibcmock.MockAsyncPacketData, disabledTimeoutTimestamp)
+				expStatus = types.RECEIVED
+			},
+			true,
+		},
+		{
+			"success: received on ORDERED channel",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				suite.coordinator.Setup(path)
+				req = &types.QueryPacketStatusRequest{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Sequence: 1}
+
+				recvPacket(ibcmock.MockAsyncPacketData, disabledTimeoutTimestamp)
+				expStatus = types.RECEIVED
+			},
+			true,
+		},
+		{
+			"success: acknowledged",
+			func() {
+				recvPacket(ibctesting.MockPacketData, disabledTimeoutTimestamp)
+				expStatus = types.ACKNOWLEDGED
+			},
+			true,
+		},
+		{
+			"success: timed out on ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrderedAllowTimeout()
+				suite.coordinator.Setup(path)
+				req = &types.QueryPacketStatusRequest{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Sequence: 1}
+
+				recvPacket(ibctesting.MockPacketData, uint64(suite.chainA.GetContext().BlockTime().UnixNano()))
+				expStatus = types.TIMEDOUT
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			req = &types.QueryPacketStatusRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketStatus(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.PacketStatus.Status)
+
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				suite.Require().Equal(channelKeeper.GetPacketCommitment(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.Sequence), res.PacketStatus.Commitment)
+
+				nextSequenceSend, _ := channelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), req.PortId, req.ChannelId)
+				suite.Require().Equal(nextSequenceSend, res.NextSequenceSend)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketStatuses() {
+	var (
+		path        *ibctesting.Path
+		req         *types.QueryPacketStatusesRequest
+		expStatuses []types.PacketStatus
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid start sequence",
+			func() {
+				req.StartSequence = 0
+			},
+			false,
+		},
+		{
+			"end sequence less than start sequence",
+			func() {
+				req.StartSequence = 3
+				req.EndSequence = 2
+			},
+			false,
+		},
+		{
+			"sequence range too large",
+			func() {
+				req.EndSequence = req.StartSequence + types.MaxPacketStatusesRange
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointB.SendPacket(packet))
+				suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+
+				packet = types.NewPacket(ibcmock.MockAsyncPacketData, 2, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointB.SendPacket(packet))
+				suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+
+				// the commitment of the packet sent with sequence 1 takes precedence over the acknowledgement
+				expStatuses = []types.PacketStatus{types.COMMITTED, types.RECEIVED, types.UNKNOWN, types.UNKNOWN}
+			},
+			true,
+		},
+		{
+			"success: range ends at the maximum sequence",
+			func() {
+				packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+				req.StartSequence = math.MaxUint64 - 5
+				req.EndSequence = math.MaxUint64
+				expStatuses = []types.PacketStatus{types.UNKNOWN, types.UNKNOWN, types.UNKNOWN, types.UNKNOWN, types.UNKNOWN, types.UNKNOWN}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			req = &types.QueryPacketStatusesRequest{
+				PortId:        path.EndpointA.ChannelConfig.PortID,
+				ChannelId:     path.EndpointA.ChannelID,
+				StartSequence: 1,
+				EndSequence:   4,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PacketStatuses(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Len(res.PacketStatuses, len(expStatuses))
+
+				for i, packetStatus := range res.PacketStatuses {
+					suite.Require().Equal(req.StartSequence+uint64(i), packetStatus.Sequence)
+					suite.Require().Equal(expStatuses[i], packetStatus.Status)
+				}
+
+				suite.Require().Equal(uint64(2), res.NextSequenceSend)
+				suite.Require().Equal(uint64(1), res.NextSequenceRecv)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
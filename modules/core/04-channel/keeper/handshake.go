@@ -82,6 +82,7 @@ func (k Keeper) WriteOpenInitChannel(
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 	k.SetNextSequenceAck(ctx, portID, channelID, 1)
+	k.SetPruningSequenceStart(ctx, portID, channelID, 1)
 
 	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "NONE", "new-state", "INIT")
 
@@ -235,6 +236,7 @@ func (k Keeper) WriteOpenTryChannel(
 		k.SetNextSequenceSend(ctx, portID, channelID, 1)
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
+		k.SetPruningSequenceStart(ctx, portID, channelID, 1)
 	}
 
 	channel := types.NewChannel(types.TRYOPEN, order, counterparty, connectionHops, version)
@@ -479,6 +481,7 @@ func (k Keeper) ChanCloseConfirm(
 	chanCap *capabilitytypes.Capability,
 	proofInit []byte,
 	proofHeight exported.Height,
+	counterpartyUpgradeSequence uint64,
 ) error {
 	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
 		return sdkerrors.Wrap(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)")
@@ -512,6 +515,7 @@ func (k Keeper) ChanCloseConfirm(
 		types.CLOSED, channel.Ordering, counterparty,
 		counterpartyHops, channel.Version,
 	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx, connectionEnd, proofHeight, proofInit,
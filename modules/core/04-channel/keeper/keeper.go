@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"strconv"
 	"strings"
 
@@ -86,6 +87,17 @@ func (k Keeper) SetChannel(ctx sdk.Context, portID, channelID string, channel ty
 	store.Set(host.ChannelKey(portID, channelID), bz)
 }
 
+// GetAppVersion returns the version of the channel with the given port and channel identifiers. It
+// implements the ICS4Wrapper interface of the applications and middleware built on top of core IBC.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", false
+	}
+
+	return channel.Version, true
+}
+
 // GetNextChannelSequence gets the next channel sequence from the store.
 func (k Keeper) GetNextChannelSequence(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -175,6 +187,18 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+// SetPacketTimeoutReceipt sets a packet receipt marking a timed-out packet to the store
+func (k Keeper) SetPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.PacketTimeoutReceipt)
+}
+
+// deletePacketReceipt deletes a packet receipt from the store
+func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -215,6 +239,12 @@ func (k Keeper) GetPacketAcknowledgement(ctx sdk.Context, portID, channelID stri
 	return bz, true
 }
 
+// deletePacketAcknowledgement deletes the packet ack hash from the store
+func (k Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+}
+
 // HasPacketAcknowledgement check if the packet ack hash is already on the store
 func (k Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -440,6 +470,322 @@ func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string)
 	return porttypes.GetModuleOwner(modules), cap, nil
 }
 
+// GetUpgrade returns the proposed upgrade for the provided port and channel identifiers.
+func (k Keeper) GetUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+	return upgrade, true
+}
+
+// SetUpgrade sets the proposed upgrade using the provided port and channel identifiers.
+func (k Keeper) SetUpgrade(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelUpgradeKey(portID, channelID), bz)
+}
+
+// deleteUpgrade deletes the upgrade for the provided port and channel identifiers.
+func (k Keeper) deleteUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelUpgradeKey(portID, channelID))
+}
+
+// GetCounterpartyUpgrade returns the counterparty upgrade for the provided port and channel identifiers.
+func (k Keeper) GetCounterpartyUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelCounterpartyUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+	return upgrade, true
+}
+
+// SetCounterpartyUpgrade sets the counterparty upgrade in the store for the provided port and channel identifiers.
+func (k Keeper) SetCounterpartyUpgrade(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelCounterpartyUpgradeKey(portID, channelID), bz)
+}
+
+// deleteCounterpartyUpgrade deletes the counterparty upgrade in the store for the provided port and channel identifiers.
+func (k Keeper) deleteCounterpartyUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelCounterpartyUpgradeKey(portID, channelID))
+}
+
+// deleteUpgradeInfo deletes all auxiliary upgrade information.
+func (k Keeper) deleteUpgradeInfo(ctx sdk.Context, portID, channelID string) {
+	k.deleteUpgrade(ctx, portID, channelID)
+	k.deleteCounterpartyUpgrade(ctx, portID, channelID)
+}
+
+// GetUpgradeErrorReceipt returns the upgrade error receipt for the provided port and channel identifiers.
+func (k Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string) (types.ErrorReceipt, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeErrorKey(portID, channelID))
+	if bz == nil {
+		return types.ErrorReceipt{}, false
+	}
+
+	var errorReceipt types.ErrorReceipt
+	k.cdc.MustUnmarshal(bz, &errorReceipt)
+	return errorReceipt, true
+}
+
+// setUpgradeErrorReceipt sets the provided error receipt in store using the port and channel identifiers.
+func (k Keeper) setUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string, errorReceipt types.ErrorReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&errorReceipt)
+	store.Set(host.ChannelUpgradeErrorKey(portID, channelID), bz)
+}
+
+// GetRecvStartSequence gets a channel's recv start sequence from the store.
+// The recv start sequence is set to the counterparty next sequence send when a
+// channel upgrade completes. Packets below it have all been received or timed out.
+func (k Keeper) GetRecvStartSequence(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.RecvStartSequenceKey(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetRecvStartSequence sets a channel's recv start sequence to the store.
+func (k Keeper) SetRecvStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.RecvStartSequenceKey(portID, channelID), bz)
+}
+
+// GetPruningSequenceStart gets a channel's pruning sequence start from the store.
+// The pruning sequence start is the next sequence whose acknowledgement and receipt
+// are to be pruned.
+func (k Keeper) GetPruningSequenceStart(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PruningSequenceStartKey(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPruningSequenceStart sets a channel's pruning sequence start to the store.
+func (k Keeper) SetPruningSequenceStart(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.PruningSequenceStartKey(portID, channelID), bz)
+}
+
+// IteratePruningSequenceStarts provides an iterator over the pruning sequence start of all
+// channels which may be pruned. For each channel, cb will be called. If the cb returns true,
+// the iterator will close and stop.
+func (k Keeper) IteratePruningSequenceStarts(ctx sdk.Context, cb func(portID, channelID string, sequence uint64) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPruningSequenceStart))
+	k.IteratePacketSequence(ctx, iterator, cb)
+}
+
+// IteratePruningSequenceStartsFrom provides an iterator over the pruning sequence start of the
+// channels whose pruning sequence start key is not lower than the provided cursor, starting at
+// the first channel if the cursor is nil. If cb returns true the iteration stops and the
+// pruning sequence start key of the next channel is returned. Nil is returned if the
+// iteration reaches the last channel.
+func (k Keeper) IteratePruningSequenceStartsFrom(ctx sdk.Context, cursor []byte, cb func(portID, channelID string, sequence uint64) bool) []byte {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := []byte(host.KeyPruningSequenceStart)
+	if cursor == nil {
+		cursor = keyPrefix
+	}
+
+	iterator := store.Iterator(cursor, sdk.PrefixEndBytes(keyPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := host.ParseChannelPath(string(iterator.Key()))
+		if err != nil {
+			// return if the key is not a channel key
+			return nil
+		}
+
+		if cb(portID, channelID, sdk.BigEndianToUint64(iterator.Value())) {
+			iterator.Next()
+			if !iterator.Valid() {
+				return nil
+			}
+
+			return append([]byte(nil), iterator.Key()...)
+		}
+	}
+
+	return nil
+}
+
+// GetPruningCursor gets the pruning sequence start key of the channel at which the pruning
+// sweep of the next block starts. Nil is returned if the sweep starts at the first channel.
+func (k Keeper) GetPruningCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get([]byte(types.KeyPruningCursor))
+}
+
+// SetPruningCursor sets the pruning sequence start key of the channel at which the pruning
+// sweep of the next block starts. The cursor is deleted if it is nil.
+func (k Keeper) SetPruningCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if cursor == nil {
+		store.Delete([]byte(types.KeyPruningCursor))
+		return
+	}
+
+	store.Set([]byte(types.KeyPruningCursor), cursor)
+}
+
+// GetAllPruningSequenceStarts returns the pruning sequence start of all channels.
+func (k Keeper) GetAllPruningSequenceStarts(ctx sdk.Context) (seqs []types.PacketSequence) {
+	k.IteratePruningSequenceStarts(ctx, func(portID, channelID string, sequence uint64) bool {
+		seqs = append(seqs, types.NewPacketSequence(portID, channelID, sequence))
+		return false
+	})
+	return seqs
+}
+
+// GetAllRecvStartSequences returns the recv start sequence of all channels.
+func (k Keeper) GetAllRecvStartSequences(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyRecvStartSequence))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, sequence uint64) bool {
+		seqs = append(seqs, types.NewPacketSequence(portID, channelID, sequence))
+		return false
+	})
+	return seqs
+}
+
+// IsChannelFrozen returns true if the channel has been frozen by governance, and false otherwise.
+func (k Keeper) IsChannelFrozen(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ChannelFrozenKey(portID, channelID))
+}
+
+// SetChannelFrozen marks the channel as frozen in the store.
+func (k Keeper) SetChannelFrozen(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.ChannelFrozenKey(portID, channelID), []byte{byte(1)})
+}
+
+// deleteChannelFrozen removes the frozen flag of the channel from the store.
+func (k Keeper) deleteChannelFrozen(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelFrozenKey(portID, channelID))
+}
+
+// IterateFrozenChannels provides an iterator over all channels frozen by governance.
+// For each frozen channel, cb will be called. If the cb returns true, the iterator
+// will close and stop.
+func (k Keeper) IterateFrozenChannels(ctx sdk.Context, cb func(frozenChannel types.FrozenChannel) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyChannelFrozen))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		if cb(types.NewFrozenChannel(portID, channelID)) {
+			break
+		}
+	}
+}
+
+// GetAllFrozenChannels returns all channels frozen by governance.
+func (k Keeper) GetAllFrozenChannels(ctx sdk.Context) []types.FrozenChannel {
+	frozenChannels := []types.FrozenChannel{}
+	k.IterateFrozenChannels(ctx, func(frozenChannel types.FrozenChannel) bool {
+		frozenChannels = append(frozenChannels, frozenChannel)
+		return false
+	})
+
+	return frozenChannels
+}
+
+// GetChannelStatistics returns the packet lifecycle statistics of the channel.
+func (k Keeper) GetChannelStatistics(ctx sdk.Context, portID, channelID string) (types.ChannelStatistics, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelStatisticsKey(portID, channelID))
+	if bz == nil {
+		return types.ChannelStatistics{}, false
+	}
+
+	var statistics types.ChannelStatistics
+	k.cdc.MustUnmarshal(bz, &statistics)
+
+	return statistics, true
+}
+
+// SetChannelStatistics sets the packet lifecycle statistics of a channel to the store.
+func (k Keeper) SetChannelStatistics(ctx sdk.Context, statistics types.ChannelStatistics) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&statistics)
+	store.Set(host.ChannelStatisticsKey(statistics.PortId, statistics.ChannelId), bz)
+}
+
+// updateChannelStatistics applies the provided update to the packet lifecycle statistics of the
+// channel, initializing them if the channel has no statistics yet.
+func (k Keeper) updateChannelStatistics(ctx sdk.Context, portID, channelID string, update func(statistics *types.ChannelStatistics)) {
+	statistics, found := k.GetChannelStatistics(ctx, portID, channelID)
+	if !found {
+		statistics = types.NewChannelStatistics(portID, channelID)
+	}
+
+	update(&statistics)
+	k.SetChannelStatistics(ctx, statistics)
+}
+
+// IterateChannelStatistics provides an iterator over the packet lifecycle statistics of all channels.
+// For each channel, cb will be called. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateChannelStatistics(ctx sdk.Context, cb func(statistics types.ChannelStatistics) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyChannelStatistics))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var statistics types.ChannelStatistics
+		k.cdc.MustUnmarshal(iterator.Value(), &statistics)
+
+		if cb(statistics) {
+			break
+		}
+	}
+}
+
+// GetAllChannelStatistics returns the packet lifecycle statistics of all channels.
+func (k Keeper) GetAllChannelStatistics(ctx sdk.Context) []types.ChannelStatistics {
+	statistics := []types.ChannelStatistics{}
+	k.IterateChannelStatistics(ctx, func(cs types.ChannelStatistics) bool {
+		statistics = append(statistics, cs)
+		return false
+	})
+
+	return statistics
+}
+
+// HasInflightPackets returns true if there are packet commitments stored at the specified
+// port and channel, and false otherwise.
+func (k Keeper) HasInflightPackets(ctx sdk.Context, portID, channelID string) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte(host.PacketCommitmentPrefixPath(portID, channelID)))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
 // common functionality for IteratePacketCommitment and IteratePacketAcknowledgement
 func (k Keeper) iterateHashes(_ sdk.Context, iterator db.Iterator, cb func(portID, channelID string, sequence uint64, hash []byte) bool) {
 	defer iterator.Close()
@@ -459,3 +805,45 @@ func (k Keeper) iterateHashes(_ sdk.Context, iterator db.Iterator, cb func(portI
 		}
 	}
 }
+
+// GetPacketStatus resolves the lifecycle status of a packet sequence on a channel end from the
+// packet state stored on the local chain. The commitment of a packet sent on the channel end
+// takes precedence over the state of a packet received with the same sequence. Packets received
+// on ORDERED and ORDERED_ALLOW_TIMEOUT channels have no receipt: a sequence below the next
+// sequence to be received, whose acknowledgement was neither written nor pruned, is received.
+func (k Keeper) GetPacketStatus(ctx sdk.Context, channel types.Channel, portID, channelID string, sequence uint64) types.PacketStatusInfo {
+	info := types.PacketStatusInfo{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Sequence:   sequence,
+		Status:     types.UNKNOWN,
+		Commitment: k.GetPacketCommitment(ctx, portID, channelID, sequence),
+	}
+
+	if receipt, found := k.GetPacketReceipt(ctx, portID, channelID, sequence); found {
+		info.Receipt = []byte(receipt)
+	}
+
+	if acknowledgement, found := k.GetPacketAcknowledgement(ctx, portID, channelID, sequence); found {
+		info.Acknowledgement = acknowledgement
+	}
+
+	switch {
+	case len(info.Commitment) != 0:
+		info.Status = types.COMMITTED
+	case bytes.Equal(info.Receipt, types.PacketTimeoutReceipt):
+		info.Status = types.TIMEDOUT
+	case len(info.Acknowledgement) != 0:
+		info.Status = types.ACKNOWLEDGED
+	case len(info.Receipt) != 0:
+		info.Status = types.RECEIVED
+	case channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT:
+		nextSequenceRecv, _ := k.GetNextSequenceRecv(ctx, portID, channelID)
+		pruningSequenceStart, _ := k.GetPruningSequenceStart(ctx, portID, channelID)
+		if sequence < nextSequenceRecv && sequence >= pruningSequenceStart {
+			info.Status = types.RECEIVED
+		}
+	}
+
+	return info
+}
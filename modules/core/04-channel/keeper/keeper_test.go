@@ -62,6 +62,22 @@ func (suite *KeeperTestSuite) TestSetChannel() {
 	suite.Equal(expectedCounterparty, storedChannel.Counterparty)
 }
 
+func (suite *KeeperTestSuite) TestGetAppVersion() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	version, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAppVersion(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+	suite.Require().Empty(version)
+
+	err := path.EndpointA.ChanOpenInit()
+	suite.Require().NoError(err)
+
+	version, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAppVersion(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.Version, version)
+}
+
 // TestGetAllChannels creates multiple channels on chain A through various connections
 // and tests their retrieval. 2 channels are on connA0 and 1 channel is on connA1
 func (suite KeeperTestSuite) TestGetAllChannels() {
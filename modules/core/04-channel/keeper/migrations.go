@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration initializes the packet lifecycle statistics of every channel from its stored sequences:
+// - the packets sent are set from the next sequence send
+// - the packets received and acknowledged are set from the next sequence recv and ack of ORDERED and
+// ORDERED_ALLOW_TIMEOUT channels, and the packets received of UNORDERED channels from the stored receipts
+// The remaining counters and the last activity heights cannot be derived from the store and start at zero.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	receipts := make(map[string]uint64)
+	m.keeper.IteratePacketReceipt(ctx, func(portID, channelID string, _ uint64, _ []byte) bool {
+		receipts[portID+"/"+channelID]++
+		return false
+	})
+
+	channels := m.keeper.GetAllChannels(ctx)
+	for _, channel := range channels {
+		statistics := types.NewChannelStatistics(channel.PortId, channel.ChannelId)
+
+		if nextSequenceSend, found := m.keeper.GetNextSequenceSend(ctx, channel.PortId, channel.ChannelId); found && nextSequenceSend > 0 {
+			statistics.PacketsSent = nextSequenceSend - 1
+		}
+
+		switch channel.Ordering {
+		case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
+			if nextSequenceRecv, found := m.keeper.GetNextSequenceRecv(ctx, channel.PortId, channel.ChannelId); found && nextSequenceRecv > 0 {
+				statistics.PacketsReceived = nextSequenceRecv - 1
+			}
+
+			if nextSequenceAck, found := m.keeper.GetNextSequenceAck(ctx, channel.PortId, channel.ChannelId); found && nextSequenceAck > 0 {
+				statistics.PacketsAcknowledged = nextSequenceAck - 1
+			}
+		case types.UNORDERED:
+			statistics.PacketsReceived = receipts[channel.PortId+"/"+channel.ChannelId]
+		}
+
+		m.keeper.SetChannelStatistics(ctx, statistics)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully initialized channel statistics", "channels", len(channels))
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// This migration initializes the pruning sequence start of every channel which has never been upgraded
+// to the first sequence, such that the acknowledgements and receipts of existing channels may be pruned.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var initialized int
+	for _, channel := range m.keeper.GetAllChannels(ctx) {
+		if _, found := m.keeper.GetPruningSequenceStart(ctx, channel.PortId, channel.ChannelId); found {
+			continue
+		}
+
+		m.keeper.SetPruningSequenceStart(ctx, channel.PortId, channel.ChannelId, 1)
+		initialized++
+	}
+
+	m.keeper.Logger(ctx).Info("successfully initialized pruning sequence starts", "channels", initialized)
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	for _, order := range []types.Order{types.UNORDERED, types.ORDERED, types.ORDERED_ALLOW_TIMEOUT} {
+		suite.Run(order.String(), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = order
+			path.EndpointB.ChannelConfig.Order = order
+			suite.coordinator.Setup(path)
+
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.EndpointA.SendPacket(packet))
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				if sequence < 3 {
+					suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement))
+				}
+			}
+
+			// remove the statistics recorded by the packet flow to mimic a store written before they were introduced
+			for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+				ctx := chain.GetContext()
+				store := ctx.KVStore(chain.GetSimApp().GetKey(host.StoreKey))
+				for _, statistics := range chain.App.GetIBCKeeper().ChannelKeeper.GetAllChannelStatistics(ctx) {
+					store.Delete(host.ChannelStatisticsKey(statistics.PortId, statistics.ChannelId))
+				}
+			}
+
+			for _, chain := range []*ibctesting.TestChain{suite.chainA, suite.chainB} {
+				migrator := keeper.NewMigrator(chain.App.GetIBCKeeper().ChannelKeeper)
+				suite.Require().NoError(migrator.Migrate2to3(chain.GetContext()))
+			}
+
+			statisticsA, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelStatistics(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(3), statisticsA.PacketsSent)
+			suite.Require().Zero(statisticsA.LastSendHeight)
+
+			statisticsB, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannelStatistics(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(3), statisticsB.PacketsReceived)
+
+			if order == types.UNORDERED {
+				// acknowledgements cannot be derived from the sequences of UNORDERED channels
+				suite.Require().Zero(statisticsA.PacketsAcknowledged)
+			} else {
+				suite.Require().Equal(uint64(2), statisticsA.PacketsAcknowledged)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathA)
+
+	pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(pathB)
+
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	// remove the pruning sequence start of the first channel to mimic a channel opened before it was initialized
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey))
+	store.Delete(host.PruningSequenceStartKey(pathA.EndpointA.ChannelConfig.PortID, pathA.EndpointA.ChannelID))
+
+	// the pruning sequence start of the second channel has already advanced
+	channelKeeper.SetPruningSequenceStart(ctx, pathB.EndpointA.ChannelConfig.PortID, pathB.EndpointA.ChannelID, 5)
+
+	migrator := keeper.NewMigrator(channelKeeper)
+	suite.Require().NoError(migrator.Migrate3to4(ctx))
+
+	pruningSequenceStart, found := channelKeeper.GetPruningSequenceStart(ctx, pathA.EndpointA.ChannelConfig.PortID, pathA.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), pruningSequenceStart)
+
+	pruningSequenceStart, found = channelKeeper.GetPruningSequenceStart(ctx, pathB.EndpointA.ChannelConfig.PortID, pathB.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(5), pruningSequenceStart)
+}
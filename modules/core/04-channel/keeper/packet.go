@@ -38,6 +38,18 @@ func (k Keeper) SendPacket(
 		)
 	}
 
+	// packets cannot be sent while in-flight packets are being flushed for a channel upgrade
+	if channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel is being upgraded (got %s)", channel.State.String(),
+		)
+	}
+
+	if k.IsChannelFrozen(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return sdkerrors.Wrapf(types.ErrChannelFrozen, "port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
@@ -124,6 +136,11 @@ func (k Keeper) SendPacket(
 	k.SetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceSend)
 	k.SetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment)
 
+	k.updateChannelStatistics(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), func(statistics *types.ChannelStatistics) {
+		statistics.PacketsSent++
+		statistics.LastSendHeight = uint64(ctx.BlockHeight())
+	})
+
 	EmitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
 	k.Logger(ctx).Info(
@@ -147,6 +164,28 @@ func GetPacketTimeoutErrorMessage(message string, latestTimestamp uint64, timeou
 	)
 }
 
+// packetTimeoutError returns an error if the packet timed out according to the
+// latest height or timestamp of this chain.
+func packetTimeoutError(ctx sdk.Context, packet exported.PacketI) error {
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	timeoutHeight := packet.GetTimeoutHeight()
+	if !timeoutHeight.IsZero() && selfHeight.GTE(timeoutHeight) {
+		return sdkerrors.Wrapf(
+			types.ErrPacketTimeout,
+			"block height >= packet timeout height (%s >= %s)", selfHeight, timeoutHeight,
+		)
+	}
+
+	if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
+		return GetPacketTimeoutErrorMessage(
+			"block timestamp >= packet timeout timestamp (%d >= %d)",
+			uint64(ctx.BlockTime().UTC().UnixNano()),
+			packet.GetTimeoutTimestamp())
+	}
+
+	return nil
+}
+
 // RecvPacket is called by a module in order to receive & process an IBC packet
 // sent on the corresponding channel end on the counterparty chain.
 func (k Keeper) RecvPacket(
@@ -155,19 +194,135 @@ func (k Keeper) RecvPacket(
 	packet exported.PacketI,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.recvPacket(ctx, chanCap, packet, func(connectionEnd exported.ConnectionI) error {
+		commitment := types.CommitPacket(k.cdc, packet)
+
+		// verify that the counterparty did commit to sending this packet
+		if err := k.connectionKeeper.VerifyPacketCommitment(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+			commitment,
+		); err != nil {
+			return sdkerrors.Wrap(err, "couldn't verify counterparty packet commitment")
+		}
+
+		return nil
+	})
+}
+
+// RecvPackets is called by a module in order to receive & process a batch of IBC packets
+// sent on the corresponding channel end on the counterparty chain, whose commitments are
+// proven by a single batch proof. The batch proof is verified once, before each packet is
+// received in order as by RecvPacket. The state changes of a packet are only written if it
+// is received or if a timeout receipt is written in its place. The result of receiving each
+// packet is returned, which is nil, ErrNoOpMsg or ErrTimeoutReceiptWritten; any other error
+// fails the whole batch.
+func (k Keeper) RecvPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []exported.PacketI,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]error, error) {
+	if len(packets) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	destPort, destChannel := packets[0].GetDestPort(), packets[0].GetDestChannel()
+	channel, found := k.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrChannelNotFound, destChannel)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	commitments := make(map[uint64][]byte, len(packets))
+	for _, packet := range packets {
+		if packet.GetDestPort() != destPort || packet.GetDestChannel() != destChannel {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "packet destination (%s, %s) doesn't match the batch destination (%s, %s)", packet.GetDestPort(), packet.GetDestChannel(), destPort, destChannel)
+		}
+
+		if _, found := commitments[packet.GetSequence()]; found {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+		commitments[packet.GetSequence()] = types.CommitPacket(k.cdc, packet)
+	}
+
+	// verify that the counterparty did commit to sending every packet of the batch, a packet
+	// not sent on the counterparty channel end is rejected when it is received
+	if err := k.connectionKeeper.VerifyPacketCommitments(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, commitments,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "couldn't verify counterparty packet commitments")
+	}
+
+	results := make([]error, len(packets))
+	for i, packet := range packets {
+		// Use a cached context to prevent accidental state changes
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := k.recvPacket(cacheCtx, chanCap, packet, func(exported.ConnectionI) error {
+			// the packet commitment is proven by the batch proof
+			return nil
+		})
+
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		switch err {
+		case nil, types.ErrTimeoutReceiptWritten:
+			writeFn()
+		case types.ErrNoOpMsg:
+		default:
+			return nil, sdkerrors.Wrapf(err, "packet sequence %d", packet.GetSequence())
+		}
+
+		results[i] = err
+	}
+
+	return results, nil
+}
+
+// recvPacket receives a packet once the provided function has verified that the counterparty
+// committed to sending it.
+func (k Keeper) recvPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	verifyCommitment func(connectionEnd exported.ConnectionI) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN, FLUSHING or FLUSHCOMPLETE (got %s)", channel.State.String(),
 		)
 	}
 
+	if k.IsChannelFrozen(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return sdkerrors.Wrapf(types.ErrChannelFrozen, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	// during a channel upgrade only packets sent before the counterparty started
+	// flushing may be received
+	if channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE {
+		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		if found && packet.GetSequence() >= counterpartyUpgrade.NextSequenceSend {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidPacket,
+				"cannot flush packet at sequence greater than or equal to counterparty next sequence send (%d ≥ %d)", packet.GetSequence(), counterpartyUpgrade.NextSequenceSend,
+			)
+		}
+	}
+
 	// Authenticate capability to ensure caller has authority to receive packet on this channel
 	capName := host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel())
 	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
@@ -207,37 +362,31 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// check if packet timeouted by comparing it with the latest height of the chain
-	selfHeight := clienttypes.GetSelfHeight(ctx)
-	timeoutHeight := packet.GetTimeoutHeight()
-	if !timeoutHeight.IsZero() && selfHeight.GTE(timeoutHeight) {
-		return sdkerrors.Wrapf(
-			types.ErrPacketTimeout,
-			"block height >= packet timeout height (%s >= %s)", selfHeight, timeoutHeight,
-		)
-	}
-
-	// check if packet timeouted by comparing it with the latest timestamp of the chain
-	if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
-		return GetPacketTimeoutErrorMessage(
-			"block timestamp >= packet timeout timestamp (%d >= %d)",
-			uint64(ctx.BlockTime().UTC().UnixNano()),
-			packet.GetTimeoutTimestamp())
+	// check if packet timeouted by comparing it with the latest height and timestamp of the chain.
+	// A timed-out packet on an ORDERED_ALLOW_TIMEOUT channel is still received in order to
+	// write a timeout receipt in its place.
+	timeoutErr := packetTimeoutError(ctx, packet)
+	if timeoutErr != nil && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
+		return timeoutErr
 	}
 
-	commitment := types.CommitPacket(k.cdc, packet)
-
-	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		commitment,
-	); err != nil {
-		return sdkerrors.Wrap(err, "couldn't verify counterparty packet commitment")
+	if err := verifyCommitment(connectionEnd); err != nil {
+		return err
 	}
 
 	switch channel.Ordering {
 	case types.UNORDERED:
+		// packets below the recv start sequence were flushed prior to a channel upgrade,
+		// their packet receipts may have been pruned or never written on an ORDERED channel
+		recvStartSequence, _ := k.GetRecvStartSequence(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		if packet.GetSequence() < recvStartSequence {
+			EmitRecvPacketEvent(ctx, packet, channel)
+			// This error indicates that the packet has already been relayed. Core IBC will
+			// treat this error as a no-op in order to prevent an entire relay transaction
+			// from failing and consuming unnecessary fees.
+			return types.ErrNoOpMsg
+		}
+
 		// check if the packet receipt has been received already for unordered channels
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 		if found {
@@ -254,7 +403,7 @@ func (k Keeper) RecvPacket(
 		// it's just a single store key set to an empty string to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -287,8 +436,33 @@ func (k Keeper) RecvPacket(
 		// Since this is the receiving chain, our channelEnd is packet's destination port and channel
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
 
+		if timeoutErr != nil {
+			// the timed-out packet is not executed, the timeout receipt allows the sending chain
+			// to time out the packet without closing the channel
+			k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+			k.Logger(ctx).Info(
+				"packet timeout receipt written",
+				"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+				"src_port", packet.GetSourcePort(),
+				"src_channel", packet.GetSourceChannel(),
+				"dst_port", packet.GetDestPort(),
+				"dst_channel", packet.GetDestChannel(),
+			)
+
+			EmitRecvPacketEvent(ctx, packet, channel)
+
+			// This error indicates that the state changes must be committed without executing
+			// the packet. Core IBC will skip the application callback and acknowledgement.
+			return types.ErrTimeoutReceiptWritten
+		}
 	}
 
+	k.updateChannelStatistics(ctx, packet.GetDestPort(), packet.GetDestChannel(), func(statistics *types.ChannelStatistics) {
+		statistics.PacketsReceived++
+		statistics.LastRecvHeight = uint64(ctx.BlockHeight())
+	})
+
 	// log that a packet has been received & executed
 	k.Logger(ctx).Info(
 		"packet received",
@@ -327,10 +501,10 @@ func (k Keeper) WriteAcknowledgement(
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN, FLUSHING or FLUSHCOMPLETE (got %s)", channel.State.String(),
 		)
 	}
 
@@ -365,6 +539,14 @@ func (k Keeper) WriteAcknowledgement(
 		types.CommitAcknowledgement(bz),
 	)
 
+	k.updateChannelStatistics(ctx, packet.GetDestPort(), packet.GetDestChannel(), func(statistics *types.ChannelStatistics) {
+		if acknowledgement.Success() {
+			statistics.AcknowledgementsSuccess++
+		} else {
+			statistics.AcknowledgementsError++
+		}
+	})
+
 	// log that a packet acknowledgement has been written
 	k.Logger(ctx).Info(
 		"acknowledgement written",
@@ -385,7 +567,7 @@ func (k Keeper) WriteAcknowledgement(
 // module on the counterparty chain. Its intended usage is within the ante
 // handler. AcknowledgePacket will clean up the packet commitment,
 // which is no longer necessary since the packet has been received and acted upon.
-// It will also increment NextSequenceAck in case of ORDERED channels.
+// It will also increment NextSequenceAck in case of ORDERED and ORDERED_ALLOW_TIMEOUT channels.
 func (k Keeper) AcknowledgePacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -393,6 +575,105 @@ func (k Keeper) AcknowledgePacket(
 	acknowledgement []byte,
 	proof []byte,
 	proofHeight exported.Height,
+) error {
+	return k.acknowledgePacket(ctx, chanCap, packet, acknowledgement, func(connectionEnd exported.ConnectionI) error {
+		return k.connectionKeeper.VerifyPacketAcknowledgement(
+			ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+			packet.GetSequence(), acknowledgement,
+		)
+	})
+}
+
+// AcknowledgePackets is called by a module to process the acknowledgements of a batch of
+// packets previously sent by the calling module on a channel, whose acknowledgements are
+// proven by a single batch proof. The batch proof is verified once, before each packet is
+// acknowledged in order as by AcknowledgePacket. The state changes of a packet are only
+// written if it is acknowledged. The result of acknowledging each packet is returned, which
+// is nil or ErrNoOpMsg; any other error fails the whole batch.
+func (k Keeper) AcknowledgePackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []exported.PacketI,
+	acknowledgements [][]byte,
+	proof []byte,
+	proofHeight exported.Height,
+) ([]error, error) {
+	if len(packets) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPacket, "batch must contain at least one packet")
+	}
+
+	if len(packets) != len(acknowledgements) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAcknowledgement, "number of acknowledgements doesn't match the number of packets (%d ≠ %d)", len(acknowledgements), len(packets))
+	}
+
+	sourcePort, sourceChannel := packets[0].GetSourcePort(), packets[0].GetSourceChannel()
+	channel, found := k.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			types.ErrChannelNotFound,
+			"port ID (%s) channel ID (%s)", sourcePort, sourceChannel,
+		)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	acks := make(map[uint64][]byte, len(packets))
+	for i, packet := range packets {
+		if packet.GetSourcePort() != sourcePort || packet.GetSourceChannel() != sourceChannel {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "packet source (%s, %s) doesn't match the batch source (%s, %s)", packet.GetSourcePort(), packet.GetSourceChannel(), sourcePort, sourceChannel)
+		}
+
+		if _, found := acks[packet.GetSequence()]; found {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "duplicate packet sequence %d", packet.GetSequence())
+		}
+		acks[packet.GetSequence()] = acknowledgements[i]
+	}
+
+	// verify that the counterparty did write the acknowledgement of every packet of the batch
+	if err := k.connectionKeeper.VerifyPacketAcknowledgements(
+		ctx, connectionEnd, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, acks,
+	); err != nil {
+		return nil, err
+	}
+
+	results := make([]error, len(packets))
+	for i, packet := range packets {
+		// Use a cached context to prevent accidental state changes
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := k.acknowledgePacket(cacheCtx, chanCap, packet, acknowledgements[i], func(exported.ConnectionI) error {
+			// the acknowledgement is proven by the batch proof
+			return nil
+		})
+
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		switch err {
+		case nil:
+			writeFn()
+		case types.ErrNoOpMsg:
+		default:
+			return nil, sdkerrors.Wrapf(err, "packet sequence %d", packet.GetSequence())
+		}
+
+		results[i] = err
+	}
+
+	return results, nil
+}
+
+// acknowledgePacket acknowledges a packet once the provided function has verified that the
+// counterparty wrote the acknowledgement.
+func (k Keeper) acknowledgePacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	acknowledgement []byte,
+	verifyAcknowledgement func(connectionEnd exported.ConnectionI) error,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -402,10 +683,10 @@ func (k Keeper) AcknowledgePacket(
 		)
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN or FLUSHING (got %s)", channel.State.String(),
 		)
 	}
 
@@ -472,15 +753,12 @@ func (k Keeper) AcknowledgePacket(
 		return sdkerrors.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
-		packet.GetSequence(), acknowledgement,
-	); err != nil {
+	if err := verifyAcknowledgement(connectionEnd); err != nil {
 		return err
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return sdkerrors.Wrapf(
@@ -508,6 +786,15 @@ func (k Keeper) AcknowledgePacket(
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
+	if channel.State == types.FLUSHING {
+		k.handleFlushState(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
+	k.updateChannelStatistics(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), func(statistics *types.ChannelStatistics) {
+		statistics.PacketsAcknowledged++
+		statistics.LastAcknowledgeHeight = uint64(ctx.BlockHeight())
+	})
+
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
 		"packet acknowledged",
@@ -112,6 +112,13 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 			err := path.EndpointA.SetChannelClosed()
 			suite.Require().NoError(err)
 		}, false},
+		{"channel frozen", func() {
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelFrozen(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
 		{"packet dest port ≠ channel counterparty port", func() {
 			suite.coordinator.Setup(path)
 			// use wrong port for dest
@@ -246,6 +253,15 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			suite.Require().NoError(err)
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT channel", func() {
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, true},
 		{"success UNORDERED channel", func() {
 			// setup uses an UNORDERED channel
 			suite.coordinator.Setup(path)
@@ -296,6 +312,17 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			err = path.EndpointB.RecvPacket(packet.(types.Packet))
 			suite.Require().NoError(err)
 		}, false},
+		{"channel frozen", func() {
+			expError = types.ErrChannelFrozen
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannelFrozen(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
 		{"out of order packet failure with ORDERED channel", func() {
 			expError = types.ErrPacketSequenceOutOfOrder
 
@@ -404,6 +431,37 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 		}, false},
+		{"timeout receipt written: ORDERED_ALLOW_TIMEOUT channel", func() {
+			expError = types.ErrTimeoutReceiptWritten
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+
+			// manually set packet commitment since a timed-out packet cannot be sent
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence(), types.CommitPacket(suite.chainA.App.AppCodec(), packet))
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			path.EndpointA.UpdateClient()
+			path.EndpointB.UpdateClient()
+		}, false},
+		{"out of order packet failure with ORDERED_ALLOW_TIMEOUT channel", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			// send 2 packets
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			// set sequence to 2
+			packet = types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err = path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			// attempts to receive packet 2 without receiving packet 1
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
 		{"next receive sequence is not found", func() {
 			expError = types.ErrSequenceReceiveNotFound
 			suite.coordinator.SetupConnections(path)
@@ -471,9 +529,9 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 				suite.Require().True(found)
 				receipt, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-				if channelB.Ordering == types.ORDERED {
+				if channelB.Ordering == types.ORDERED || channelB.Ordering == types.ORDERED_ALLOW_TIMEOUT {
 					suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv, "sequence not incremented in ordered channel")
-					suite.Require().False(receiptStored, "packet receipt stored on ORDERED channel")
+					suite.Require().False(receiptStored, "packet receipt stored on ordered channel")
 				} else {
 					suite.Require().Equal(uint64(1), nextSeqRecv, "sequence incremented for UNORDERED channel")
 					suite.Require().True(receiptStored, "packet receipt not stored after RecvPacket in UNORDERED channel")
@@ -486,6 +544,17 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 				if expError != nil {
 					suite.Require().True(errors.Is(err, expError))
 				}
+
+				// the timeout receipt is written in place of the timed-out packet
+				if expError == types.ErrTimeoutReceiptWritten {
+					nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+					suite.Require().True(found)
+					suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv, "sequence not incremented for timed-out packet")
+
+					receipt, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(receiptStored, "timeout receipt not stored")
+					suite.Require().Equal(string(types.PacketTimeoutReceipt), receipt)
+				}
 			}
 		})
 	}
@@ -828,6 +897,23 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 			err = path.EndpointB.RecvPacket(packet)
 			suite.Require().NoError(err)
 
+			// set next sequence ack wrong
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"next ack sequence mismatch ORDERED_ALLOW_TIMEOUT", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			// create packet commitment
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// create packet acknowledgement
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
 			// set next sequence ack wrong
 			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
@@ -872,6 +958,241 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 	}
 }
 
+// TestRecvPackets tests receiving a batch of packets on chainB sent from chainA with a
+// single batch proof of their commitments.
+func (suite *KeeperTestSuite) TestRecvPackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		channelCap *capabilitytypes.Capability
+		expResults []error
+	)
+
+	sendPackets := func(n int) {
+		packets = make([]types.Packet, n)
+		for i := range packets {
+			packets[i] = types.NewPacket(ibctesting.MockPacketData, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointA.SendPacket(packets[i]))
+		}
+	}
+
+	testCases := []testCase{
+		{"success on unordered channel", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+			expResults = []error{nil, nil, nil}
+		}, true},
+		{"success on ordered channel", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+			expResults = []error{nil, nil, nil}
+		}, true},
+		{"success: packet already received is a no-op", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(3)
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[1]))
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			expResults = []error{nil, types.ErrNoOpMsg, nil}
+		}, true},
+		{"empty batch", func() {
+			suite.coordinator.Setup(path)
+			packets = []types.Packet{}
+		}, false},
+		{"packets destined to different channels", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+			packets[1].DestinationChannel = ibctesting.InvalidID
+		}, false},
+		{"duplicate packet sequence", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+			packets = append(packets, packets[0])
+		}, false},
+		{"packet commitment not proven by the batch proof", func() {
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+			packets[1].Data = []byte("invalid packet data")
+		}, false},
+		{"ordered channel: packets received out of order", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			sendPackets(2)
+			packets[0], packets[1] = packets[1], packets[0]
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			// query the batch proof of every distinct packet commitment sent
+			var packetKeys [][]byte
+			seen := make(map[uint64]bool)
+			for _, packet := range packets {
+				if !seen[packet.GetSequence()] {
+					seen[packet.GetSequence()] = true
+					packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				}
+			}
+
+			var (
+				proof       []byte
+				proofHeight = suite.chainB.GetClientState(path.EndpointB.ClientID).GetLatestHeight()
+			)
+			if len(packetKeys) > 0 {
+				proof, proofHeight = suite.chainA.QueryProofs(packetKeys...)
+			}
+
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			batch := make([]exported.PacketI, len(packets))
+			for i := range packets {
+				batch[i] = packets[i]
+			}
+
+			results, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPackets(suite.chainB.GetContext(), channelCap, batch, proof, proofHeight)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, results)
+
+				for _, packet := range packets {
+					if path.EndpointB.ChannelConfig.Order == types.ORDERED {
+						nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+						suite.Require().True(found)
+						suite.Require().Equal(uint64(len(packets)+1), nextSeqRecv)
+					} else {
+						_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+						suite.Require().True(found)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(results)
+			}
+		})
+	}
+}
+
+// TestAcknowledgePackets tests acknowledging a batch of packets on chainA received on chainB
+// with a single batch proof of their acknowledgements.
+func (suite *KeeperTestSuite) TestAcknowledgePackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		acks       [][]byte
+		channelCap *capabilitytypes.Capability
+		expResults []error
+	)
+
+	relayPackets := func(n int) {
+		packets = make([]types.Packet, n)
+		acks = make([][]byte, n)
+		for i := range packets {
+			packets[i] = types.NewPacket(ibctesting.MockPacketData, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointA.SendPacket(packets[i]))
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[i]))
+			acks[i] = ibcmock.MockAcknowledgement.Acknowledgement()
+		}
+	}
+
+	testCases := []testCase{
+		{"success on unordered channel", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+			expResults = []error{nil, nil, nil}
+		}, true},
+		{"success on ordered channel", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+			expResults = []error{nil, nil, nil}
+		}, true},
+		{"success: packet already acknowledged is a no-op", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+			suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[0], acks[0]))
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			expResults = []error{types.ErrNoOpMsg, nil, nil}
+		}, true},
+		{"number of acknowledgements doesn't match the number of packets", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(2)
+			acks = acks[:1]
+		}, false},
+		{"packets sent on different channels", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(2)
+			packets[1].SourceChannel = ibctesting.InvalidID
+		}, false},
+		{"duplicate packet sequence", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(2)
+			packets = append(packets, packets[0])
+			acks = append(acks, acks[0])
+		}, false},
+		{"acknowledgement not proven by the batch proof", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(2)
+			acks[1] = []byte("invalid acknowledgement")
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			// query the batch proof of every distinct acknowledgement written
+			var packetKeys [][]byte
+			seen := make(map[uint64]bool)
+			for _, packet := range packets {
+				if !seen[packet.GetSequence()] {
+					seen[packet.GetSequence()] = true
+					packetKeys = append(packetKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+				}
+			}
+			proof, proofHeight := suite.chainB.QueryProofs(packetKeys...)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			batch := make([]exported.PacketI, len(packets))
+			for i := range packets {
+				batch[i] = packets[i]
+			}
+
+			results, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePackets(suite.chainA.GetContext(), channelCap, batch, acks, proof, proofHeight)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, results)
+
+				for _, packet := range packets {
+					pc := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().Nil(pc)
+				}
+
+				if path.EndpointA.ChannelConfig.Order == types.ORDERED {
+					nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+					suite.Require().True(found)
+					suite.Require().Equal(uint64(len(packets)+1), nextSeqAck)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(results)
+			}
+		})
+	}
+}
+
 func TestGetPacketTimeoutErrorMessage(t *testing.T) {
 	type args struct {
 		message          string
@@ -909,3 +1230,55 @@ func TestGetPacketTimeoutErrorMessage(t *testing.T) {
 		})
 	}
 }
+
+// TestChannelStatistics tests that the packet lifecycle statistics of both channel ends
+// are updated as packets are sent, received, acknowledged and timed out.
+func (suite *KeeperTestSuite) TestChannelStatistics() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// packet acknowledged with a successful acknowledgement
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement))
+
+	// packet acknowledged with an error acknowledgement
+	packet = types.NewPacket(ibctesting.MockFailPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockFailAcknowledgement.Acknowledgement()))
+
+	// packet timed out
+	packet = types.NewPacket(ibctesting.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	// packet timed out on close
+	packet = types.NewPacket(ibctesting.MockPacketData, 4, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.EndpointB.ChanForceClose())
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutOnClose(packet))
+
+	statisticsA, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannelStatistics(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(4), statisticsA.PacketsSent)
+	suite.Require().Equal(uint64(0), statisticsA.PacketsReceived)
+	suite.Require().Equal(uint64(2), statisticsA.PacketsAcknowledged)
+	suite.Require().Equal(uint64(2), statisticsA.PacketsTimedOut)
+	suite.Require().Equal(uint64(1), statisticsA.PacketsTimedOutOnClose)
+	suite.Require().NotZero(statisticsA.LastSendHeight)
+	suite.Require().NotZero(statisticsA.LastAcknowledgeHeight)
+	suite.Require().NotZero(statisticsA.LastTimeoutHeight)
+	suite.Require().Zero(statisticsA.LastRecvHeight)
+
+	statisticsB, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetChannelStatistics(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(0), statisticsB.PacketsSent)
+	suite.Require().Equal(uint64(2), statisticsB.PacketsReceived)
+	suite.Require().Equal(uint64(1), statisticsB.AcknowledgementsSuccess)
+	suite.Require().Equal(uint64(1), statisticsB.AcknowledgementsError)
+	suite.Require().NotZero(statisticsB.LastRecvHeight)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// PruneAcknowledgements prunes the packet acknowledgements and receipts of up to limit packet
+// sequences below the pruning sequence end of a channel. The total number of pruned sequences
+// and the number of sequences which remain to be pruned are returned.
+//
+// Acknowledgements and receipts may only be pruned once the counterparty can no longer need them
+// and they are no longer needed to reject a packet received again. This is the case below the
+// timeout horizon of the channel, below which every packet sent by the counterparty has been
+// acknowledged or timed out on the counterparty. The timeout horizon is proven with
+// AdvanceTimeoutHorizon or set by a channel upgrade, and is stored as the recv start sequence,
+// below which packets are rejected in place of the pruned receipts. Acknowledgements and receipts
+// of ORDERED and ORDERED_ALLOW_TIMEOUT channels are never pruned beyond the next sequence to be
+// received.
+func (k Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string, limit uint64) (uint64, uint64, error) {
+	start, end, err := k.GetPruningSequences(ctx, portID, channelID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var totalPruned uint64
+	for ; start < end && totalPruned < limit; start++ {
+		k.deletePacketAcknowledgement(ctx, portID, channelID, start)
+		// packet receipts are only written for UNORDERED channels and timed-out packets on
+		// ORDERED_ALLOW_TIMEOUT channels, deleting a receipt which does not exist is a no-op
+		k.deletePacketReceipt(ctx, portID, channelID, start)
+		totalPruned++
+	}
+
+	k.SetPruningSequenceStart(ctx, portID, channelID, start)
+
+	var totalRemaining uint64
+	if end > start {
+		totalRemaining = end - start
+	}
+	k.Logger(ctx).Debug("pruned acknowledgements", "port-id", portID, "channel-id", channelID, "total-pruned", totalPruned, "total-remaining", totalRemaining)
+
+	EmitPruneAcknowledgementsEvent(ctx, portID, channelID, start, totalPruned, totalRemaining)
+
+	return totalPruned, totalRemaining, nil
+}
+
+// GetPruningSequences returns the next sequence to be pruned and the sequence below which
+// acknowledgements and receipts may be pruned for the provided channel. The sequence end is
+// the timeout horizon of the channel, bounded by the next sequence to be received on ORDERED
+// and ORDERED_ALLOW_TIMEOUT channels.
+func (k Keeper) GetPruningSequences(ctx sdk.Context, portID, channelID string) (uint64, uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	start, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrPruningSequenceStartNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// the recv start sequence is not set until the timeout horizon is first advanced
+	end, _ := k.GetRecvStartSequence(ctx, portID, channelID)
+
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID)
+		if !found {
+			return 0, 0, sdkerrors.Wrapf(types.ErrSequenceReceiveNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		if nextSequenceRecv < end {
+			end = nextSequenceRecv
+		}
+	}
+
+	return start, end, nil
+}
+
+// AdvanceTimeoutHorizon advances the timeout horizon of a channel, below which every packet sent
+// by the counterparty has been acknowledged or timed out on the counterparty, such that the
+// acknowledgements and receipts below it may be pruned. The provided proofs prove the absence of
+// the counterparty packet commitments of consecutive sequences, starting at the current timeout
+// horizon. The new timeout horizon is returned and set as the recv start sequence of the channel,
+// below which packets are rejected.
+//
+// The last proven sequence must have been received, ensuring that every proven sequence was sent
+// by the counterparty: its packet receipt must exist on UNORDERED channels, and it must be below
+// the next sequence to be received on ORDERED and ORDERED_ALLOW_TIMEOUT channels. The proofs must
+// be made at the latest height of the counterparty client, which is past the height at which the
+// packets were sent. Packets whose commitments are absent at that height can neither be received,
+// acknowledged nor timed out anymore.
+func (k Keeper) AdvanceTimeoutHorizon(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proofs [][]byte,
+	proofHeight exported.Height,
+) (uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if len(proofs) == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalidPacket, "at least one packet commitment absence proof must be provided")
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return 0, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
+	if !found {
+		return 0, sdkerrors.Wrap(clienttypes.ErrClientNotFound, connectionEnd.GetClientID())
+	}
+
+	if !proofHeight.EQ(clientState.GetLatestHeight()) {
+		return 0, sdkerrors.Wrapf(clienttypes.ErrInvalidHeight, "proof height must be the latest client height (%s ≠ %s)", proofHeight, clientState.GetLatestHeight())
+	}
+
+	horizon, _ := k.GetRecvStartSequence(ctx, portID, channelID)
+	if horizon == 0 {
+		horizon = 1
+	}
+
+	newHorizon := horizon + uint64(len(proofs))
+	lastSequence := newHorizon - 1
+
+	switch channel.Ordering {
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID)
+		if !found {
+			return 0, sdkerrors.Wrapf(types.ErrSequenceReceiveNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		if lastSequence >= nextSequenceRecv {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidPacket, "last proven sequence %d has not been received (next sequence recv %d)", lastSequence, nextSequenceRecv)
+		}
+	default:
+		if _, found := k.GetPacketReceipt(ctx, portID, channelID, lastSequence); !found {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidPacket, "packet receipt of the last proven sequence %d not found", lastSequence)
+		}
+	}
+
+	for i, proof := range proofs {
+		sequence := horizon + uint64(i)
+		if err := k.connectionKeeper.VerifyPacketCommitmentAbsence(
+			ctx, connectionEnd, proofHeight, proof,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequence,
+		); err != nil {
+			return 0, sdkerrors.Wrapf(err, "couldn't verify absence of counterparty packet commitment of sequence %d", sequence)
+		}
+	}
+
+	k.SetRecvStartSequence(ctx, portID, channelID, newHorizon)
+
+	k.Logger(ctx).Info("timeout horizon advanced", "port-id", portID, "channel-id", channelID, "timeout-horizon", strconv.FormatUint(newHorizon, 10))
+
+	EmitAdvanceTimeoutHorizonEvent(ctx, portID, channelID, newHorizon)
+
+	return newHorizon, nil
+}
@@ -0,0 +1,322 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// setPrunableAcknowledgements stores an acknowledgement and a receipt for the first n packet
+// sequences on chainA and marks all sequences below end as prunable.
+func (suite *KeeperTestSuite) setPrunableAcknowledgements(path *ibctesting.Path, n, end uint64) {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	for sequence := uint64(1); sequence <= n; sequence++ {
+		channelKeeper.SetPacketAcknowledgement(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, ibctesting.MockAcknowledgement)
+		channelKeeper.SetPacketReceipt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+	}
+
+	channelKeeper.SetRecvStartSequence(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, end)
+	channelKeeper.SetPruningSequenceStart(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+}
+
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path                *ibctesting.Path
+		limit               uint64
+		expPruned           uint64
+		expRemaining        uint64
+		expPruningEnd       uint64
+		numAcknowledgements uint64 = 10
+	)
+
+	testCases := []testCase{
+		{"success: limit is less than the prunable sequences", func() {
+			limit = 3
+			expPruned, expRemaining, expPruningEnd = 3, 2, 4
+		}, true},
+		{"success: limit is greater than the prunable sequences", func() {
+			limit = 10
+			expPruned, expRemaining, expPruningEnd = 5, 0, 6
+		}, true},
+		{"success: ORDERED channel is pruned up to the next sequence recv", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.Ordering = types.ORDERED
+			path.EndpointA.SetChannel(channel)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 3)
+
+			limit = 10
+			expPruned, expRemaining, expPruningEnd = 2, 0, 3
+		}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"success: nothing is pruned until the timeout horizon is advanced", func() {
+			ctx := suite.chainA.GetContext()
+			store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey))
+			store.Delete(host.RecvStartSequenceKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+			limit = 10
+			expPruned, expRemaining, expPruningEnd = 0, 0, 1
+		}, true},
+		{"pruning sequence start not found", func() {
+			ctx := suite.chainA.GetContext()
+			store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey))
+			store.Delete(host.PruningSequenceStartKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			suite.setPrunableAcknowledgements(path, numAcknowledgements, 6)
+
+			tc.malleate()
+
+			totalPruned, totalRemaining, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, limit,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPruned, totalPruned)
+				suite.Require().Equal(expRemaining, totalRemaining)
+
+				ctx := suite.chainA.GetContext()
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+				pruningSequenceStart, found := channelKeeper.GetPruningSequenceStart(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(expPruningEnd, pruningSequenceStart)
+
+				for sequence := uint64(1); sequence <= numAcknowledgements; sequence++ {
+					_, foundReceipt := channelKeeper.GetPacketReceipt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+					foundAck := channelKeeper.HasPacketAcknowledgement(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+
+					suite.Require().Equal(sequence >= expPruningEnd, foundAck)
+					suite.Require().Equal(sequence >= expPruningEnd, foundReceipt)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestBeginBlockerPruning tests that the begin blocker prunes at most the per block limit
+// of packet sequences.
+func (suite *KeeperTestSuite) TestBeginBlockerPruning() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	numAcknowledgements := types.PruningLimitPerBlock + 50
+	suite.setPrunableAcknowledgements(path, numAcknowledgements, numAcknowledgements+1)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	channel.BeginBlocker(suite.chainA.GetContext(), channelKeeper)
+
+	pruningSequenceStart, found := channelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PruningLimitPerBlock+1, pruningSequenceStart)
+
+	channel.BeginBlocker(suite.chainA.GetContext(), channelKeeper)
+
+	pruningSequenceStart, found = channelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(numAcknowledgements+1, pruningSequenceStart)
+	suite.Require().False(channelKeeper.HasPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, numAcknowledgements))
+}
+
+// TestBeginBlockerPruningCursor tests that the begin blocker visits at most the per block limit
+// of channels, resuming from the pruning cursor stored by the previous block.
+func (suite *KeeperTestSuite) TestBeginBlockerPruningCursor() {
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	// channels which are not found are visited without being pruned
+	numChannels := types.PruningChannelsPerBlock + 5
+	for i := uint64(0); i < numChannels; i++ {
+		channelKeeper.SetPruningSequenceStart(ctx, ibctesting.MockPort, types.FormatChannelIdentifier(i), 1)
+	}
+
+	pruningSequenceStarts := channelKeeper.GetAllPruningSequenceStarts(ctx)
+	suite.Require().Len(pruningSequenceStarts, int(numChannels))
+
+	channel.BeginBlocker(ctx, channelKeeper)
+
+	next := pruningSequenceStarts[types.PruningChannelsPerBlock]
+	suite.Require().Equal(host.PruningSequenceStartKey(next.PortId, next.ChannelId), channelKeeper.GetPruningCursor(ctx))
+
+	// the sweep reaches the last channel and restarts at the first channel in the next block
+	channel.BeginBlocker(ctx, channelKeeper)
+	suite.Require().Nil(channelKeeper.GetPruningCursor(ctx))
+}
+
+// TestPruneAcknowledgementsAfterUpgrade tests that the acknowledgements and receipts of packets
+// received before a channel upgrade are pruned once the upgrade completes.
+func (suite *KeeperTestSuite) TestPruneAcknowledgementsAfterUpgrade() {
+	path := suite.newUpgradePath(types.UNORDERED)
+
+	var packets []types.Packet
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+		suite.Require().NoError(path.EndpointB.SendPacket(packet))
+		suite.Require().NoError(path.RelayPacket(packet))
+		packets = append(packets, packet)
+	}
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	// the begin blocker of the block following the upgrade prunes the acknowledgements and receipts
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	for _, packet := range packets {
+		_, found := channelKeeper.GetPacketReceipt(suite.chainA.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		suite.Require().False(found)
+		suite.Require().False(channelKeeper.HasPacketAcknowledgement(suite.chainA.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	}
+
+	res, err := suite.chainA.QueryServer.PrunableAcknowledgements(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryPrunableAcknowledgementsRequest{
+		PortId:    path.EndpointA.ChannelConfig.PortID,
+		ChannelId: path.EndpointA.ChannelID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.PruningSequenceEnd)
+	suite.Require().Zero(res.TotalRemainingSequences)
+}
+
+// TestAdvanceTimeoutHorizon tests that the timeout horizon of channels which have never been
+// upgraded is advanced by proving the absence of the counterparty packet commitments, after
+// which the acknowledgements and receipts below it are pruned.
+func (suite *KeeperTestSuite) TestAdvanceTimeoutHorizon() {
+	var (
+		path        *ibctesting.Path
+		proofs      [][]byte
+		proofHeight exported.Height
+		expHorizon  uint64
+	)
+
+	// relayPackets sends two packets from chainB to chainA, relays them and their acknowledgements
+	// and returns the proofs of absence of their packet commitments on chainB
+	relayPackets := func(order types.Order) {
+		path = ibctesting.NewPath(suite.chainA, suite.chainB)
+		path.EndpointA.ChannelConfig.Order = order
+		path.EndpointB.ChannelConfig.Order = order
+		suite.coordinator.Setup(path)
+
+		for sequence := uint64(1); sequence <= 2; sequence++ {
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointB.SendPacket(packet))
+			suite.Require().NoError(path.RelayPacket(packet))
+		}
+
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+
+		proofs = nil
+		for sequence := uint64(1); sequence <= 2; sequence++ {
+			var proof []byte
+			proof, proofHeight = path.EndpointB.QueryProof(host.PacketCommitmentKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence))
+			proofs = append(proofs, proof)
+		}
+
+		expHorizon = 3
+	}
+
+	testCases := []testCase{
+		{"success: UNORDERED channel", func() {}, true},
+		{"success: ORDERED channel", func() {
+			relayPackets(types.ORDERED)
+		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT channel", func() {
+			relayPackets(types.ORDERED_ALLOW_TIMEOUT)
+		}, true},
+		{"success: timeout horizon advanced from the previous timeout horizon", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2)
+			proofs = proofs[1:]
+		}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"no proofs provided", func() {
+			proofs = nil
+		}, false},
+		{"proof height is not the latest client height", func() {
+			proofHeight = proofHeight.Increment()
+		}, false},
+		{"packet receipt of the last proven sequence not found", func() {
+			proofs = append(proofs, proofs[0])
+		}, false},
+		{"last proven sequence not received on ORDERED channel", func() {
+			relayPackets(types.ORDERED)
+			proofs = append(proofs, proofs[0])
+		}, false},
+		{"counterparty packet commitment exists", func() {
+			// the third packet is received but not yet acknowledged on chainB
+			packet := types.NewPacket(ibctesting.MockPacketData, 3, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointB.SendPacket(packet))
+			suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proofs = nil
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				var proof []byte
+				proof, proofHeight = path.EndpointB.QueryProof(host.PacketCommitmentKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence))
+				proofs = append(proofs, proof)
+			}
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			relayPackets(types.UNORDERED)
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			horizon, err := channelKeeper.AdvanceTimeoutHorizon(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, proofs, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expHorizon, horizon)
+
+				ctx := suite.chainA.GetContext()
+				recvStartSequence, found := channelKeeper.GetRecvStartSequence(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(expHorizon, recvStartSequence)
+
+				totalPruned, totalRemaining, err := channelKeeper.PruneAcknowledgements(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expHorizon-1, totalPruned)
+				suite.Require().Zero(totalRemaining)
+
+				for sequence := uint64(1); sequence < expHorizon; sequence++ {
+					_, found := channelKeeper.GetPacketReceipt(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+					suite.Require().False(found)
+					suite.Require().False(channelKeeper.HasPacketAcknowledgement(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence))
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
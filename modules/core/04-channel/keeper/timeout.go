@@ -83,10 +83,10 @@ func (k Keeper) TimeoutPacket(
 		return types.ErrNoOpMsg
 	}
 
-	if channel.State != types.OPEN {
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING) {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
+			"channel state is not OPEN or FLUSHING (got %s)", channel.State.String(),
 		)
 	}
 
@@ -112,6 +112,31 @@ func (k Keeper) TimeoutPacket(
 			ctx, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		// timeouts are processed in order together with acknowledgements
+		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		if !found {
+			return sdkerrors.Wrapf(
+				types.ErrSequenceAckNotFound,
+				"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+			)
+		}
+
+		if packet.GetSequence() != nextSequenceAck {
+			return sdkerrors.Wrapf(
+				types.ErrPacketSequenceOutOfOrder,
+				"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+			)
+		}
+
+		// the counterparty must have written a timeout receipt for the packet. Proving the
+		// next sequence recv is not sufficient since the packet could then never be received
+		// once its commitment is deleted, halting the counterparty channel end.
+		err = k.connectionKeeper.VerifyPacketReceipt(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+			types.PacketTimeoutReceipt,
+		)
 	case types.UNORDERED:
 		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
 			ctx, connectionEnd, proofHeight, proof,
@@ -131,6 +156,7 @@ func (k Keeper) TimeoutPacket(
 
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// ORDERED_ALLOW_TIMEOUT channels remain open and increment their next sequence ack.
 //
 // CONTRACT: this function must be called in the IBC handler
 func (k Keeper) TimeoutExecuted(
@@ -153,11 +179,37 @@ func (k Keeper) TimeoutExecuted(
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
-	if channel.Ordering == types.ORDERED {
+	switch {
+	case channel.Ordering == types.ORDERED:
+		// a timed out packet on an ORDERED channel being flushed aborts the upgrade
+		// attempt before the channel is closed
+		if channel.State == types.FLUSHING {
+			k.MustAbortUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), types.ErrPacketTimeout)
+			channel, _ = k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		}
+
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	case channel.Ordering == types.ORDERED_ALLOW_TIMEOUT:
+		// the next sequence ack is not incremented for packets timed out on close
+		// which are not the next packet to be acknowledged
+		nextSequenceAck, _ := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		if packet.GetSequence() == nextSequenceAck {
+			k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceAck+1)
+		}
+
+		if channel.State == types.FLUSHING {
+			k.handleFlushState(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		}
+	case channel.State == types.FLUSHING:
+		k.handleFlushState(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	}
 
+	k.updateChannelStatistics(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), func(statistics *types.ChannelStatistics) {
+		statistics.PacketsTimedOut++
+		statistics.LastTimeoutHeight = uint64(ctx.BlockHeight())
+	})
+
 	k.Logger(ctx).Info(
 		"packet timed-out",
 		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
@@ -188,6 +240,7 @@ func (k Keeper) TimeoutOnClose(
 	proofClosed []byte,
 	proofHeight exported.Height,
 	nextSequenceRecv uint64,
+	counterpartyUpgradeSequence uint64,
 ) error {
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
@@ -245,6 +298,7 @@ func (k Keeper) TimeoutOnClose(
 	expectedChannel := types.NewChannel(
 		types.CLOSED, channel.Ordering, counterparty, counterpartyHops, channel.Version,
 	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
 
 	// check that the opposing channel end has closed
 	if err := k.connectionKeeper.VerifyChannelState(
@@ -257,6 +311,21 @@ func (k Keeper) TimeoutOnClose(
 
 	var err error
 	switch channel.Ordering {
+	case types.ORDERED_ALLOW_TIMEOUT:
+		// a packet received after its timeout has a timeout receipt written in its place
+		if nextSequenceRecv > packet.GetSequence() {
+			err = k.connectionKeeper.VerifyPacketReceipt(
+				ctx, connectionEnd, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+				types.PacketTimeoutReceipt,
+			)
+			break
+		}
+
+		err = k.connectionKeeper.VerifyNextSequenceRecv(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+		)
 	case types.ORDERED:
 		// check that packet has not been received
 		if nextSequenceRecv > packet.GetSequence() {
@@ -281,6 +350,12 @@ func (k Keeper) TimeoutOnClose(
 		return err
 	}
 
+	// the packet is counted as timed out by TimeoutExecuted, only the closure of the
+	// counterparty channel end is recorded here
+	k.updateChannelStatistics(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), func(statistics *types.ChannelStatistics) {
+		statistics.PacketsTimedOutOnClose++
+	})
+
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return nil
 }
@@ -47,6 +47,18 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			// need to update chainA's client representing chainB to prove missing ack
 			path.EndpointA.UpdateClient()
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+			path.EndpointA.SendPacket(packet)
+
+			// write the timeout receipt on chainB and update chainA's client representing chainB
+			err := path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}, true},
 		{"packet already timed out: ORDERED", func() {
 			expError = types.ErrNoOpMsg
 			ordered = true
@@ -166,6 +178,32 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			path.EndpointA.SendPacket(packet)
 			path.EndpointA.UpdateClient()
 		}, false},
+		{"timeout receipt verification failed: ORDERED_ALLOW_TIMEOUT", func() {
+			// skip error check, error occurs in light-clients
+
+			// the timed-out packet is not received, no timeout receipt is written
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			path.EndpointA.UpdateClient()
+		}, false},
+		{"packet sequence ≠ next ack sequence: ORDERED_ALLOW_TIMEOUT", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+			path.EndpointA.SendPacket(packet)
+
+			err := path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2)
+		}, false},
 	}
 
 	for i, tc := range testCases {
@@ -229,6 +267,15 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 
 			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"success ORDERED_ALLOW_TIMEOUT", func() {
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+			path.EndpointA.SendPacket(packet)
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
@@ -259,6 +306,17 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 			if tc.expPass {
 				suite.NoError(err)
 				suite.Nil(pc)
+
+				channel := path.EndpointA.GetChannel()
+				switch channel.Ordering {
+				case types.ORDERED:
+					suite.Equal(types.CLOSED, channel.State)
+				case types.ORDERED_ALLOW_TIMEOUT:
+					suite.Equal(types.OPEN, channel.State)
+
+					nextSeqAck, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
+					suite.Equal(packet.GetSequence()+1, nextSeqAck)
+				}
 			} else {
 				suite.Error(err)
 			}
@@ -302,6 +360,36 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 
 			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = true
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+			path.EndpointA.SendPacket(packet)
+			path.EndpointB.SetChannelClosed()
+			// need to update chainA's client representing chainB to prove missing ack
+			path.EndpointA.UpdateClient()
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT timeout receipt", func() {
+			// the timeout receipt is proven for a packet received after its timeout
+			ordered = false
+			nextSeqRecv = 2
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+			path.EndpointA.SendPacket(packet)
+			err := path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+			path.EndpointB.SetChannelClosed()
+			// need to update chainA's client representing chainB to prove the timeout receipt
+			path.EndpointA.UpdateClient()
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
@@ -421,7 +509,7 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 				proof, _ = suite.chainB.QueryProof(unorderedPacketKey)
 			}
 
-			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, proofClosed, proofHeight, nextSeqRecv)
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, proofClosed, proofHeight, nextSeqRecv, 0)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -0,0 +1,856 @@
+package keeper
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// ChanUpgradeInit is called by governance to initiate a channel upgrade handshake with
+// a module on another chain. The proposed upgrade fields are validated against the
+// current channel end.
+func (k Keeper) ChanUpgradeInit(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+	upgradeFields types.UpgradeFields,
+) (types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return types.Upgrade{}, sdkerrors.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
+	}
+
+	if err := k.validateSelfUpgradeFields(ctx, upgradeFields, channel); err != nil {
+		return types.Upgrade{}, err
+	}
+
+	return types.NewUpgrade(upgradeFields, types.Timeout{}, 0), nil
+}
+
+// WriteUpgradeInitChannel writes a channel which has successfully passed the UpgradeInit handshake step.
+// Any previous upgrade attempt is aborted by writing an error receipt for its upgrade sequence.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeInitChannel(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade, upgradeVersion string) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-init")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	// a new upgrade attempt replaces the previous one, the counterparty is notified
+	// through the error receipt so that it may cancel its side of the previous attempt
+	if _, found := k.GetUpgrade(ctx, portID, channelID); found {
+		k.WriteErrorReceipt(ctx, portID, channelID, types.NewUpgradeError(channel.UpgradeSequence, types.ErrInvalidUpgrade))
+		k.deleteUpgradeInfo(ctx, portID, channelID)
+	}
+
+	channel.UpgradeSequence++
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	upgrade.Fields.Version = upgradeVersion
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+
+	k.Logger(ctx).Info("channel transitioned to upgrade init", "port-id", portID, "channel-id", channelID, "upgrade-sequence", channel.UpgradeSequence)
+
+	EmitChannelUpgradeInitEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeTry is called by a module to accept the first step of a channel upgrade handshake initiated by
+// a module on another chain. The upgrade must have been initialized on this chain by governance. If this function
+// is successful, the channel starts flushing in-flight packets and the proposed upgrade is returned.
+// If the upgrade fails, the upgrade sequence will still be incremented but an error will be returned.
+func (k Keeper) ChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	proposedConnectionHops []string,
+	counterpartyUpgradeFields types.UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	proofCounterpartyChannel,
+	proofCounterpartyUpgrade []byte,
+	proofHeight clienttypes.Height,
+) (types.Channel, types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrUpgradeNotFound, "channel upgrade must be initialized by governance before it can be accepted: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// the relayer provided connection hops must match the connection hops proposed by governance
+	if !reflect.DeepEqual(upgrade.Fields.ConnectionHops, proposedConnectionHops) {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrInvalidUpgrade, "proposed connection hops (%s) do not match the initialized upgrade connection hops (%s)", proposedConnectionHops, upgrade.Fields.ConnectionHops)
+	}
+
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return types.Channel{}, types.Upgrade{}, err
+	}
+
+	// the counterparty channel must be proven to still be OPEN at the upgrade sequence
+	// at which the counterparty initialized the upgrade
+	counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
+	counterpartyChannel := types.NewChannel(types.OPEN, channel.Ordering, types.NewCounterparty(portID, channelID), counterpartyHops, channel.Version)
+	counterpartyChannel.UpgradeSequence = counterpartyUpgradeSequence
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connection, proofHeight, proofCounterpartyChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	counterpartyUpgrade := types.NewUpgrade(counterpartyUpgradeFields, types.Timeout{}, 0)
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx, connection, proofHeight, proofCounterpartyUpgrade,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyUpgrade,
+	); err != nil {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	// if the counterparty sequence is less than the current sequence, then either the counterparty chain is out-of-sync or
+	// the message is out-of-sync and we write an error receipt with our sequence so that the counterparty can abort
+	// their attempt and resync with our sequence. When the next upgrade attempt is initiated, both chains will use a sequence
+	// greater than max(chainA.UpgradeSequence, chainB.UpgradeSequence).
+	if counterpartyUpgradeSequence < channel.UpgradeSequence {
+		return channel, upgrade, types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrapf(
+			types.ErrInvalidUpgradeSequence, "counterparty upgrade sequence < current upgrade sequence (%d < %d)", counterpartyUpgradeSequence, channel.UpgradeSequence,
+		))
+	}
+
+	// if the counterparty sequence is greater than the current sequence, we fast-forward to the counterparty sequence
+	// so that both channel ends are using the same sequence for the current upgrade
+	if counterpartyUpgradeSequence > channel.UpgradeSequence {
+		channel.UpgradeSequence = counterpartyUpgradeSequence
+		k.SetChannel(ctx, portID, channelID, channel)
+	}
+
+	if err := k.checkForUpgradeCompatibility(ctx, upgrade.Fields, counterpartyUpgradeFields); err != nil {
+		return channel, upgrade, types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
+	if err := k.startFlushing(ctx, portID, channelID, &upgrade); err != nil {
+		return types.Channel{}, types.Upgrade{}, err
+	}
+
+	channel, _ = k.GetChannel(ctx, portID, channelID)
+
+	return channel, upgrade, nil
+}
+
+// WriteUpgradeTryChannel writes the channel end and upgrade to state after successfully passing the UpgradeTry handshake step.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTryChannel(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade, upgradeVersion string) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-try")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	upgrade.Fields.Version = upgradeVersion
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+
+	k.Logger(ctx).Info("channel transitioned to upgrade try", "port-id", portID, "channel-id", channelID, "new-state", channel.State.String(), "upgrade-sequence", channel.UpgradeSequence)
+
+	EmitChannelUpgradeTryEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeAck is called by a module to accept the ACKUPGRADE handshake step of the channel upgrade protocol.
+// This method should only be called by the IBC core msg server.
+// This method will verify that the counterparty has called the ChanUpgradeTry handler.
+// and that its own upgrade is compatible with the selected counterparty version.
+// NOTE: the channel may be in either the OPEN or FLUSHING state.
+// The channel may be in OPEN if we are in the happy path.
+//
+//	A -> Init (OPEN), B -> Try (FLUSHING), A -> Ack (begins in OPEN)
+//
+// The channel may be in FLUSHING if we are in a crossing hellos situation.
+//
+//	A -> Init (OPEN), B -> Init (OPEN) -> A -> Try (FLUSHING), B -> Try (FLUSHING), A -> Ack (begins in FLUSHING)
+func (k Keeper) ChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !(channel.State == types.OPEN || channel.State == types.FLUSHING) {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "expected one of [%s, %s], got %s", types.OPEN, types.FLUSHING, channel.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
+	counterpartyChannel := types.NewChannel(types.FLUSHING, channel.Ordering, types.NewCounterparty(portID, channelID), counterpartyHops, channel.Version)
+	counterpartyChannel.UpgradeSequence = channel.UpgradeSequence
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connection, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx, connection, proofHeight, proofUpgrade,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyUpgrade,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	// optimistically accept the version that the TRY chain proposes and pass it to the app callback for confirmation.
+	// In the crossing hellos case, the version returned by our own TRY call is not modified and both TRY calls
+	// are required to have selected the same version.
+	if channel.State == types.OPEN {
+		upgrade.Fields.Version = counterpartyUpgrade.Fields.Version
+	}
+
+	if err := k.checkForUpgradeCompatibility(ctx, upgrade.Fields, counterpartyUpgrade.Fields); err != nil {
+		return types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
+	if upgrade.Fields.Version != counterpartyUpgrade.Fields.Version {
+		return types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrapf(
+			types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade version (%s) to match counterparty upgrade version (%s)", upgrade.Fields.Version, counterpartyUpgrade.Fields.Version,
+		))
+	}
+
+	if channel.State == types.OPEN {
+		if err := k.startFlushing(ctx, portID, channelID, &upgrade); err != nil {
+			return err
+		}
+	}
+
+	// the upgrade is aborted if the counterparty timeout has already elapsed on this chain
+	timeout := counterpartyUpgrade.Timeout
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+	if timeout.Elapsed(selfHeight, selfTimestamp) {
+		return types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "counterparty upgrade timeout elapsed"))
+	}
+
+	return nil
+}
+
+// WriteUpgradeAckChannel writes a channel which has successfully passed the UpgradeAck handshake step as well as
+// setting the upgrade for that channel.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeAckChannel(ctx sdk.Context, portID, channelID string, counterpartyUpgrade types.Upgrade) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-ack")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, portID, channelID, channel)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID))
+	}
+
+	upgrade.Fields.Version = counterpartyUpgrade.Fields.Version
+	k.SetUpgrade(ctx, portID, channelID, upgrade)
+	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
+
+	k.Logger(ctx).Info("channel transitioned to upgrade ack", "port-id", portID, "channel-id", channelID, "state", channel.State.String(), "upgrade-sequence", channel.UpgradeSequence)
+
+	EmitChannelUpgradeAckEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeConfirm is called on the chain which is on FLUSHING after chanUpgradeAck is called on the counterparty.
+// This will inform the TRY chain of the timeout set on ACK by the counterparty. If the timeout has already exceeded, we will write an error receipt and restore.
+func (k Keeper) ChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelState types.State,
+	counterpartyUpgrade types.Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.FLUSHING {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.FLUSHING, channel.State)
+	}
+
+	// the counterparty channel must be proven to be in either FLUSHING or FLUSHCOMPLETE state
+	if !(counterpartyChannelState == types.FLUSHING || counterpartyChannelState == types.FLUSHCOMPLETE) {
+		return sdkerrors.Wrapf(types.ErrInvalidCounterparty, "expected one of [%s, %s], got %s", types.FLUSHING, types.FLUSHCOMPLETE, counterpartyChannelState)
+	}
+
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
+	counterpartyChannel := types.NewChannel(counterpartyChannelState, channel.Ordering, types.NewCounterparty(portID, channelID), counterpartyHops, channel.Version)
+	counterpartyChannel.UpgradeSequence = channel.UpgradeSequence
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connection, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgrade(
+		ctx, connection, proofHeight, proofUpgrade,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyUpgrade,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify counterparty upgrade")
+	}
+
+	// the upgrade is aborted if the counterparty timeout has already elapsed on this chain
+	timeout := counterpartyUpgrade.Timeout
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+	if timeout.Elapsed(selfHeight, selfTimestamp) {
+		return types.NewUpgradeError(channel.UpgradeSequence, sdkerrors.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "counterparty upgrade timeout elapsed"))
+	}
+
+	return nil
+}
+
+// WriteUpgradeConfirmChannel writes a channel which has successfully passed the ChanUpgradeConfirm handshake step.
+// If the channel has no in-flight packets, its state is updated to indicate that flushing has completed. Otherwise, the counterparty upgrade is set
+// and the channel state is left unchanged.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeConfirmChannel(ctx sdk.Context, portID, channelID string, counterpartyUpgrade types.Upgrade) types.Channel {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-confirm")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, portID, channelID, channel)
+	}
+
+	k.SetCounterpartyUpgrade(ctx, portID, channelID, counterpartyUpgrade)
+
+	k.Logger(ctx).Info("channel transitioned to upgrade confirm", "port-id", portID, "channel-id", channelID, "state", channel.State.String(), "upgrade-sequence", channel.UpgradeSequence)
+
+	EmitChannelUpgradeConfirmEvent(ctx, portID, channelID, channel)
+
+	return channel
+}
+
+// ChanUpgradeOpen is called by a module to complete the channel upgrade handshake and move the channel back to an OPEN state.
+// This method should only be called after both channels have flushed any in-flight packets.
+// This method should only be called directly by the core IBC message server.
+func (k Keeper) ChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelState types.State,
+	counterpartyUpgradeSequence uint64,
+	proofCounterpartyChannel []byte,
+	proofHeight clienttypes.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.FLUSHCOMPLETE {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.FLUSHCOMPLETE, channel.State)
+	}
+
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	var counterpartyChannel types.Channel
+	switch counterpartyChannelState {
+	case types.OPEN:
+		upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		if counterpartyUpgradeSequence < channel.UpgradeSequence {
+			return sdkerrors.Wrapf(types.ErrInvalidUpgradeSequence, "counterparty channel upgrade sequence (%d) must be greater than or equal to current upgrade sequence (%d)", counterpartyUpgradeSequence, channel.UpgradeSequence)
+		}
+
+		// If counterparty has reached OPEN, we must use the upgraded connection to verify the counterparty channel
+		upgradeConnection, err := k.getOpenConnection(ctx, upgrade.Fields.ConnectionHops[0])
+		if err != nil {
+			return err
+		}
+
+		counterpartyHops := []string{upgradeConnection.GetCounterparty().GetConnectionID()}
+		counterpartyChannel = types.NewChannel(types.OPEN, upgrade.Fields.Ordering, types.NewCounterparty(portID, channelID), counterpartyHops, upgrade.Fields.Version)
+		counterpartyChannel.UpgradeSequence = counterpartyUpgradeSequence
+
+	case types.FLUSHCOMPLETE:
+		counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
+		counterpartyChannel = types.NewChannel(types.FLUSHCOMPLETE, channel.Ordering, types.NewCounterparty(portID, channelID), counterpartyHops, channel.Version)
+		counterpartyChannel.UpgradeSequence = channel.UpgradeSequence
+
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidCounterparty, "counterparty channel state must be one of [%s, %s], got %s", types.OPEN, types.FLUSHCOMPLETE, counterpartyChannelState)
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connection, proofHeight, proofCounterpartyChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify counterparty channel")
+	}
+
+	return nil
+}
+
+// WriteUpgradeOpenChannel writes the agreed upon upgrade fields to the channel, and sets the channel state back to OPEN. This can be called in one of two cases:
+// - In the UpgradeConfirm step of the handshake if both sides have already flushed any in-flight packets.
+// - In the UpgradeOpen step of the handshake.
+func (k Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID string) types.Channel {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-open")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID))
+	}
+
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve counterparty channel upgrade: port ID (%s) channel ID (%s)", portID, channelID))
+	}
+
+	// packets sent by the counterparty before flushing started have all been received or timed out,
+	// and their acknowledgements have been processed by the counterparty. Packets below this sequence
+	// are rejected by the upgraded channel and their acknowledgements and receipts may be pruned.
+	k.SetRecvStartSequence(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
+	if _, found := k.GetPruningSequenceStart(ctx, portID, channelID); !found {
+		k.SetPruningSequenceStart(ctx, portID, channelID, 1)
+	}
+
+	previousState := channel.State
+
+	channel.Ordering = upgrade.Fields.Ordering
+	channel.Version = upgrade.Fields.Version
+	channel.ConnectionHops = upgrade.Fields.ConnectionHops
+	channel.State = types.OPEN
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	// delete state associated with upgrade which is no longer required.
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel transitioned to upgrade open", "port-id", portID, "channel-id", channelID, "previous-state", previousState.String(), "new-state", types.OPEN.String())
+
+	EmitChannelUpgradeOpenEvent(ctx, portID, channelID, channel)
+
+	return channel
+}
+
+// ChanUpgradeTimeout times out an outstanding upgrade.
+// This should be used by the initialising chain when the counterparty chain has not responded to an upgrade proposal within the specified timeout period.
+func (k Keeper) ChanUpgradeTimeout(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannel types.Channel,
+	proofCounterpartyChannel []byte,
+	proofHeight clienttypes.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !(channel.State == types.FLUSHING || channel.State == types.FLUSHCOMPLETE) {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "expected one of [%s, %s], got %s", types.FLUSHING, types.FLUSHCOMPLETE, channel.State)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connection, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	// proof must be from a height after the upgrade timeout has elapsed
+	if !upgrade.Timeout.Elapsed(proofHeight, proofTimestamp) {
+		return sdkerrors.Wrap(upgrade.Timeout.ErrTimeoutNotReached(proofHeight, proofTimestamp), "upgrade timeout has not been reached")
+	}
+
+	// counterparty channel must be proved to still be in OPEN or FLUSHING state
+	if !(counterpartyChannel.State == types.OPEN || counterpartyChannel.State == types.FLUSHING) {
+		return sdkerrors.Wrapf(types.ErrInvalidCounterparty, "expected one of [%s, %s], got %s", types.OPEN, types.FLUSHING, counterpartyChannel.State)
+	}
+
+	if counterpartyChannel.State == types.OPEN {
+		upgradeConnection, err := k.getOpenConnection(ctx, upgrade.Fields.ConnectionHops[0])
+		if err != nil {
+			return err
+		}
+
+		// the upgrade cannot be timed out if the counterparty has already completed it
+		counterpartyHops := []string{upgradeConnection.GetCounterparty().GetConnectionID()}
+		upgradeAlreadyComplete := upgrade.Fields.Version == counterpartyChannel.Version &&
+			upgrade.Fields.Ordering == counterpartyChannel.Ordering &&
+			reflect.DeepEqual(counterpartyHops, counterpartyChannel.ConnectionHops)
+		if upgradeAlreadyComplete {
+			return sdkerrors.Wrap(types.ErrInvalidUpgrade, "counterparty channel is already upgraded")
+		}
+	}
+
+	if counterpartyChannel.UpgradeSequence < channel.UpgradeSequence {
+		return sdkerrors.Wrapf(types.ErrInvalidUpgradeSequence, "counterparty channel upgrade sequence (%d) must be greater than or equal to current upgrade sequence (%d)", counterpartyChannel.UpgradeSequence, channel.UpgradeSequence)
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connection, proofHeight, proofCounterpartyChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify counterparty channel state")
+	}
+
+	return nil
+}
+
+// WriteUpgradeTimeoutChannel restores the channel state of an initialising chain in the event that the counterparty chain has passed the timeout set in ChanUpgradeInit to the state before the upgrade was proposed.
+// Auxiliary upgrade state is also deleted.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeTimeoutChannel(ctx sdk.Context, portID, channelID string) (types.Channel, types.Upgrade) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-timeout")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID))
+	}
+
+	channel = k.restoreChannel(ctx, portID, channelID, channel.UpgradeSequence, channel)
+	k.WriteErrorReceipt(ctx, portID, channelID, types.NewUpgradeError(channel.UpgradeSequence, types.ErrTimeoutElapsed))
+
+	k.Logger(ctx).Info("channel state restored", "port-id", portID, "channel-id", channelID)
+
+	EmitChannelUpgradeTimeoutEvent(ctx, portID, channelID, channel, upgrade)
+
+	return channel, upgrade
+}
+
+// ChanUpgradeCancel is called by the msg server to prove that an error receipt was written on the counterparty
+// which constitutes a valid situation where the upgrade should be cancelled. An error is returned if sufficient evidence
+// for cancelling the upgrade has not been provided.
+func (k Keeper) ChanUpgradeCancel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	errorReceipt types.ErrorReceipt,
+	errorReceiptProof []byte,
+	proofHeight clienttypes.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if _, found := k.GetUpgrade(ctx, portID, channelID); !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// the error receipt should have a sequence greater than or equal to the current upgrade sequence
+	if errorReceipt.Sequence < channel.UpgradeSequence {
+		return sdkerrors.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than or equal to current upgrade sequence (%d)", errorReceipt.Sequence, channel.UpgradeSequence)
+	}
+
+	connection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelUpgradeError(
+		ctx, connection, proofHeight, errorReceiptProof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		errorReceipt,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to verify counterparty error receipt")
+	}
+
+	return nil
+}
+
+// WriteUpgradeCancelChannel writes a channel which has canceled the upgrade process. Auxiliary upgrade state is
+// also deleted and an error receipt is written so that the counterparty may also cancel the upgrade.
+func (k Keeper) WriteUpgradeCancelChannel(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	defer telemetry.IncrCounter(1, "ibc", "channel", "upgrade-cancel")
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID))
+	}
+
+	channel = k.restoreChannel(ctx, portID, channelID, sequence, channel)
+	k.WriteErrorReceipt(ctx, portID, channelID, types.NewUpgradeError(sequence, types.ErrInvalidUpgrade))
+
+	k.Logger(ctx).Info("channel state restored", "port-id", portID, "channel-id", channelID)
+
+	EmitChannelUpgradeCancelEvent(ctx, portID, channelID, channel)
+}
+
+// WriteErrorReceipt will write an error receipt from the provided UpgradeError.
+func (k Keeper) WriteErrorReceipt(ctx sdk.Context, portID, channelID string, upgradeError *types.UpgradeError) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID))
+	}
+
+	errorReceiptToWrite := upgradeError.GetErrorReceipt()
+
+	existingErrorReceipt, found := k.GetUpgradeErrorReceipt(ctx, portID, channelID)
+	if found && existingErrorReceipt.Sequence >= errorReceiptToWrite.Sequence {
+		panic(sdkerrors.Wrapf(types.ErrInvalidUpgradeSequence, "error receipt sequence (%d) must be greater than existing error receipt sequence (%d)", errorReceiptToWrite.Sequence, existingErrorReceipt.Sequence))
+	}
+
+	k.setUpgradeErrorReceipt(ctx, portID, channelID, errorReceiptToWrite)
+	EmitErrorReceiptEvent(ctx, portID, channelID, channel, upgradeError)
+}
+
+// MustAbortUpgrade will restore the channel state to its pre-upgrade state so that upgrade is aborted.
+// Any unnecessary state is deleted and an error receipt is written.
+// This function is expected to always succeed, a panic will occur if an error occurs.
+func (k Keeper) MustAbortUpgrade(ctx sdk.Context, portID, channelID string, err error) {
+	if err := k.abortUpgrade(ctx, portID, channelID, err); err != nil {
+		panic(err)
+	}
+}
+
+// abortUpgrade will restore the channel state to its pre-upgrade state so that upgrade is aborted.
+// Any unnecessary state is deleted and an error receipt is written.
+func (k Keeper) abortUpgrade(ctx sdk.Context, portID, channelID string, err error) error {
+	if err == nil {
+		return sdkerrors.Wrap(types.ErrInvalidUpgradeErrorReceipt, "cannot abort upgrade handshake with nil error")
+	}
+
+	if _, found := k.GetUpgrade(ctx, portID, channelID); !found {
+		return sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// in the case of application callbacks, the error may not be an upgrade error.
+	// in this case we need to construct one in order to write the error receipt.
+	var upgradeError *types.UpgradeError
+	if !errors.As(err, &upgradeError) {
+		upgradeError = types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
+	channel = k.restoreChannel(ctx, portID, channelID, channel.UpgradeSequence, channel)
+	k.WriteErrorReceipt(ctx, portID, channelID, upgradeError)
+
+	return nil
+}
+
+// restoreChannel will restore the channel state to its pre-upgrade state so that upgrade is aborted.
+func (k Keeper) restoreChannel(ctx sdk.Context, portID, channelID string, upgradeSequence uint64, channel types.Channel) types.Channel {
+	channel.State = types.OPEN
+	channel.UpgradeSequence = upgradeSequence
+
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	// delete state associated with upgrade which is no longer required.
+	k.deleteUpgradeInfo(ctx, portID, channelID)
+
+	return channel
+}
+
+// startFlushing will set the upgrade last packet send and continue blocking the upgrade from continuing until all
+// in-flight packets have been flushed.
+func (k Keeper) startFlushing(ctx sdk.Context, portID, channelID string, upgrade *types.Upgrade) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	channel.State = types.FLUSHING
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	upgrade.NextSequenceSend = nextSequenceSend
+	upgrade.Timeout = types.NewTimeout(clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(types.UpgradeTimeoutPeriod).UnixNano()))
+	k.SetUpgrade(ctx, portID, channelID, *upgrade)
+
+	return nil
+}
+
+// handleFlushState is called when a packet is acknowledged or timed out while the channel is in FLUSHING state.
+// If the counterparty upgrade timeout has elapsed the upgrade is aborted, otherwise the channel is moved to
+// FLUSHCOMPLETE once all in-flight packets have been flushed.
+func (k Keeper) handleFlushState(ctx sdk.Context, portID, channelID string) {
+	counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	timeout := counterpartyUpgrade.Timeout
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+
+	if timeout.Elapsed(selfHeight, selfTimestamp) {
+		// packet flushing timeout has expired, abort the upgrade
+		// committing an error receipt to state, deleting upgrade information and restoring the channel.
+		k.Logger(ctx).Info("upgrade aborted", "port-id", portID, "channel-id", channelID)
+		k.MustAbortUpgrade(ctx, portID, channelID, timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp))
+		return
+	}
+
+	if !k.HasInflightPackets(ctx, portID, channelID) {
+		channel, found := k.GetChannel(ctx, portID, channelID)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID))
+		}
+
+		// set the channel state to flush complete if all packets have been acknowledged/flushed.
+		channel.State = types.FLUSHCOMPLETE
+		k.SetChannel(ctx, portID, channelID, channel)
+
+		EmitChannelFlushCompleteEvent(ctx, portID, channelID, channel)
+	}
+}
+
+// validateSelfUpgradeFields validates the proposed upgrade fields against the existing channel.
+// It returns an error if the following constraints are not met:
+// - there exists at least one valid proposed change to the existing channel fields
+// - the proposed order does not downgrade an UNORDERED channel to ORDERED or ORDERED_ALLOW_TIMEOUT
+// - the proposed connection hops do not exist
+// - the proposed version is non-empty (checked in UpgradeFields.ValidateBasic())
+// - the proposed connection hops are not open
+func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types.UpgradeFields, currentChannel types.Channel) error {
+	currentFields := types.NewUpgradeFields(currentChannel.Ordering, currentChannel.ConnectionHops, currentChannel.Version)
+	if reflect.DeepEqual(proposedUpgrade, currentFields) {
+		return sdkerrors.Wrapf(types.ErrInvalidUpgrade, "existing channel end is identical to proposed upgrade channel end: got %s", proposedUpgrade)
+	}
+
+	if currentChannel.Ordering == types.UNORDERED && proposedUpgrade.Ordering != types.UNORDERED {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelOrdering, "channel ordering cannot be upgraded from %s to %s", currentChannel.Ordering, proposedUpgrade.Ordering)
+	}
+
+	if _, err := k.getOpenConnection(ctx, proposedUpgrade.ConnectionHops[0]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkForUpgradeCompatibility checks performs stateful validation of self upgrade fields relative to counterparty upgrade.
+func (k Keeper) checkForUpgradeCompatibility(ctx sdk.Context, upgradeFields, counterpartyUpgradeFields types.UpgradeFields) error {
+	// assert that both sides propose the same channel ordering
+	if upgradeFields.Ordering != counterpartyUpgradeFields.Ordering {
+		return sdkerrors.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "expected upgrade ordering (%s) to match counterparty upgrade ordering (%s)", upgradeFields.Ordering, counterpartyUpgradeFields.Ordering)
+	}
+
+	proposedConnection, err := k.getOpenConnection(ctx, upgradeFields.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if proposedConnection.GetCounterparty().GetConnectionID() != counterpartyUpgradeFields.ConnectionHops[0] {
+		return sdkerrors.Wrapf(types.ErrIncompatibleCounterpartyUpgrade, "counterparty upgrade connection end is not a counterparty of self proposed connection end (%s != %s)", counterpartyUpgradeFields.ConnectionHops[0], proposedConnection.GetCounterparty().GetConnectionID())
+	}
+
+	return nil
+}
+
+// getOpenConnection returns the connection with the provided identifier, or an error if
+// the connection does not exist or is not OPEN.
+func (k Keeper) getOpenConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.GetState() != int32(connectiontypes.OPEN) {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connection.GetState()).String(),
+		)
+	}
+
+	return connection, nil
+}
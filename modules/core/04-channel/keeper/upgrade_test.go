@@ -0,0 +1,410 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+// newUpgradePath creates an open mock channel between chainA and chainB where both
+// endpoints propose to upgrade the channel to the mock upgrade version.
+func (suite *KeeperTestSuite) newUpgradePath(order types.Order) *ibctesting.Path {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Order = order
+	path.EndpointB.ChannelConfig.Order = order
+	suite.coordinator.Setup(path)
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade = types.NewUpgrade(
+		types.NewUpgradeFields(types.UNORDERED, []string{path.EndpointA.ConnectionID}, mock.UpgradeVersion), types.Timeout{}, 0,
+	)
+	path.EndpointB.ChannelConfig.ProposedUpgrade = types.NewUpgrade(
+		types.NewUpgradeFields(types.UNORDERED, []string{path.EndpointB.ConnectionID}, mock.UpgradeVersion), types.Timeout{}, 0,
+	)
+
+	return path
+}
+
+// TestChanUpgradeInit tests the validation performed when a channel upgrade is initialized.
+func (suite *KeeperTestSuite) TestChanUpgradeInit() {
+	var (
+		path   *ibctesting.Path
+		fields types.UpgradeFields
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"success: upgrade replaces an existing upgrade", func() {
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+		}, true},
+		{"channel not found", func() {
+			path.EndpointA.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"channel is not OPEN", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, false},
+		{"proposed fields are identical to the existing channel", func() {
+			channel := path.EndpointA.GetChannel()
+			fields = types.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, channel.Version)
+		}, false},
+		{"unordered channel cannot be upgraded to ordered", func() {
+			fields.Ordering = types.ORDERED
+		}, false},
+		{"unordered channel cannot be upgraded to ordered allow timeout", func() {
+			fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+		}, false},
+		{"proposed connection not found", func() {
+			fields.ConnectionHops = []string{ibctesting.InvalidID}
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = suite.newUpgradePath(types.UNORDERED)
+			fields = path.EndpointA.ChannelConfig.ProposedUpgrade.Fields
+
+			tc.malleate()
+
+			upgrade, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, fields,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(fields, upgrade.Fields)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestWriteUpgradeInitChannel tests that the upgrade sequence is incremented when an upgrade is
+// initialized and that an error receipt is written when an existing upgrade is replaced.
+func (suite *KeeperTestSuite) TestWriteUpgradeInitChannel() {
+	path := suite.newUpgradePath(types.UNORDERED)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+	channel := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.ProposedUpgrade.Fields, path.EndpointA.GetChannelUpgrade().Fields)
+
+	_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+
+	// initializing a new upgrade aborts the previous attempt
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+	channel = path.EndpointA.GetChannel()
+	suite.Require().Equal(uint64(2), channel.UpgradeSequence)
+
+	errorReceipt, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), errorReceipt.Sequence)
+}
+
+// TestChanUpgradeTry tests the ChanUpgradeTry handshake step. The upgrade is initialized on
+// both chains before chainB accepts the upgrade proposed by chainA.
+func (suite *KeeperTestSuite) TestChanUpgradeTry() {
+	var (
+		path                   *ibctesting.Path
+		proposedConnectionHops []string
+		expUpgradeError        bool
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"success: counterparty upgrade sequence is greater than the current sequence", func() {
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+		}, true},
+		{"channel not found", func() {
+			path.EndpointB.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"upgrade has not been initialized", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.UpgradeSequence = 0
+			path.EndpointB.SetChannel(channel)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.MustAbortUpgrade(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, types.ErrInvalidUpgrade)
+		}, false},
+		{"proposed connection hops do not match the initialized upgrade", func() {
+			proposedConnectionHops = []string{ibctesting.InvalidID}
+		}, false},
+		{"invalid counterparty proof", func() {
+			// do not update the client on chainB so that the new upgrade cannot be proven
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+		}, false},
+		{"counterparty upgrade sequence is less than the current sequence", func() {
+			expUpgradeError = true
+			suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expUpgradeError = false
+
+			path = suite.newUpgradePath(types.UNORDERED)
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			proposedConnectionHops = path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops
+
+			tc.malleate()
+
+			counterpartyUpgrade := path.EndpointA.GetChannelUpgrade()
+			counterpartyUpgradeSequence := path.EndpointA.GetChannel().UpgradeSequence
+			proofChannel, proofUpgrade, proofHeight := path.EndpointA.QueryChannelUpgradeProof()
+
+			channel, upgrade, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeTry(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				proposedConnectionHops, counterpartyUpgrade.Fields, counterpartyUpgradeSequence,
+				proofChannel, proofUpgrade, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.FLUSHING, channel.State)
+				suite.Require().Equal(counterpartyUpgradeSequence, channel.UpgradeSequence)
+				suite.Require().NotZero(upgrade.NextSequenceSend)
+				suite.Require().True(upgrade.Timeout.IsValid())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(expUpgradeError, types.IsUpgradeError(err))
+			}
+		})
+	}
+}
+
+// TestChanUpgradeTryIncompatibleOrdering tests that an upgrade error is returned when both
+// chains propose a different channel ordering.
+func (suite *KeeperTestSuite) TestChanUpgradeTryIncompatibleOrdering() {
+	path := suite.newUpgradePath(types.ORDERED)
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+
+	// the msg server aborts the upgrade and writes an error receipt
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+	channel := path.EndpointB.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(mock.Version, channel.Version)
+
+	errorReceipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channel.UpgradeSequence, errorReceipt.Sequence)
+
+	// the counterparty cancels its side of the upgrade using the error receipt
+	suite.Require().NoError(path.EndpointA.ChanUpgradeCancel())
+
+	channel = path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(mock.Version, channel.Version)
+
+	_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+}
+
+// TestUpgradeHandshake tests a successful upgrade handshake when no packets are in-flight.
+func (suite *KeeperTestSuite) TestUpgradeHandshake() {
+	path := suite.newUpgradePath(types.UNORDERED)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().Equal(types.FLUSHING, path.EndpointB.GetChannel().State)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().Equal(types.FLUSHCOMPLETE, path.EndpointA.GetChannel().State)
+
+	// both ends have flushed, chainB moves directly to OPEN
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		suite.Require().Equal(types.OPEN, channel.State)
+		suite.Require().Equal(mock.UpgradeVersion, channel.Version)
+		suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+		_, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+		suite.Require().False(found)
+
+		_, found = endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetCounterpartyUpgrade(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+		suite.Require().False(found)
+	}
+}
+
+// TestUpgradeHandshakeWithInflightPackets tests that an upgrade only completes once all packets
+// sent before the upgrade started have been flushed, and that no new packets may be sent.
+func (suite *KeeperTestSuite) TestUpgradeHandshakeWithInflightPackets() {
+	path := suite.newUpgradePath(types.ORDERED)
+
+	// send a packet which is in-flight when the upgrade starts
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().Equal(types.FLUSHING, path.EndpointA.GetChannel().State)
+
+	// new packets cannot be sent while flushing
+	newPacket := types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(suite.chainA.GetContext(), suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), newPacket)
+	suite.Require().ErrorIs(err, types.ErrInvalidChannelState)
+
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().Equal(types.FLUSHCOMPLETE, path.EndpointB.GetChannel().State)
+
+	// chainA moves to FLUSHCOMPLETE once the in-flight packet is acknowledged
+	suite.Require().NoError(path.RelayPacket(packet))
+	suite.Require().Equal(types.FLUSHCOMPLETE, path.EndpointA.GetChannel().State)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeOpen())
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		suite.Require().Equal(types.OPEN, channel.State)
+		suite.Require().Equal(types.UNORDERED, channel.Ordering)
+		suite.Require().Equal(mock.UpgradeVersion, channel.Version)
+	}
+
+	// packets sent before the upgrade are rejected by the UNORDERED channel
+	recvStartSequence, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetRecvStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), recvStartSequence)
+}
+
+// TestChanUpgradeTimeout tests that an upgrade is restored once the counterparty has not
+// progressed the handshake before the upgrade timeout elapsed.
+func (suite *KeeperTestSuite) TestChanUpgradeTimeout() {
+	path := suite.newUpgradePath(types.UNORDERED)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+	// the timeout has not yet been reached on the counterparty
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	proofChannel, proofHeight := path.EndpointA.QueryProof(channelKey)
+
+	err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeTimeout(
+		suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		path.EndpointA.GetChannel(), proofChannel, proofHeight,
+	)
+	suite.Require().ErrorIs(err, types.ErrTimeoutNotReached)
+
+	suite.coordinator.IncrementTimeBy(types.UpgradeTimeoutPeriod + time.Minute)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTimeout())
+
+	channel := path.EndpointB.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(mock.Version, channel.Version)
+
+	errorReceipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channel.UpgradeSequence, errorReceipt.Sequence)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeCancel())
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+}
+
+// TestChanUpgradeAckTimeoutElapsed tests that the upgrade is aborted on ACK if the timeout
+// set by the counterparty has already elapsed.
+func (suite *KeeperTestSuite) TestChanUpgradeAckTimeoutElapsed() {
+	path := suite.newUpgradePath(types.UNORDERED)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+	suite.coordinator.IncrementTimeBy(types.UpgradeTimeoutPeriod + time.Minute)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+	channel := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channel.State)
+	suite.Require().Equal(mock.Version, channel.Version)
+
+	_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+
+	suite.Require().NoError(path.EndpointB.ChanUpgradeCancel())
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+}
+
+// TestChanUpgradeCancel tests the validation of the error receipt used to cancel an upgrade.
+func (suite *KeeperTestSuite) TestChanUpgradeCancel() {
+	var (
+		path         *ibctesting.Path
+		errorReceipt types.ErrorReceipt
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"upgrade not found", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.MustAbortUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.ErrInvalidUpgrade)
+		}, false},
+		{"error receipt sequence is less than the current upgrade sequence", func() {
+			errorReceipt.Sequence = 0
+		}, false},
+		{"error receipt does not match proof", func() {
+			errorReceipt.Message = "invalid message"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = suite.newUpgradePath(types.UNORDERED)
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+			upgradeErr := types.NewUpgradeError(path.EndpointA.GetChannel().UpgradeSequence, types.ErrInvalidUpgrade)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.WriteErrorReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, upgradeErr)
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			errorReceipt = upgradeErr.GetErrorReceipt()
+
+			tc.malleate()
+
+			errorReceiptKey := host.ChannelUpgradeErrorKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			proof, proofHeight := path.EndpointB.QueryProof(errorReceiptKey)
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeCancel(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				errorReceipt, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ func TestChannelValidateBasic(t *testing.T) {
 		expPass bool
 	}{
 		{"valid channel", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, connHops, version), true},
+		{"valid channel with ORDERED_ALLOW_TIMEOUT order", types.NewChannel(types.TRYOPEN, types.ORDERED_ALLOW_TIMEOUT, counterparty, connHops, version), true},
 		{"invalid state", types.NewChannel(types.UNINITIALIZED, types.ORDERED, counterparty, connHops, version), false},
 		{"invalid order", types.NewChannel(types.TRYOPEN, types.NONE, counterparty, connHops, version), false},
 		{"more than 1 connection hop", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"connection1", "connection2"}, version), false},
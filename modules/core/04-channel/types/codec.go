@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
@@ -37,8 +38,25 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelCloseConfirm{},
 		&MsgRecvPacket{},
 		&MsgAcknowledgement{},
+		&MsgRecvPackets{},
+		&MsgAcknowledgements{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
+		&MsgChannelUpgradeTry{},
+		&MsgChannelUpgradeAck{},
+		&MsgChannelUpgradeConfirm{},
+		&MsgChannelUpgradeOpen{},
+		&MsgChannelUpgradeTimeout{},
+		&MsgChannelUpgradeCancel{},
+		&MsgPruneAcknowledgements{},
+		&MsgAdvanceTimeoutHorizon{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ChannelUpgradeProposal{},
+		&ChannelCloseProposal{},
+		&ChannelFreezeProposal{},
+		&ChannelUnfreezeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
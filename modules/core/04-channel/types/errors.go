@@ -38,4 +38,29 @@ var (
 	ErrNoOpMsg = sdkerrors.Register(SubModuleName, 23, "message is redundant, no-op will be performed")
 
 	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+	ErrPacketNotSent         = sdkerrors.Register(SubModuleName, 25, "packet has not been sent")
+
+	// channel upgrade errors
+	ErrInvalidUpgrade                  = sdkerrors.Register(SubModuleName, 26, "invalid channel upgrade")
+	ErrUpgradeNotFound                 = sdkerrors.Register(SubModuleName, 27, "channel upgrade not found")
+	ErrInvalidUpgradeSequence          = sdkerrors.Register(SubModuleName, 28, "invalid channel upgrade sequence")
+	ErrUpgradeErrorNotFound            = sdkerrors.Register(SubModuleName, 29, "channel upgrade error receipt not found")
+	ErrInvalidUpgradeErrorReceipt      = sdkerrors.Register(SubModuleName, 30, "invalid channel upgrade error receipt")
+	ErrIncompatibleCounterpartyUpgrade = sdkerrors.Register(SubModuleName, 31, "incompatible counterparty upgrade")
+	ErrInvalidTimeout                  = sdkerrors.Register(SubModuleName, 32, "invalid timeout")
+	ErrTimeoutElapsed                  = sdkerrors.Register(SubModuleName, 33, "timeout elapsed")
+	ErrTimeoutNotReached               = sdkerrors.Register(SubModuleName, 34, "timeout not reached")
+	ErrPendingInflightPackets          = sdkerrors.Register(SubModuleName, 35, "pending inflight packets exist")
+	ErrUpgradeAborted                  = sdkerrors.Register(SubModuleName, 36, "channel upgrade aborted")
+
+	// pruning errors
+	ErrPruningSequenceStartNotFound = sdkerrors.Register(SubModuleName, 37, "pruning sequence start not found")
+	ErrRecvStartSequenceNotFound    = sdkerrors.Register(SubModuleName, 38, "recv start sequence not found")
+
+	// Commit the timeout receipt of a timed-out packet on an ORDERED_ALLOW_TIMEOUT channel
+	ErrTimeoutReceiptWritten = sdkerrors.Register(SubModuleName, 39, "packet timed out, timeout receipt written")
+
+	// governance errors
+	ErrChannelFrozen    = sdkerrors.Register(SubModuleName, 40, "channel is frozen")
+	ErrChannelNotFrozen = sdkerrors.Register(SubModuleName, 41, "channel is not frozen")
 )
@@ -15,6 +15,20 @@ const (
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
 
+	AttributeKeyChannelState            = "channel_state"
+	AttributeKeyUpgradeConnectionHops   = "upgrade_connection_hops"
+	AttributeKeyUpgradeVersion          = "upgrade_version"
+	AttributeKeyUpgradeOrdering         = "upgrade_ordering"
+	AttributeKeyUpgradeSequence         = "upgrade_sequence"
+	AttributeKeyUpgradeTimeoutHeight    = "upgrade_timeout_height"
+	AttributeKeyUpgradeTimeoutTimestamp = "upgrade_timeout_timestamp"
+	AttributeKeyUpgradeErrorReceipt     = "upgrade_error_receipt"
+
+	AttributeKeyPruningSequenceStart    = "pruning_sequence_start"
+	AttributeKeyTotalPrunedSequences    = "total_pruned_sequences"
+	AttributeKeyTotalRemainingSequences = "total_remaining_sequences"
+	AttributeKeyTimeoutHorizon          = "timeout_horizon"
+
 	EventTypeSendPacket           = "send_packet"
 	EventTypeRecvPacket           = "recv_packet"
 	EventTypeWriteAck             = "write_acknowledgement"
@@ -50,5 +64,22 @@ var (
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
 	EventTypeChannelClosed       = "channel_close"
 
+	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
+	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
+	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
+	EventTypeChannelUpgradeConfirm = "channel_upgrade_confirm"
+	EventTypeChannelUpgradeOpen    = "channel_upgrade_open"
+	EventTypeChannelUpgradeTimeout = "channel_upgrade_timeout"
+	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
+	EventTypeChannelUpgradeError   = "channel_upgrade_error"
+	EventTypeChannelFlushComplete  = "channel_flush_complete"
+
+	EventTypePruneAcknowledgements = "prune_acknowledgements"
+	EventTypeAdvanceTimeoutHorizon = "advance_timeout_horizon"
+
+	EventTypeChannelForceClose = "channel_force_close"
+	EventTypeChannelFrozen     = "channel_frozen"
+	EventTypeChannelUnfrozen   = "channel_unfrozen"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
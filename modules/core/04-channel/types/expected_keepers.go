@@ -32,6 +32,24 @@ type ConnectionKeeper interface {
 		channelID string,
 		channel exported.ChannelI,
 	) error
+	VerifyChannelUpgrade(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		upgrade exported.UpgradeI,
+	) error
+	VerifyChannelUpgradeError(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		errorReceipt exported.ErrorReceiptI,
+	) error
 	VerifyPacketCommitment(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -52,6 +70,24 @@ type ConnectionKeeper interface {
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		commitments map[uint64][]byte,
+	) error
+	VerifyPacketAcknowledgements(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		acknowledgements map[uint64][]byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -61,6 +97,25 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketCommitmentAbsence(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -41,6 +41,26 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewFrozenChannel creates a new FrozenChannel instance.
+func NewFrozenChannel(portID, channelID string) FrozenChannel {
+	return FrozenChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (fc FrozenChannel) Validate() error {
+	if err := host.PortIdentifierValidator(fc.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(fc.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	return nil
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
@@ -60,14 +80,18 @@ func NewGenesisState(
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
+		Channels:              []IdentifiedChannel{},
+		Acknowledgements:      []PacketState{},
+		Receipts:              []PacketState{},
+		Commitments:           []PacketState{},
+		SendSequences:         []PacketSequence{},
+		RecvSequences:         []PacketSequence{},
+		AckSequences:          []PacketSequence{},
+		NextChannelSequence:   0,
+		FrozenChannels:        []FrozenChannel{},
+		Statistics:            []ChannelStatistics{},
+		PruningSequenceStarts: []PacketSequence{},
+		RecvStartSequences:    []PacketSequence{},
 	}
 }
 
@@ -139,6 +163,30 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, fc := range gs.FrozenChannels {
+		if err := fc.Validate(); err != nil {
+			return fmt.Errorf("invalid frozen channel %v index %d: %w", fc, i, err)
+		}
+	}
+
+	for i, cs := range gs.Statistics {
+		if err := cs.Validate(); err != nil {
+			return fmt.Errorf("invalid channel statistics %v index %d: %w", cs, i, err)
+		}
+	}
+
+	for i, ps := range gs.PruningSequenceStarts {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence start %v index %d: %w", ps, i, err)
+		}
+	}
+
+	for i, rs := range gs.RecvStartSequences {
+		if err := rs.Validate(); err != nil {
+			return fmt.Errorf("invalid recv start sequence %v index %d: %w", rs, i, err)
+		}
+	}
+
 	return nil
 }
 
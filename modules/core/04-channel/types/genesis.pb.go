@@ -34,6 +34,14 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty" yaml:"next_channel_sequence"`
+	// channels frozen by governance
+	FrozenChannels []FrozenChannel `protobuf:"bytes,9,rep,name=frozen_channels,json=frozenChannels,proto3" json:"frozen_channels" yaml:"frozen_channels"`
+	// packet lifecycle statistics of the channels
+	Statistics []ChannelStatistics `protobuf:"bytes,10,rep,name=statistics,proto3" json:"statistics"`
+	// next sequences to be pruned of the channels
+	PruningSequenceStarts []PacketSequence `protobuf:"bytes,11,rep,name=pruning_sequence_starts,json=pruningSequenceStarts,proto3" json:"pruning_sequence_starts" yaml:"pruning_sequence_starts"`
+	// sequences below which packets are no longer received on the channels
+	RecvStartSequences []PacketSequence `protobuf:"bytes,12,rep,name=recv_start_sequences,json=recvStartSequences,proto3" json:"recv_start_sequences" yaml:"recv_start_sequences"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +133,34 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetFrozenChannels() []FrozenChannel {
+	if m != nil {
+		return m.FrozenChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStatistics() []ChannelStatistics {
+	if m != nil {
+		return m.Statistics
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceStarts() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceStarts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecvStartSequences() []PacketSequence {
+	if m != nil {
+		return m.RecvStartSequences
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,39 +231,48 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0xe3, 0x36, 0x4d, 0xd3, 0x6d, 0x13, 0xd1, 0x6d, 0x23, 0x99, 0xa8, 0xd8, 0xc6, 0x48,
-	0x28, 0x12, 0xaa, 0x4d, 0x69, 0x2f, 0x70, 0x34, 0x07, 0xc8, 0x0d, 0x6d, 0x39, 0x21, 0xa1, 0xc8,
-	0x5e, 0x4f, 0xdd, 0x55, 0x62, 0x6f, 0xf0, 0x6e, 0x02, 0x7d, 0x0a, 0x78, 0xac, 0x1e, 0x7b, 0xe4,
-	0x64, 0xa1, 0xe4, 0x0d, 0x72, 0xe4, 0x84, 0xfc, 0x37, 0x89, 0x1a, 0x21, 0xca, 0xcd, 0x3b, 0xf3,
-	0x9b, 0xef, 0x9b, 0x83, 0x07, 0x3d, 0x65, 0x1e, 0xb5, 0x29, 0x8f, 0xc1, 0xa6, 0xd7, 0x6e, 0x14,
-	0xc1, 0xc8, 0x9e, 0x9e, 0xd9, 0x01, 0x44, 0x20, 0x98, 0xb0, 0xc6, 0x31, 0x97, 0x1c, 0x1f, 0x31,
-	0x8f, 0x5a, 0x69, 0xc4, 0x2a, 0x22, 0xd6, 0xf4, 0xac, 0x7b, 0x1c, 0xf0, 0x80, 0x67, 0x7d, 0x3b,
-	0xfd, 0xca, 0xa3, 0xdd, 0x8d, 0xb4, 0x72, 0x2a, 0x8b, 0x98, 0xf3, 0x1d, 0x74, 0xf0, 0x2e, 0xe7,
-	0x5f, 0x4a, 0x57, 0x02, 0xfe, 0x8c, 0x9a, 0x45, 0x42, 0xa8, 0x8a, 0xb1, 0xdd, 0xdb, 0x7f, 0xf5,
-	0xdc, 0xda, 0x60, 0xb4, 0xfa, 0x3e, 0x44, 0x92, 0x5d, 0x31, 0xf0, 0xdf, 0xe6, 0x45, 0xe7, 0xf1,
-	0x6d, 0xa2, 0xd7, 0x7e, 0x27, 0xfa, 0xe1, 0xbd, 0x16, 0xa9, 0x90, 0x98, 0xa0, 0x47, 0x2e, 0x1d,
-	0x46, 0xfc, 0xeb, 0x08, 0xfc, 0x00, 0x42, 0x88, 0xa4, 0x50, 0xb7, 0x32, 0x8d, 0xb1, 0x51, 0xf3,
-	0xc1, 0xa5, 0x43, 0x90, 0xd9, 0x6a, 0x4e, 0x3d, 0x15, 0x90, 0x7b, 0xf3, 0xf8, 0x3d, 0xda, 0xa7,
-	0x3c, 0x0c, 0x99, 0xcc, 0x71, 0xdb, 0x0f, 0xc2, 0xad, 0x8e, 0x62, 0x07, 0x35, 0x63, 0xa0, 0xc0,
-	0xc6, 0x52, 0xa8, 0xf5, 0x07, 0x61, 0xaa, 0x39, 0xcc, 0x50, 0x5b, 0x40, 0xe4, 0x0f, 0x04, 0x7c,
-	0x99, 0x40, 0x44, 0x41, 0xa8, 0x3b, 0x19, 0xe9, 0xd9, 0xdf, 0x48, 0x45, 0xd6, 0x79, 0x92, 0xc2,
-	0x16, 0x89, 0xde, 0xb9, 0x71, 0xc3, 0xd1, 0x1b, 0x73, 0x1d, 0x64, 0x92, 0x56, 0x5a, 0x28, 0xc3,
-	0x99, 0x2a, 0x06, 0x3a, 0x5d, 0x51, 0x35, 0xfe, 0x5b, 0xb5, 0x0e, 0x32, 0x49, 0x2b, 0x2d, 0x2c,
-	0x55, 0x57, 0xa8, 0xe5, 0xd2, 0xe1, 0x8a, 0x69, 0xf7, 0xdf, 0x4d, 0x27, 0x85, 0xe9, 0x38, 0x37,
-	0xad, 0x71, 0x4c, 0x72, 0xe0, 0xd2, 0xe1, 0xd2, 0xf3, 0x11, 0x75, 0x22, 0xf8, 0x26, 0x07, 0x05,
-	0xad, 0x0a, 0xaa, 0x4d, 0x43, 0xe9, 0xd5, 0x1d, 0x63, 0x91, 0xe8, 0x27, 0x39, 0x66, 0x63, 0xcc,
-	0x24, 0x47, 0x69, 0xbd, 0xf8, 0xef, 0x4a, 0xac, 0xf9, 0x5d, 0x41, 0xed, 0xf5, 0xa5, 0xf0, 0x0b,
-	0xb4, 0x3b, 0xe6, 0xb1, 0x1c, 0x30, 0x5f, 0x55, 0x0c, 0xa5, 0xb7, 0xe7, 0xe0, 0x45, 0xa2, 0xb7,
-	0x73, 0x74, 0xd1, 0x30, 0x49, 0x23, 0xfd, 0xea, 0xfb, 0xf8, 0x02, 0xa1, 0xd2, 0xc4, 0x7c, 0x75,
-	0x2b, 0xcb, 0x77, 0x16, 0x89, 0x7e, 0x98, 0xe7, 0x97, 0x3d, 0x93, 0xec, 0x15, 0x8f, 0xbe, 0x8f,
-	0xbb, 0xa8, 0x59, 0xad, 0xbf, 0x9d, 0xae, 0x4f, 0xaa, 0xb7, 0x73, 0x79, 0x3b, 0xd3, 0x94, 0xbb,
-	0x99, 0xa6, 0xfc, 0x9a, 0x69, 0xca, 0x8f, 0xb9, 0x56, 0xbb, 0x9b, 0x6b, 0xb5, 0x9f, 0x73, 0xad,
-	0xf6, 0xe9, 0x75, 0xc0, 0xe4, 0xf5, 0xc4, 0xb3, 0x28, 0x0f, 0x6d, 0xca, 0x45, 0xc8, 0x85, 0xcd,
-	0x3c, 0x7a, 0x1a, 0x70, 0x7b, 0x7a, 0x6e, 0x87, 0xdc, 0x9f, 0x8c, 0x40, 0xe4, 0x47, 0xfd, 0xf2,
-	0xe2, 0xb4, 0xbc, 0x6b, 0x79, 0x33, 0x06, 0xe1, 0x35, 0xb2, 0x9b, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x29, 0xa1, 0xc4, 0xd2, 0x46, 0x04, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4e, 0x13, 0x41,
+	0x18, 0xc7, 0xbb, 0x80, 0xd0, 0x0e, 0x50, 0x65, 0xa0, 0xba, 0x22, 0x6e, 0xeb, 0x60, 0x08, 0x89,
+	0x61, 0x57, 0x84, 0x8b, 0x1e, 0xd7, 0x44, 0x25, 0xf1, 0x60, 0x06, 0x4f, 0x26, 0xa6, 0xd9, 0xce,
+	0x4e, 0x97, 0x49, 0xbb, 0x3b, 0x75, 0x67, 0x5a, 0x85, 0xab, 0x0f, 0xa0, 0x8f, 0xe0, 0xe3, 0x70,
+	0xe4, 0xe8, 0xa9, 0x31, 0xf0, 0x06, 0x3d, 0x7a, 0x32, 0xbb, 0x33, 0xbb, 0xdd, 0x4a, 0x25, 0xe0,
+	0xad, 0xf3, 0x7d, 0xff, 0xef, 0xf7, 0xff, 0x26, 0xfd, 0xef, 0x80, 0x47, 0xac, 0x45, 0x1c, 0xc2,
+	0x63, 0xea, 0x90, 0x23, 0x2f, 0x8a, 0x68, 0xd7, 0x19, 0xec, 0x3a, 0x01, 0x8d, 0xa8, 0x60, 0xc2,
+	0xee, 0xc5, 0x5c, 0x72, 0xb8, 0xca, 0x5a, 0xc4, 0x4e, 0x24, 0xb6, 0x96, 0xd8, 0x83, 0xdd, 0xf5,
+	0xb5, 0x80, 0x07, 0x3c, 0xed, 0x3b, 0xc9, 0x2f, 0x25, 0x5d, 0x9f, 0x4a, 0xcb, 0xa6, 0x94, 0xe4,
+	0xf1, 0x34, 0x89, 0x90, 0x9e, 0x64, 0x42, 0x32, 0xa2, 0x3d, 0xd1, 0x8f, 0x0a, 0x58, 0x7a, 0xad,
+	0xb6, 0x38, 0x94, 0x9e, 0xa4, 0xf0, 0x23, 0x28, 0x6b, 0xbd, 0x30, 0x8d, 0xc6, 0xec, 0xf6, 0xe2,
+	0xb3, 0x2d, 0x7b, 0xca, 0x5e, 0xf6, 0x81, 0x4f, 0x23, 0xc9, 0xda, 0x8c, 0xfa, 0x2f, 0x55, 0xd1,
+	0xbd, 0x7f, 0x3a, 0xac, 0x97, 0x7e, 0x0f, 0xeb, 0x2b, 0x97, 0x5a, 0x38, 0x47, 0x42, 0x0c, 0xee,
+	0x78, 0xa4, 0x13, 0xf1, 0xcf, 0x5d, 0xea, 0x07, 0x34, 0xa4, 0x91, 0x14, 0xe6, 0x4c, 0x6a, 0xd3,
+	0x98, 0x6a, 0xf3, 0xce, 0x23, 0x1d, 0x2a, 0xd3, 0xd5, 0xdc, 0xb9, 0xc4, 0x00, 0x5f, 0x9a, 0x87,
+	0x6f, 0xc0, 0x22, 0xe1, 0x61, 0xc8, 0xa4, 0xc2, 0xcd, 0xde, 0x08, 0x57, 0x1c, 0x85, 0x2e, 0x28,
+	0xc7, 0x94, 0x50, 0xd6, 0x93, 0xc2, 0x9c, 0xbb, 0x11, 0x26, 0x9f, 0x83, 0x0c, 0x54, 0x05, 0x8d,
+	0xfc, 0xa6, 0xa0, 0x9f, 0xfa, 0x34, 0x22, 0x54, 0x98, 0xb7, 0x52, 0xd2, 0xe6, 0x55, 0x24, 0xad,
+	0x75, 0x1f, 0x26, 0xb0, 0xd1, 0xb0, 0x5e, 0x3b, 0xf6, 0xc2, 0xee, 0x0b, 0x34, 0x09, 0x42, 0x78,
+	0x39, 0x29, 0x64, 0xe2, 0xd4, 0x2a, 0xa6, 0x64, 0x50, 0xb0, 0x9a, 0xff, 0x6f, 0xab, 0x49, 0x10,
+	0xc2, 0xcb, 0x49, 0x61, 0x6c, 0xd5, 0x06, 0xcb, 0x1e, 0xe9, 0x14, 0x9c, 0x16, 0xae, 0xef, 0xb4,
+	0xa1, 0x9d, 0xd6, 0x94, 0xd3, 0x04, 0x07, 0xe1, 0x25, 0x8f, 0x74, 0xc6, 0x3e, 0xef, 0x41, 0x2d,
+	0xa2, 0x5f, 0x64, 0x53, 0xd3, 0x72, 0xa1, 0x59, 0x6e, 0x18, 0xdb, 0x73, 0x6e, 0x63, 0x34, 0xac,
+	0x6f, 0x28, 0xcc, 0x54, 0x19, 0xc2, 0xab, 0x49, 0x5d, 0xe7, 0x2e, 0xc3, 0xc2, 0x0e, 0xb8, 0xdd,
+	0x8e, 0xf9, 0x09, 0x8d, 0x9a, 0x79, 0xb6, 0x2b, 0xe9, 0xfe, 0x68, 0xea, 0xfe, 0xaf, 0x52, 0x6d,
+	0x96, 0x6b, 0x4b, 0xaf, 0x7f, 0x57, 0xf9, 0xfe, 0x05, 0x42, 0xb8, 0xda, 0x2e, 0xca, 0x05, 0x7c,
+	0x0b, 0xc0, 0xf8, 0x33, 0x33, 0xc1, 0x15, 0xdf, 0x50, 0xb6, 0x66, 0xae, 0xd6, 0x61, 0x2a, 0xcc,
+	0xc3, 0xaf, 0x06, 0xb8, 0xd7, 0x8b, 0xfb, 0x11, 0x8b, 0x82, 0xfc, 0x96, 0x4d, 0x21, 0xbd, 0x58,
+	0x0a, 0x73, 0xf1, 0xfa, 0xff, 0xc1, 0x96, 0xbe, 0x84, 0xa5, 0x2e, 0xf1, 0x0f, 0x22, 0xc2, 0x35,
+	0xdd, 0xc9, 0x06, 0x0f, 0xd3, 0x3a, 0x3c, 0x01, 0x6b, 0x2a, 0x20, 0xc9, 0xb1, 0x90, 0x82, 0xa5,
+	0xeb, 0x6f, 0xb0, 0xa9, 0x37, 0x78, 0x50, 0xcc, 0xdb, 0x24, 0x0e, 0x61, 0x98, 0xa6, 0x2e, 0xa9,
+	0xe6, 0x91, 0x40, 0xdf, 0x0c, 0x50, 0x9d, 0x64, 0xc1, 0x27, 0x60, 0xa1, 0xc7, 0x63, 0xd9, 0x64,
+	0xbe, 0x69, 0x34, 0x8c, 0xed, 0x8a, 0x0b, 0x47, 0xc3, 0x7a, 0x55, 0x5f, 0x4d, 0x35, 0x10, 0x9e,
+	0x4f, 0x7e, 0x1d, 0xf8, 0x70, 0x1f, 0x80, 0x2c, 0x26, 0xcc, 0x37, 0x67, 0x52, 0x7d, 0x6d, 0x34,
+	0xac, 0xaf, 0x28, 0xfd, 0xb8, 0x87, 0x70, 0x45, 0x1f, 0x0e, 0x7c, 0xb8, 0x0e, 0xca, 0x79, 0xf6,
+	0x66, 0x93, 0xec, 0xe1, 0xfc, 0xec, 0x1e, 0x9e, 0x9e, 0x5b, 0xc6, 0xd9, 0xb9, 0x65, 0xfc, 0x3a,
+	0xb7, 0x8c, 0xef, 0x17, 0x56, 0xe9, 0xec, 0xc2, 0x2a, 0xfd, 0xbc, 0xb0, 0x4a, 0x1f, 0x9e, 0x07,
+	0x4c, 0x1e, 0xf5, 0x5b, 0x36, 0xe1, 0xa1, 0x43, 0xb8, 0x08, 0xb9, 0x70, 0x58, 0x8b, 0xec, 0x04,
+	0xdc, 0x19, 0xec, 0x39, 0x21, 0xf7, 0xfb, 0x5d, 0x2a, 0xd4, 0xa3, 0xfc, 0x74, 0x7f, 0x27, 0x7b,
+	0x97, 0xe5, 0x71, 0x8f, 0x8a, 0xd6, 0x7c, 0xfa, 0x20, 0xef, 0xfd, 0x19, 0x00, 0xd4, 0x4c, 0xeb,
+	0xa2, 0x29, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +295,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecvStartSequences) > 0 {
+		for iNdEx := len(m.RecvStartSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvStartSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for iNdEx := len(m.PruningSequenceStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.FrozenChannels) > 0 {
+		for iNdEx := len(m.FrozenChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -460,6 +561,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextChannelSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
 	}
+	if len(m.FrozenChannels) > 0 {
+		for _, e := range m.FrozenChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for _, e := range m.PruningSequenceStarts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecvStartSequences) > 0 {
+		for _, e := range m.RecvStartSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -775,6 +900,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenChannels = append(m.FrozenChannels, FrozenChannel{})
+			if err := m.FrozenChannels[len(m.FrozenChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, ChannelStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStarts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningSequenceStarts = append(m.PruningSequenceStarts, PacketSequence{})
+			if err := m.PruningSequenceStarts[len(m.PruningSequenceStarts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvStartSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvStartSequences = append(m.RecvStartSequences, PacketSequence{})
+			if err := m.RecvStartSequences[len(m.RecvStartSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
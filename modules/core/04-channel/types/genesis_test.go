@@ -137,6 +137,72 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid frozen channel",
+			genState: types.GenesisState{
+				FrozenChannels: []types.FrozenChannel{
+					types.NewFrozenChannel(testPort1, testChannel1),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid frozen channel",
+			genState: types.GenesisState{
+				FrozenChannels: []types.FrozenChannel{
+					types.NewFrozenChannel(testPort1, "(testChannel1)"),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid channel statistics",
+			genState: types.GenesisState{
+				Statistics: []types.ChannelStatistics{
+					types.NewChannelStatistics(testPort1, testChannel1),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid channel statistics: timed out on close exceeds timed out",
+			genState: types.GenesisState{
+				Statistics: []types.ChannelStatistics{
+					{PortId: testPort1, ChannelId: testChannel1, PacketsTimedOut: 1, PacketsTimedOutOnClose: 2},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid pruning sequence starts and recv start sequences",
+			genState: types.GenesisState{
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 1),
+				},
+				RecvStartSequences: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 5),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid pruning sequence start",
+			genState: types.GenesisState{
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, "(testChannel1)", 1),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid recv start sequence",
+			genState: types.GenesisState{
+				RecvStartSequences: []types.PacketSequence{
+					types.NewPacketSequence("(testPort1)", testChannel1, 5),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
@@ -28,6 +28,18 @@ const (
 
 	// ChannelPrefix is the prefix used when creating a channel identifier
 	ChannelPrefix = "channel-"
+
+	// KeyPruningCursor is the key used to store the pruning sequence start key of the channel
+	// at which the pruning sweep of the next block starts
+	KeyPruningCursor = "pruningCursor"
+
+	// PruningLimitPerBlock is the maximum number of packet sequences whose acknowledgements
+	// and receipts are pruned in the begin blocker of every block
+	PruningLimitPerBlock uint64 = 100
+
+	// PruningChannelsPerBlock is the maximum number of channels visited by the pruning sweep
+	// in the begin blocker of every block
+	PruningChannelsPerBlock uint64 = 20
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -11,6 +11,14 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
+// MaxBatchPackets is the maximum number of packets carried by a MsgRecvPackets or
+// MsgAcknowledgements message.
+const MaxBatchPackets = 100
+
+// MaxTimeoutHorizonProofs is the maximum number of packet commitment absence proofs carried
+// by a MsgAdvanceTimeoutHorizon message.
+const MaxTimeoutHorizonProofs = 100
+
 var _ sdk.Msg = &MsgChannelOpenInit{}
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -269,14 +277,15 @@ var _ sdk.Msg = &MsgChannelCloseConfirm{}
 // nolint:interfacer
 func NewMsgChannelCloseConfirm(
 	portID, channelID string, proofInit []byte, proofHeight clienttypes.Height,
-	signer string,
+	signer string, counterpartyUpgradeSequence uint64,
 ) *MsgChannelCloseConfirm {
 	return &MsgChannelCloseConfirm{
-		PortId:      portID,
-		ChannelId:   channelID,
-		ProofInit:   proofInit,
-		ProofHeight: proofHeight,
-		Signer:      signer,
+		PortId:                      portID,
+		ChannelId:                   channelID,
+		ProofInit:                   proofInit,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
 	}
 }
 
@@ -407,14 +416,16 @@ func NewMsgTimeoutOnClose(
 	packet Packet, nextSequenceRecv uint64,
 	proofUnreceived, proofClose []byte,
 	proofHeight clienttypes.Height, signer string,
+	counterpartyUpgradeSequence uint64,
 ) *MsgTimeoutOnClose {
 	return &MsgTimeoutOnClose{
-		Packet:           packet,
-		NextSequenceRecv: nextSequenceRecv,
-		ProofUnreceived:  proofUnreceived,
-		ProofClose:       proofClose,
-		ProofHeight:      proofHeight,
-		Signer:           signer,
+		Packet:                      packet,
+		NextSequenceRecv:            nextSequenceRecv,
+		ProofUnreceived:             proofUnreceived,
+		ProofClose:                  proofClose,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
 	}
 }
 
@@ -493,3 +504,578 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+var _ sdk.Msg = &MsgRecvPackets{}
+
+// NewMsgRecvPackets constructs new MsgRecvPackets
+// nolint:interfacer
+func NewMsgRecvPackets(
+	packets []Packet, proofCommitments []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofCommitments: proofCommitments,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if len(msg.ProofCommitments) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validateBatchPackets(msg.Packets)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPackets) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgAcknowledgements{}
+
+// NewMsgAcknowledgements constructs a new MsgAcknowledgements
+// nolint:interfacer
+func NewMsgAcknowledgements(
+	packets []Packet,
+	acks [][]byte, proofAcked []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofAcked:       proofAcked,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgements) ValidateBasic() error {
+	if len(msg.ProofAcked) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements ≠ number of packets (%d ≠ %d)", len(msg.Acknowledgements), len(msg.Packets))
+	}
+	for i, ack := range msg.Acknowledgements {
+		if len(ack) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "ack bytes of packet %d cannot be empty", i)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return validateBatchPackets(msg.Packets)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAcknowledgements) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// validateBatchPackets validates the packets of a batch message. The batch must contain between
+// one and MaxBatchPackets valid packets sent on the same channel, with unique sequences.
+func validateBatchPackets(packets []Packet) error {
+	if len(packets) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "batch must contain at least one packet")
+	}
+	if len(packets) > MaxBatchPackets {
+		return sdkerrors.Wrapf(ErrInvalidPacket, "batch cannot contain more than %d packets (got %d)", MaxBatchPackets, len(packets))
+	}
+
+	sequences := make(map[uint64]bool, len(packets))
+	for i, packet := range packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid packet %d", i)
+		}
+
+		if packet.SourcePort != packets[0].SourcePort || packet.SourceChannel != packets[0].SourceChannel ||
+			packet.DestinationPort != packets[0].DestinationPort || packet.DestinationChannel != packets[0].DestinationChannel {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "packet %d is not sent on the same channel as packet 0", i)
+		}
+
+		if sequences[packet.Sequence] {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", packet.Sequence)
+		}
+		sequences[packet.Sequence] = true
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeTry{}
+
+// NewMsgChannelUpgradeTry constructs a new MsgChannelUpgradeTry
+// nolint:interfacer
+func NewMsgChannelUpgradeTry(
+	portID,
+	channelID string,
+	proposedConnectionHops []string,
+	counterpartyUpgradeFields UpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	proofChannel []byte,
+	proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeTry {
+	return &MsgChannelUpgradeTry{
+		PortId:                        portID,
+		ChannelId:                     channelID,
+		ProposedUpgradeConnectionHops: proposedConnectionHops,
+		CounterpartyUpgradeFields:     counterpartyUpgradeFields,
+		CounterpartyUpgradeSequence:   counterpartyUpgradeSequence,
+		ProofChannel:                  proofChannel,
+		ProofUpgrade:                  proofUpgrade,
+		ProofHeight:                   proofHeight,
+		Signer:                        signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTry) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProposedUpgradeConnectionHops) == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "proposed connection hops cannot be empty")
+	}
+	if err := msg.CounterpartyUpgradeFields.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "error validating counterparty upgrade fields")
+	}
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeSequence, "counterparty upgrade sequence cannot be 0")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTry) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeAck{}
+
+// NewMsgChannelUpgradeAck constructs a new MsgChannelUpgradeAck
+// nolint:interfacer
+func NewMsgChannelUpgradeAck(
+	portID,
+	channelID string,
+	counterpartyUpgrade Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeAck {
+	return &MsgChannelUpgradeAck{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyUpgrade: counterpartyUpgrade,
+		ProofChannel:        proofChannel,
+		ProofUpgrade:        proofUpgrade,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeAck) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if err := msg.CounterpartyUpgrade.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "error validating counterparty upgrade")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeAck) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeConfirm{}
+
+// NewMsgChannelUpgradeConfirm constructs a new MsgChannelUpgradeConfirm
+// nolint:interfacer
+func NewMsgChannelUpgradeConfirm(
+	portID,
+	channelID string,
+	counterpartyChannelState State,
+	counterpartyUpgrade Upgrade,
+	proofChannel,
+	proofUpgrade []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeConfirm {
+	return &MsgChannelUpgradeConfirm{
+		PortId:                   portID,
+		ChannelId:                channelID,
+		CounterpartyChannelState: counterpartyChannelState,
+		CounterpartyUpgrade:      counterpartyUpgrade,
+		ProofChannel:             proofChannel,
+		ProofUpgrade:             proofUpgrade,
+		ProofHeight:              proofHeight,
+		Signer:                   signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if !(msg.CounterpartyChannelState == FLUSHING || msg.CounterpartyChannelState == FLUSHCOMPLETE) {
+		return sdkerrors.Wrapf(ErrInvalidChannelState, "expected channel state to be one of: %s or %s, got: %s", FLUSHING, FLUSHCOMPLETE, msg.CounterpartyChannelState)
+	}
+	if err := msg.CounterpartyUpgrade.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "error validating counterparty upgrade")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty upgrade proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeOpen{}
+
+// NewMsgChannelUpgradeOpen constructs a new MsgChannelUpgradeOpen
+// nolint:interfacer
+func NewMsgChannelUpgradeOpen(
+	portID,
+	channelID string,
+	counterpartyChannelState State,
+	counterpartyUpgradeSequence uint64,
+	proofChannel []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeOpen {
+	return &MsgChannelUpgradeOpen{
+		PortId:                      portID,
+		ChannelId:                   channelID,
+		CounterpartyChannelState:    counterpartyChannelState,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		ProofChannel:                proofChannel,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeOpen) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if !(msg.CounterpartyChannelState == FLUSHCOMPLETE || msg.CounterpartyChannelState == OPEN) {
+		return sdkerrors.Wrapf(ErrInvalidChannelState, "expected channel state to be one of: %s or %s, got: %s", FLUSHCOMPLETE, OPEN, msg.CounterpartyChannelState)
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeOpen) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeTimeout{}
+
+// NewMsgChannelUpgradeTimeout constructs a new MsgChannelUpgradeTimeout
+// nolint:interfacer
+func NewMsgChannelUpgradeTimeout(
+	portID,
+	channelID string,
+	counterpartyChannel Channel,
+	proofChannel []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeTimeout {
+	return &MsgChannelUpgradeTimeout{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		ProofChannel:        proofChannel,
+		ProofHeight:         proofHeight,
+		Signer:              signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if err := msg.CounterpartyChannel.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "error validating counterparty channel")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty channel proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeCancel{}
+
+// NewMsgChannelUpgradeCancel constructs a new MsgChannelUpgradeCancel
+// nolint:interfacer
+func NewMsgChannelUpgradeCancel(
+	portID,
+	channelID string,
+	errorReceipt ErrorReceipt,
+	proofErrorReceipt []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelUpgradeCancel {
+	return &MsgChannelUpgradeCancel{
+		PortId:            portID,
+		ChannelId:         channelID,
+		ErrorReceipt:      errorReceipt,
+		ProofErrorReceipt: proofErrorReceipt,
+		ProofHeight:       proofHeight,
+		Signer:            signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.ErrorReceipt.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeErrorReceipt, "error receipt sequence cannot be 0")
+	}
+	if len(msg.ProofErrorReceipt) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty error receipt proof")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgPruneAcknowledgements{}
+
+// NewMsgPruneAcknowledgements constructs a new MsgPruneAcknowledgements
+// nolint:interfacer
+func NewMsgPruneAcknowledgements(portID, channelID string, limit uint64, signer string) *MsgPruneAcknowledgements {
+	return &MsgPruneAcknowledgements{
+		PortId:    portID,
+		ChannelId: channelID,
+		Limit:     limit,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgPruneAcknowledgements) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.Limit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of acknowledgements to prune must be greater than 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPruneAcknowledgements) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgAdvanceTimeoutHorizon{}
+
+// NewMsgAdvanceTimeoutHorizon constructs a new MsgAdvanceTimeoutHorizon
+// nolint:interfacer
+func NewMsgAdvanceTimeoutHorizon(
+	portID, channelID string, proofsCommitmentAbsence [][]byte,
+	proofHeight clienttypes.Height, signer string,
+) *MsgAdvanceTimeoutHorizon {
+	return &MsgAdvanceTimeoutHorizon{
+		PortId:                  portID,
+		ChannelId:               channelID,
+		ProofsCommitmentAbsence: proofsCommitmentAbsence,
+		ProofHeight:             proofHeight,
+		Signer:                  signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAdvanceTimeoutHorizon) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofsCommitmentAbsence) == 0 || len(msg.ProofsCommitmentAbsence) > MaxTimeoutHorizonProofs {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of proofs must be between 1 and %d (got %d)", MaxTimeoutHorizonProofs, len(msg.ProofsCommitmentAbsence))
+	}
+	for i, proof := range msg.ProofsCommitmentAbsence {
+		if len(proof) == 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof at index %d", i)
+		}
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAdvanceTimeoutHorizon) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -119,7 +119,7 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		{"too short port id", types.NewMsgChannelOpenInit(invalidShortPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"too long port id", types.NewMsgChannelOpenInit(invalidLongPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"port id contains non-alpha", types.NewMsgChannelOpenInit(invalidPort, version, types.ORDERED, connHops, cpportid, addr), false},
-		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(3), connHops, cpportid, addr), false},
+		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(4), connHops, cpportid, addr), false},
 		{"connection hops more than 1 ", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr), false},
 		{"too short connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, addr), false},
@@ -290,15 +290,15 @@ func (suite *TypesTestSuite) TestMsgChannelCloseConfirmValidateBasic() {
 		msg     *types.MsgChannelCloseConfirm
 		expPass bool
 	}{
-		{"", types.NewMsgChannelCloseConfirm(portid, chanid, suite.proof, height, addr), true},
-		{"too short port id", types.NewMsgChannelCloseConfirm(invalidShortPort, chanid, suite.proof, height, addr), false},
-		{"too long port id", types.NewMsgChannelCloseConfirm(invalidLongPort, chanid, suite.proof, height, addr), false},
-		{"port id contains non-alpha", types.NewMsgChannelCloseConfirm(invalidPort, chanid, suite.proof, height, addr), false},
-		{"too short channel id", types.NewMsgChannelCloseConfirm(portid, invalidShortChannel, suite.proof, height, addr), false},
-		{"too long channel id", types.NewMsgChannelCloseConfirm(portid, invalidLongChannel, suite.proof, height, addr), false},
-		{"channel id contains non-alpha", types.NewMsgChannelCloseConfirm(portid, invalidChannel, suite.proof, height, addr), false},
-		{"empty proof", types.NewMsgChannelCloseConfirm(portid, chanid, emptyProof, height, addr), false},
-		{"proof height is zero", types.NewMsgChannelCloseConfirm(portid, chanid, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"", types.NewMsgChannelCloseConfirm(portid, chanid, suite.proof, height, addr, 0), true},
+		{"too short port id", types.NewMsgChannelCloseConfirm(invalidShortPort, chanid, suite.proof, height, addr, 0), false},
+		{"too long port id", types.NewMsgChannelCloseConfirm(invalidLongPort, chanid, suite.proof, height, addr, 0), false},
+		{"port id contains non-alpha", types.NewMsgChannelCloseConfirm(invalidPort, chanid, suite.proof, height, addr, 0), false},
+		{"too short channel id", types.NewMsgChannelCloseConfirm(portid, invalidShortChannel, suite.proof, height, addr, 0), false},
+		{"too long channel id", types.NewMsgChannelCloseConfirm(portid, invalidLongChannel, suite.proof, height, addr, 0), false},
+		{"channel id contains non-alpha", types.NewMsgChannelCloseConfirm(portid, invalidChannel, suite.proof, height, addr, 0), false},
+		{"empty proof", types.NewMsgChannelCloseConfirm(portid, chanid, emptyProof, height, addr, 0), false},
+		{"proof height is zero", types.NewMsgChannelCloseConfirm(portid, chanid, suite.proof, clienttypes.ZeroHeight(), addr, 0), false},
 	}
 
 	for _, tc := range testCases {
@@ -387,13 +387,13 @@ func (suite *TypesTestSuite) TestMsgTimeoutOnCloseValidateBasic() {
 		msg     sdk.Msg
 		expPass bool
 	}{
-		{"success", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, addr), true},
-		{"seq 0", types.NewMsgTimeoutOnClose(packet, 0, suite.proof, suite.proof, height, addr), false},
-		{"empty proof", types.NewMsgTimeoutOnClose(packet, 1, emptyProof, suite.proof, height, addr), false},
-		{"empty proof close", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, emptyProof, height, addr), false},
-		{"proof height is zero", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr), false},
-		{"signer address is empty", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, emptyAddr), false},
-		{"invalid packet", types.NewMsgTimeoutOnClose(invalidPacket, 1, suite.proof, suite.proof, height, addr), false},
+		{"success", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, addr, 0), true},
+		{"seq 0", types.NewMsgTimeoutOnClose(packet, 0, suite.proof, suite.proof, height, addr, 0), false},
+		{"empty proof", types.NewMsgTimeoutOnClose(packet, 1, emptyProof, suite.proof, height, addr, 0), false},
+		{"empty proof close", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, emptyProof, height, addr, 0), false},
+		{"proof height is zero", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr, 0), false},
+		{"signer address is empty", types.NewMsgTimeoutOnClose(packet, 1, suite.proof, suite.proof, height, emptyAddr, 0), false},
+		{"invalid packet", types.NewMsgTimeoutOnClose(invalidPacket, 1, suite.proof, suite.proof, height, addr, 0), false},
 	}
 
 	for _, tc := range testCases {
@@ -439,3 +439,338 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, "otherchannel", cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+
+	tooManyPackets := make([]types.Packet, types.MaxBatchPackets+1)
+	for i := range tooManyPackets {
+		tooManyPackets[i] = types.NewPacket(validPacketData, uint64(i+1), portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRecvPackets
+		expPass bool
+	}{
+		{"success", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, addr), true},
+		{"proof height is zero", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"proof contain empty proof", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, emptyProof, height, addr), false},
+		{"missing signer address", types.NewMsgRecvPackets([]types.Packet{packet, packet2}, suite.proof, height, emptyAddr), false},
+		{"empty batch", types.NewMsgRecvPackets([]types.Packet{}, suite.proof, height, addr), false},
+		{"too many packets", types.NewMsgRecvPackets(tooManyPackets, suite.proof, height, addr), false},
+		{"invalid packet", types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, suite.proof, height, addr), false},
+		{"packets on different channels", types.NewMsgRecvPackets([]types.Packet{packet, otherChannelPacket}, suite.proof, height, addr), false},
+		{"duplicate packet sequence", types.NewMsgRecvPackets([]types.Packet{packet, packet}, suite.proof, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	acks := [][]byte{packet.GetData(), packet2.GetData()}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAcknowledgements
+		expPass bool
+	}{
+		{"success", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, suite.proof, height, addr), true},
+		{"proof height must be > 0", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"missing signer address", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, suite.proof, height, emptyAddr), false},
+		{"cannot submit an empty proof", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks, emptyProof, height, addr), false},
+		{"number of acks doesn't match the number of packets", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, acks[:1], suite.proof, height, addr), false},
+		{"empty ack", types.NewMsgAcknowledgements([]types.Packet{packet, packet2}, [][]byte{packet.GetData(), nil}, suite.proof, height, addr), false},
+		{"invalid packet", types.NewMsgAcknowledgements([]types.Packet{packet, invalidPacket}, acks, suite.proof, height, addr), false},
+		{"duplicate packet sequence", types.NewMsgAcknowledgements([]types.Packet{packet, packet}, acks, suite.proof, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTryValidateBasic() {
+	upgradeFields := types.NewUpgradeFields(types.UNORDERED, connHops, version)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTry
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, upgradeFields, 1, suite.proof, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeTry(invalidPort, chanid, connHops, upgradeFields, 1, suite.proof, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeTry(portid, invalidChannel, connHops, upgradeFields, 1, suite.proof, suite.proof, height, addr), false},
+		{"empty proposed connection hops", types.NewMsgChannelUpgradeTry(portid, chanid, nil, upgradeFields, 1, suite.proof, suite.proof, height, addr), false},
+		{"invalid counterparty upgrade fields", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, types.NewUpgradeFields(types.NONE, connHops, version), 1, suite.proof, suite.proof, height, addr), false},
+		{"counterparty upgrade sequence is zero", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, upgradeFields, 0, suite.proof, suite.proof, height, addr), false},
+		{"empty channel proof", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, upgradeFields, 1, emptyProof, suite.proof, height, addr), false},
+		{"empty upgrade proof", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, upgradeFields, 1, suite.proof, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, upgradeFields, 1, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeTry(portid, chanid, connHops, upgradeFields, 1, suite.proof, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeAckValidateBasic() {
+	upgrade := types.NewUpgrade(types.NewUpgradeFields(types.UNORDERED, connHops, version), types.NewTimeout(timeoutHeight, 0), 1)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeAck
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, suite.proof, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeAck(invalidPort, chanid, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeAck(portid, invalidChannel, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"counterparty upgrade timeout is not set", types.NewMsgChannelUpgradeAck(portid, chanid, types.NewUpgrade(upgrade.Fields, types.Timeout{}, 1), suite.proof, suite.proof, height, addr), false},
+		{"empty channel proof", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, emptyProof, suite.proof, height, addr), false},
+		{"empty upgrade proof", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, suite.proof, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeAck(portid, chanid, upgrade, suite.proof, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeConfirmValidateBasic() {
+	upgrade := types.NewUpgrade(types.NewUpgradeFields(types.UNORDERED, connHops, version), types.NewTimeout(timeoutHeight, 0), 1)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeConfirm
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeConfirm(portid, chanid, types.FLUSHING, upgrade, suite.proof, suite.proof, height, addr), true},
+		{"counterparty is FLUSHCOMPLETE", types.NewMsgChannelUpgradeConfirm(portid, chanid, types.FLUSHCOMPLETE, upgrade, suite.proof, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeConfirm(invalidPort, chanid, types.FLUSHING, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeConfirm(portid, invalidChannel, types.FLUSHING, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"invalid counterparty channel state", types.NewMsgChannelUpgradeConfirm(portid, chanid, types.OPEN, upgrade, suite.proof, suite.proof, height, addr), false},
+		{"empty channel proof", types.NewMsgChannelUpgradeConfirm(portid, chanid, types.FLUSHING, upgrade, emptyProof, suite.proof, height, addr), false},
+		{"empty upgrade proof", types.NewMsgChannelUpgradeConfirm(portid, chanid, types.FLUSHING, upgrade, suite.proof, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeConfirm(portid, chanid, types.FLUSHING, upgrade, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeConfirm(portid, chanid, types.FLUSHING, upgrade, suite.proof, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeOpenValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeOpen
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeOpen(portid, chanid, types.FLUSHCOMPLETE, 1, suite.proof, height, addr), true},
+		{"counterparty is OPEN", types.NewMsgChannelUpgradeOpen(portid, chanid, types.OPEN, 1, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeOpen(invalidPort, chanid, types.FLUSHCOMPLETE, 1, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeOpen(portid, invalidChannel, types.FLUSHCOMPLETE, 1, suite.proof, height, addr), false},
+		{"invalid counterparty channel state", types.NewMsgChannelUpgradeOpen(portid, chanid, types.FLUSHING, 1, suite.proof, height, addr), false},
+		{"empty channel proof", types.NewMsgChannelUpgradeOpen(portid, chanid, types.FLUSHCOMPLETE, 1, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeOpen(portid, chanid, types.FLUSHCOMPLETE, 1, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeOpen(portid, chanid, types.FLUSHCOMPLETE, 1, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTimeoutValidateBasic() {
+	counterparty := types.NewChannel(types.FLUSHING, types.UNORDERED, types.NewCounterparty(portid, chanid), connHops, version)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTimeout
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeTimeout(portid, chanid, counterparty, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeTimeout(invalidPort, chanid, counterparty, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeTimeout(portid, invalidChannel, counterparty, suite.proof, height, addr), false},
+		{"invalid counterparty channel", types.NewMsgChannelUpgradeTimeout(portid, chanid, types.Channel{}, suite.proof, height, addr), false},
+		{"empty channel proof", types.NewMsgChannelUpgradeTimeout(portid, chanid, counterparty, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTimeout(portid, chanid, counterparty, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeTimeout(portid, chanid, counterparty, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeCancelValidateBasic() {
+	errorReceipt := types.NewErrorReceipt(1, "error")
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeCancel
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeCancel(invalidPort, chanid, errorReceipt, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeCancel(portid, invalidChannel, errorReceipt, suite.proof, height, addr), false},
+		{"error receipt sequence is zero", types.NewMsgChannelUpgradeCancel(portid, chanid, types.NewErrorReceipt(0, "error"), suite.proof, height, addr), false},
+		{"empty error receipt proof", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgChannelUpgradeCancel(portid, chanid, errorReceipt, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPruneAcknowledgements
+		expPass bool
+	}{
+		{"", types.NewMsgPruneAcknowledgements(portid, chanid, 10, addr), true},
+		{"port id contains non-alpha", types.NewMsgPruneAcknowledgements(invalidPort, chanid, 10, addr), false},
+		{"channel id contains non-alpha", types.NewMsgPruneAcknowledgements(portid, invalidChannel, 10, addr), false},
+		{"limit is zero", types.NewMsgPruneAcknowledgements(portid, chanid, 0, addr), false},
+		{"empty signer", types.NewMsgPruneAcknowledgements(portid, chanid, 10, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAdvanceTimeoutHorizonValidateBasic() {
+	tooManyProofs := make([][]byte, types.MaxTimeoutHorizonProofs+1)
+	for i := range tooManyProofs {
+		tooManyProofs[i] = suite.proof
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAdvanceTimeoutHorizon
+		expPass bool
+	}{
+		{"", types.NewMsgAdvanceTimeoutHorizon(portid, chanid, [][]byte{suite.proof}, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgAdvanceTimeoutHorizon(invalidPort, chanid, [][]byte{suite.proof}, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgAdvanceTimeoutHorizon(portid, invalidChannel, [][]byte{suite.proof}, height, addr), false},
+		{"no proofs", types.NewMsgAdvanceTimeoutHorizon(portid, chanid, nil, height, addr), false},
+		{"too many proofs", types.NewMsgAdvanceTimeoutHorizon(portid, chanid, tooManyProofs, height, addr), false},
+		{"empty proof", types.NewMsgAdvanceTimeoutHorizon(portid, chanid, [][]byte{suite.proof, emptyProof}, height, addr), false},
+		{"proof height is zero", types.NewMsgAdvanceTimeoutHorizon(portid, chanid, [][]byte{suite.proof}, clienttypes.ZeroHeight(), addr), false},
+		{"empty signer", types.NewMsgAdvanceTimeoutHorizon(portid, chanid, [][]byte{suite.proof}, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -40,6 +40,10 @@ func CommitAcknowledgement(data []byte) []byte {
 	return hash[:]
 }
 
+// PacketTimeoutReceipt is the packet receipt written on ORDERED_ALLOW_TIMEOUT
+// channels for a packet received after its timeout.
+var PacketTimeoutReceipt = []byte{byte(2)}
+
 var _ exported.PacketI = (*Packet)(nil)
 
 // NewPacket creates a new Packet instance. It panics if the provided
@@ -111,3 +115,26 @@ func (p Packet) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPacketId returns a new instance of PacketId
+func NewPacketId(portID, channelID string, seq uint64) PacketId {
+	return PacketId{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  seq,
+	}
+}
+
+// Validate performs basic validation of the PacketId
+func (p PacketId) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+	return nil
+}
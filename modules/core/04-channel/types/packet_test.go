@@ -51,3 +51,25 @@ func TestPacketValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestPacketIdValidate(t *testing.T) {
+	testCases := []struct {
+		packetID types.PacketId
+		expPass  bool
+		errMsg   string
+	}{
+		{types.NewPacketId(portid, chanid, 1), true, ""},
+		{types.NewPacketId(invalidPort, chanid, 1), false, "invalid port"},
+		{types.NewPacketId(portid, invalidChannel, 1), false, "invalid channel"},
+		{types.NewPacketId(portid, chanid, 0), false, "invalid sequence"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetID.Validate()
+		if tc.expPass {
+			require.NoError(t, err, "PacketId %d failed: %s", i, tc.errMsg)
+		} else {
+			require.Error(t, err, "invalid PacketId %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeChannelUpgrade defines the type for a ChannelUpgradeProposal
+	ProposalTypeChannelUpgrade = "ChannelUpgrade"
+	// ProposalTypeChannelClose defines the type for a ChannelCloseProposal
+	ProposalTypeChannelClose = "ChannelClose"
+	// ProposalTypeChannelFreeze defines the type for a ChannelFreezeProposal
+	ProposalTypeChannelFreeze = "ChannelFreeze"
+	// ProposalTypeChannelUnfreeze defines the type for a ChannelUnfreezeProposal
+	ProposalTypeChannelUnfreeze = "ChannelUnfreeze"
+)
+
+var (
+	_ govtypes.Content = &ChannelUpgradeProposal{}
+	_ govtypes.Content = &ChannelCloseProposal{}
+	_ govtypes.Content = &ChannelFreezeProposal{}
+	_ govtypes.Content = &ChannelUnfreezeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeChannelUpgrade)
+	govtypes.RegisterProposalType(ProposalTypeChannelClose)
+	govtypes.RegisterProposalType(ProposalTypeChannelFreeze)
+	govtypes.RegisterProposalType(ProposalTypeChannelUnfreeze)
+}
+
+// NewChannelUpgradeProposal creates a new channel upgrade proposal.
+func NewChannelUpgradeProposal(title, description, portID, channelID string, fields UpgradeFields) *ChannelUpgradeProposal {
+	return &ChannelUpgradeProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ChannelId:   channelID,
+		Fields:      fields,
+	}
+}
+
+// GetTitle returns the title of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) GetTitle() string { return cup.Title }
+
+// GetDescription returns the description of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) GetDescription() string { return cup.Description }
+
+// ProposalRoute returns the routing key of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) ProposalType() string { return ProposalTypeChannelUpgrade }
+
+// ValidateBasic runs basic stateless validity checks
+func (cup *ChannelUpgradeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cup); err != nil {
+		return err
+	}
+
+	if err := validateProposalChannel(cup.PortId, cup.ChannelId); err != nil {
+		return err
+	}
+
+	return cup.Fields.ValidateBasic()
+}
+
+// NewChannelCloseProposal creates a new channel close proposal.
+func NewChannelCloseProposal(title, description, portID, channelID string) *ChannelCloseProposal {
+	return &ChannelCloseProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a channel close proposal.
+func (ccp *ChannelCloseProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a channel close proposal.
+func (ccp *ChannelCloseProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a channel close proposal.
+func (ccp *ChannelCloseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel close proposal.
+func (ccp *ChannelCloseProposal) ProposalType() string { return ProposalTypeChannelClose }
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp *ChannelCloseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ccp); err != nil {
+		return err
+	}
+
+	return validateProposalChannel(ccp.PortId, ccp.ChannelId)
+}
+
+// NewChannelFreezeProposal creates a new channel freeze proposal.
+func NewChannelFreezeProposal(title, description, portID, channelID string) *ChannelFreezeProposal {
+	return &ChannelFreezeProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a channel freeze proposal.
+func (cfp *ChannelFreezeProposal) GetTitle() string { return cfp.Title }
+
+// GetDescription returns the description of a channel freeze proposal.
+func (cfp *ChannelFreezeProposal) GetDescription() string { return cfp.Description }
+
+// ProposalRoute returns the routing key of a channel freeze proposal.
+func (cfp *ChannelFreezeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel freeze proposal.
+func (cfp *ChannelFreezeProposal) ProposalType() string { return ProposalTypeChannelFreeze }
+
+// ValidateBasic runs basic stateless validity checks
+func (cfp *ChannelFreezeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cfp); err != nil {
+		return err
+	}
+
+	return validateProposalChannel(cfp.PortId, cfp.ChannelId)
+}
+
+// NewChannelUnfreezeProposal creates a new channel unfreeze proposal.
+func NewChannelUnfreezeProposal(title, description, portID, channelID string) *ChannelUnfreezeProposal {
+	return &ChannelUnfreezeProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a channel unfreeze proposal.
+func (cup *ChannelUnfreezeProposal) GetTitle() string { return cup.Title }
+
+// GetDescription returns the description of a channel unfreeze proposal.
+func (cup *ChannelUnfreezeProposal) GetDescription() string { return cup.Description }
+
+// ProposalRoute returns the routing key of a channel unfreeze proposal.
+func (cup *ChannelUnfreezeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel unfreeze proposal.
+func (cup *ChannelUnfreezeProposal) ProposalType() string { return ProposalTypeChannelUnfreeze }
+
+// ValidateBasic runs basic stateless validity checks
+func (cup *ChannelUnfreezeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cup); err != nil {
+		return err
+	}
+
+	return validateProposalChannel(cup.PortId, cup.ChannelId)
+}
+
+// validateProposalChannel validates the port and channel identifiers of a channel proposal.
+func validateProposalChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(channelID) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	return nil
+}
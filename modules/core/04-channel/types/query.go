@@ -7,6 +7,10 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
+// MaxPacketStatusesRange is the maximum number of packet sequences whose status may be
+// resolved by a single PacketStatuses query.
+const MaxPacketStatusesRange = 100
+
 var (
 	_ codectypes.UnpackInterfacesMessage = QueryChannelClientStateResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryChannelConsensusStateResponse{}
@@ -93,3 +97,25 @@ func NewQueryNextSequenceReceiveResponse(
 		ProofHeight:         height,
 	}
 }
+
+// NewQueryUpgradeResponse creates a new QueryUpgradeResponse instance
+func NewQueryUpgradeResponse(
+	upgrade Upgrade, proof []byte, height clienttypes.Height,
+) *QueryUpgradeResponse {
+	return &QueryUpgradeResponse{
+		Upgrade:     upgrade,
+		Proof:       proof,
+		ProofHeight: height,
+	}
+}
+
+// NewQueryUpgradeErrorResponse creates a new QueryUpgradeErrorResponse instance
+func NewQueryUpgradeErrorResponse(
+	errorReceipt ErrorReceipt, proof []byte, height clienttypes.Height,
+) *QueryUpgradeErrorResponse {
+	return &QueryUpgradeErrorResponse{
+		ErrorReceipt: errorReceipt,
+		Proof:        proof,
+		ProofHeight:  height,
+	}
+}
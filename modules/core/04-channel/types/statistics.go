@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewChannelStatistics creates a new ChannelStatistics instance with all counters set to zero.
+func NewChannelStatistics(portID, channelID string) ChannelStatistics {
+	return ChannelStatistics{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (cs ChannelStatistics) Validate() error {
+	if err := host.PortIdentifierValidator(cs.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(cs.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	if cs.PacketsTimedOutOnClose > cs.PacketsTimedOut {
+		return fmt.Errorf("packets timed out on close (%d) cannot exceed packets timed out (%d)", cs.PacketsTimedOutOnClose, cs.PacketsTimedOut)
+	}
+	return nil
+}
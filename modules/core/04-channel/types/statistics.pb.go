@@ -0,0 +1,707 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/channel/v1/statistics.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelStatistics defines the packet lifecycle counters maintained for a channel end.
+// The last activity heights are the block heights of this chain at which the
+// corresponding packet handler was last executed.
+type ChannelStatistics struct {
+	// channel port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// number of packets sent on the channel
+	PacketsSent uint64 `protobuf:"varint,3,opt,name=packets_sent,json=packetsSent,proto3" json:"packets_sent,omitempty" yaml:"packets_sent"`
+	// number of packets received on the channel
+	PacketsReceived uint64 `protobuf:"varint,4,opt,name=packets_received,json=packetsReceived,proto3" json:"packets_received,omitempty" yaml:"packets_received"`
+	// number of acknowledgements written for received packets which the application
+	// reported as successful
+	AcknowledgementsSuccess uint64 `protobuf:"varint,5,opt,name=acknowledgements_success,json=acknowledgementsSuccess,proto3" json:"acknowledgements_success,omitempty" yaml:"acknowledgements_success"`
+	// number of acknowledgements written for received packets which the application
+	// reported as failed
+	AcknowledgementsError uint64 `protobuf:"varint,6,opt,name=acknowledgements_error,json=acknowledgementsError,proto3" json:"acknowledgements_error,omitempty" yaml:"acknowledgements_error"`
+	// number of sent packets whose acknowledgement has been processed
+	PacketsAcknowledged uint64 `protobuf:"varint,7,opt,name=packets_acknowledged,json=packetsAcknowledged,proto3" json:"packets_acknowledged,omitempty" yaml:"packets_acknowledged"`
+	// number of sent packets which timed out, including packets timed out on close
+	PacketsTimedOut uint64 `protobuf:"varint,8,opt,name=packets_timed_out,json=packetsTimedOut,proto3" json:"packets_timed_out,omitempty" yaml:"packets_timed_out"`
+	// number of sent packets which timed out because the counterparty channel end closed
+	PacketsTimedOutOnClose uint64 `protobuf:"varint,9,opt,name=packets_timed_out_on_close,json=packetsTimedOutOnClose,proto3" json:"packets_timed_out_on_close,omitempty" yaml:"packets_timed_out_on_close"`
+	// height at which a packet was last sent
+	LastSendHeight uint64 `protobuf:"varint,10,opt,name=last_send_height,json=lastSendHeight,proto3" json:"last_send_height,omitempty" yaml:"last_send_height"`
+	// height at which a packet was last received
+	LastRecvHeight uint64 `protobuf:"varint,11,opt,name=last_recv_height,json=lastRecvHeight,proto3" json:"last_recv_height,omitempty" yaml:"last_recv_height"`
+	// height at which an acknowledgement was last processed
+	LastAcknowledgeHeight uint64 `protobuf:"varint,12,opt,name=last_acknowledge_height,json=lastAcknowledgeHeight,proto3" json:"last_acknowledge_height,omitempty" yaml:"last_acknowledge_height"`
+	// height at which a packet last timed out
+	LastTimeoutHeight uint64 `protobuf:"varint,13,opt,name=last_timeout_height,json=lastTimeoutHeight,proto3" json:"last_timeout_height,omitempty" yaml:"last_timeout_height"`
+}
+
+func (m *ChannelStatistics) Reset()         { *m = ChannelStatistics{} }
+func (m *ChannelStatistics) String() string { return proto.CompactTextString(m) }
+func (*ChannelStatistics) ProtoMessage()    {}
+func (*ChannelStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_262b1dc2d62d74f3, []int{0}
+}
+func (m *ChannelStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelStatistics.Merge(m, src)
+}
+func (m *ChannelStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelStatistics proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ChannelStatistics)(nil), "ibc.core.channel.v1.ChannelStatistics")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/channel/v1/statistics.proto", fileDescriptor_262b1dc2d62d74f3)
+}
+
+var fileDescriptor_262b1dc2d62d74f3 = []byte{
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x4e, 0xdb, 0x3e,
+	0x1c, 0xc7, 0xdb, 0xff, 0x9f, 0xc1, 0x30, 0x8c, 0x51, 0x17, 0x68, 0x04, 0x5b, 0x0c, 0xde, 0x26,
+	0x21, 0x4d, 0x34, 0x43, 0x70, 0x19, 0xb7, 0x81, 0x98, 0xe0, 0x32, 0x24, 0x97, 0xc3, 0xc4, 0x61,
+	0x51, 0xea, 0x58, 0x69, 0x44, 0x12, 0x57, 0xb1, 0x9b, 0x89, 0x37, 0xd8, 0x71, 0x8f, 0xb0, 0xc7,
+	0xd9, 0x91, 0xe3, 0x4e, 0xd1, 0x04, 0x6f, 0x10, 0x69, 0xf7, 0xc9, 0x8e, 0x53, 0xd2, 0xb2, 0xde,
+	0x92, 0xef, 0xf7, 0xf3, 0xfb, 0x58, 0xfd, 0x35, 0x32, 0x78, 0x1d, 0xf6, 0xa9, 0x43, 0x79, 0xca,
+	0x1c, 0x3a, 0xf0, 0x92, 0x84, 0x45, 0x4e, 0xb6, 0xef, 0x08, 0xe9, 0xc9, 0x50, 0xc8, 0x90, 0x8a,
+	0xee, 0x30, 0xe5, 0x92, 0xc3, 0x76, 0xd8, 0xa7, 0x5d, 0x45, 0x75, 0x0d, 0xd5, 0xcd, 0xf6, 0x37,
+	0xd7, 0x02, 0x1e, 0x70, 0xdd, 0x3b, 0xea, 0xa9, 0x44, 0xf1, 0x9f, 0x05, 0xd0, 0x3a, 0x29, 0xa1,
+	0xde, 0x58, 0x03, 0xdf, 0x82, 0x85, 0x21, 0x4f, 0xa5, 0x1b, 0xfa, 0x56, 0x73, 0xbb, 0xb9, 0xbb,
+	0x78, 0x0c, 0x8b, 0x1c, 0xad, 0xdc, 0x78, 0x71, 0x74, 0x84, 0x4d, 0x81, 0xc9, 0xbc, 0x7a, 0x3a,
+	0xf7, 0xe1, 0x21, 0x00, 0xe6, 0x18, 0xc5, 0xff, 0xa7, 0xf9, 0xf5, 0x22, 0x47, 0xad, 0x92, 0x7f,
+	0xe8, 0x30, 0x59, 0x34, 0x2f, 0xe7, 0x3e, 0x3c, 0x02, 0xcb, 0x43, 0x8f, 0x5e, 0x33, 0x29, 0x5c,
+	0xc1, 0x12, 0x69, 0xfd, 0xbf, 0xdd, 0xdc, 0x9d, 0x3b, 0xee, 0x14, 0x39, 0x6a, 0x9b, 0x73, 0x6a,
+	0x2d, 0x26, 0x4b, 0xe6, 0xb5, 0xc7, 0x12, 0x09, 0x3f, 0x82, 0xd5, 0xaa, 0x4d, 0x19, 0x65, 0x61,
+	0xc6, 0x7c, 0x6b, 0x4e, 0xcf, 0x6f, 0x15, 0x39, 0xea, 0x4c, 0xce, 0x57, 0x04, 0x26, 0xcf, 0x4d,
+	0x44, 0x4c, 0x02, 0xbf, 0x00, 0xcb, 0xa3, 0xd7, 0x09, 0xff, 0x1a, 0x31, 0x3f, 0x60, 0x31, 0x4b,
+	0xd4, 0x71, 0x23, 0x4a, 0x99, 0x10, 0xd6, 0x13, 0xed, 0x7b, 0x55, 0xe4, 0x08, 0x95, 0xbe, 0x59,
+	0x24, 0x26, 0x9d, 0xe9, 0xaa, 0x57, 0x36, 0xf0, 0x33, 0xd8, 0x78, 0x34, 0xc5, 0xd2, 0x94, 0xa7,
+	0xd6, 0xbc, 0xb6, 0xef, 0x14, 0x39, 0x7a, 0x39, 0xc3, 0xae, 0x39, 0x4c, 0xd6, 0xa7, 0x8b, 0x53,
+	0x95, 0x43, 0x02, 0xd6, 0xaa, 0xdf, 0x57, 0x03, 0x7c, 0x6b, 0x41, 0x7b, 0x51, 0x91, 0xa3, 0xad,
+	0xc9, 0x2d, 0xd4, 0x29, 0x4c, 0xda, 0x26, 0xfe, 0x50, 0x4b, 0xe1, 0x19, 0x68, 0x55, 0xb4, 0x0c,
+	0x63, 0xe6, 0xbb, 0x7c, 0x24, 0xad, 0xa7, 0x5a, 0xf8, 0xa2, 0xc8, 0x91, 0x35, 0x29, 0x1c, 0x23,
+	0x0f, 0x7b, 0xbd, 0x54, 0xd1, 0xc5, 0x48, 0x42, 0x0f, 0x6c, 0x3e, 0xc2, 0x5c, 0x9e, 0xb8, 0x34,
+	0xe2, 0x82, 0x59, 0x8b, 0x5a, 0xf9, 0xa6, 0xc8, 0xd1, 0xce, 0x0c, 0xe5, 0x98, 0xc5, 0x64, 0x63,
+	0xca, 0x7d, 0x91, 0x9c, 0xa8, 0x02, 0x9e, 0x82, 0xd5, 0xc8, 0x13, 0x52, 0x7d, 0x1d, 0xbe, 0x3b,
+	0x60, 0x61, 0x30, 0x90, 0x16, 0x98, 0xfe, 0x04, 0xa6, 0x09, 0x4c, 0x56, 0x54, 0xd4, 0x63, 0x89,
+	0x7f, 0xa6, 0x83, 0xb1, 0x26, 0x65, 0x34, 0xab, 0x34, 0x4b, 0xff, 0xd4, 0xd4, 0x08, 0xa3, 0x21,
+	0x8c, 0x66, 0x46, 0x73, 0x05, 0x3a, 0x1a, 0xaa, 0x6d, 0xb9, 0xb2, 0x2d, 0x6b, 0x1b, 0x2e, 0x72,
+	0x64, 0xd7, 0x6c, 0x8f, 0x41, 0x4c, 0xd6, 0x55, 0x53, 0xfb, 0x47, 0x8c, 0xfb, 0x13, 0x68, 0xeb,
+	0x11, 0xb5, 0x1d, 0xb5, 0x1b, 0xe3, 0x7d, 0xa6, 0xbd, 0x76, 0x91, 0xa3, 0xcd, 0x9a, 0x77, 0x12,
+	0xc2, 0xa4, 0xa5, 0xd2, 0xcb, 0x32, 0x2c, 0x7d, 0x47, 0x73, 0xdf, 0x7e, 0xa0, 0xc6, 0x71, 0xef,
+	0xe7, 0x9d, 0xdd, 0xbc, 0xbd, 0xb3, 0x9b, 0xbf, 0xef, 0xec, 0xe6, 0xf7, 0x7b, 0xbb, 0x71, 0x7b,
+	0x6f, 0x37, 0x7e, 0xdd, 0xdb, 0x8d, 0xab, 0xf7, 0x41, 0x28, 0x07, 0xa3, 0x7e, 0x97, 0xf2, 0xd8,
+	0xa1, 0x5c, 0xc4, 0x5c, 0x38, 0x61, 0x9f, 0xee, 0x05, 0xdc, 0xc9, 0x0e, 0x9c, 0x98, 0xfb, 0xa3,
+	0x88, 0x89, 0xf2, 0x0a, 0x7a, 0x77, 0xb8, 0x57, 0xdd, 0x42, 0xf2, 0x66, 0xc8, 0x44, 0x7f, 0x5e,
+	0xdf, 0x29, 0x07, 0x7f, 0x07, 0x00, 0x34, 0x59, 0xfc, 0x81, 0xa6, 0x04, 0x00, 0x00,
+}
+
+func (m *ChannelStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastTimeoutHeight != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.LastTimeoutHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.LastAcknowledgeHeight != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.LastAcknowledgeHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastRecvHeight != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.LastRecvHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LastSendHeight != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.LastSendHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PacketsTimedOutOnClose != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.PacketsTimedOutOnClose))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PacketsTimedOut != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.PacketsTimedOut))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PacketsAcknowledged != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.PacketsAcknowledged))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AcknowledgementsError != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.AcknowledgementsError))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AcknowledgementsSuccess != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.AcknowledgementsSuccess))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PacketsReceived != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.PacketsReceived))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PacketsSent != 0 {
+		i = encodeVarintStatistics(dAtA, i, uint64(m.PacketsSent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintStatistics(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintStatistics(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStatistics(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStatistics(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovStatistics(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovStatistics(uint64(l))
+	}
+	if m.PacketsSent != 0 {
+		n += 1 + sovStatistics(uint64(m.PacketsSent))
+	}
+	if m.PacketsReceived != 0 {
+		n += 1 + sovStatistics(uint64(m.PacketsReceived))
+	}
+	if m.AcknowledgementsSuccess != 0 {
+		n += 1 + sovStatistics(uint64(m.AcknowledgementsSuccess))
+	}
+	if m.AcknowledgementsError != 0 {
+		n += 1 + sovStatistics(uint64(m.AcknowledgementsError))
+	}
+	if m.PacketsAcknowledged != 0 {
+		n += 1 + sovStatistics(uint64(m.PacketsAcknowledged))
+	}
+	if m.PacketsTimedOut != 0 {
+		n += 1 + sovStatistics(uint64(m.PacketsTimedOut))
+	}
+	if m.PacketsTimedOutOnClose != 0 {
+		n += 1 + sovStatistics(uint64(m.PacketsTimedOutOnClose))
+	}
+	if m.LastSendHeight != 0 {
+		n += 1 + sovStatistics(uint64(m.LastSendHeight))
+	}
+	if m.LastRecvHeight != 0 {
+		n += 1 + sovStatistics(uint64(m.LastRecvHeight))
+	}
+	if m.LastAcknowledgeHeight != 0 {
+		n += 1 + sovStatistics(uint64(m.LastAcknowledgeHeight))
+	}
+	if m.LastTimeoutHeight != 0 {
+		n += 1 + sovStatistics(uint64(m.LastTimeoutHeight))
+	}
+	return n
+}
+
+func sovStatistics(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStatistics(x uint64) (n int) {
+	return sovStatistics(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatistics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsSent", wireType)
+			}
+			m.PacketsSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsReceived", wireType)
+			}
+			m.PacketsReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsReceived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgementsSuccess", wireType)
+			}
+			m.AcknowledgementsSuccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgementsSuccess |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgementsError", wireType)
+			}
+			m.AcknowledgementsError = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcknowledgementsError |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsAcknowledged", wireType)
+			}
+			m.PacketsAcknowledged = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsAcknowledged |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsTimedOut", wireType)
+			}
+			m.PacketsTimedOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsTimedOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsTimedOutOnClose", wireType)
+			}
+			m.PacketsTimedOutOnClose = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsTimedOutOnClose |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSendHeight", wireType)
+			}
+			m.LastSendHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSendHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRecvHeight", wireType)
+			}
+			m.LastRecvHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRecvHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAcknowledgeHeight", wireType)
+			}
+			m.LastAcknowledgeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAcknowledgeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimeoutHeight", wireType)
+			}
+			m.LastTimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatistics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStatistics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStatistics(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStatistics
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStatistics
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStatistics
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStatistics
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStatistics
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStatistics        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStatistics          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStatistics = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var (
+	_ exported.UpgradeI      = (*Upgrade)(nil)
+	_ exported.ErrorReceiptI = (*ErrorReceipt)(nil)
+)
+
+// restoreErrorString defines the error message written to the error receipt
+// of an aborted upgrade. The underlying error is not included as its message
+// is not guaranteed to be deterministic.
+const restoreErrorString = "restored channel to pre-upgrade state"
+
+// UpgradeTimeoutPeriod defines the period after which an upgrade attempt times out
+// once a channel end has started flushing in-flight packets.
+const UpgradeTimeoutPeriod = 10 * time.Minute
+
+// NewUpgrade creates a new Upgrade instance.
+func NewUpgrade(upgradeFields UpgradeFields, timeout Timeout, nextSequenceSend uint64) Upgrade {
+	return Upgrade{
+		Fields:           upgradeFields,
+		Timeout:          timeout,
+		NextSequenceSend: nextSequenceSend,
+	}
+}
+
+// GetNextSequenceSend implements UpgradeI interface.
+func (u Upgrade) GetNextSequenceSend() uint64 {
+	return u.NextSequenceSend
+}
+
+// ValidateBasic performs a basic validation of the upgrade fields
+func (u Upgrade) ValidateBasic() error {
+	if err := u.Fields.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "proposed upgrade fields are invalid")
+	}
+
+	if !u.Timeout.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "upgrade timeout height and upgrade timeout timestamp cannot both be 0")
+	}
+
+	return nil
+}
+
+// NewUpgradeFields returns a new UpgradeFields instance.
+func NewUpgradeFields(ordering Order, connectionHops []string, version string) UpgradeFields {
+	return UpgradeFields{
+		Ordering:       ordering,
+		ConnectionHops: connectionHops,
+		Version:        version,
+	}
+}
+
+// ValidateBasic performs a basic validation of the proposed upgrade fields
+func (uf UpgradeFields) ValidateBasic() error {
+	if !(uf.Ordering == ORDERED || uf.Ordering == UNORDERED || uf.Ordering == ORDERED_ALLOW_TIMEOUT) {
+		return sdkerrors.Wrap(ErrInvalidChannelOrdering, uf.Ordering.String())
+	}
+
+	if len(uf.ConnectionHops) != 1 {
+		return sdkerrors.Wrap(ErrTooManyConnectionHops, "current IBC version only supports one connection hop")
+	}
+
+	if err := host.ConnectionIdentifierValidator(uf.ConnectionHops[0]); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection hop ID")
+	}
+
+	if strings.TrimSpace(uf.Version) == "" {
+		return sdkerrors.Wrap(ErrInvalidChannelVersion, "version cannot be empty")
+	}
+
+	return nil
+}
+
+// NewErrorReceipt returns a new ErrorReceipt instance.
+func NewErrorReceipt(upgradeSequence uint64, message string) ErrorReceipt {
+	return ErrorReceipt{
+		Sequence: upgradeSequence,
+		Message:  message,
+	}
+}
+
+// GetSequence implements ErrorReceiptI interface.
+func (er ErrorReceipt) GetSequence() uint64 {
+	return er.Sequence
+}
+
+// GetMessage implements ErrorReceiptI interface.
+func (er ErrorReceipt) GetMessage() string {
+	return er.Message
+}
+
+// UpgradeError defines an error that occurs during an upgrade handshake. A
+// channel end returning an UpgradeError aborts the current upgrade attempt,
+// writes an error receipt for the upgrade sequence and restores the channel.
+type UpgradeError struct {
+	sequence uint64
+	err      error
+}
+
+// NewUpgradeError returns a new UpgradeError instance.
+func NewUpgradeError(upgradeSequence uint64, err error) *UpgradeError {
+	return &UpgradeError{
+		sequence: upgradeSequence,
+		err:      err,
+	}
+}
+
+// Error implements the error interface, returning the underlying error which caused the upgrade to fail.
+func (u *UpgradeError) Error() string {
+	return u.err.Error()
+}
+
+// Is returns true if the provided error is an upgrade error.
+func (*UpgradeError) Is(err error) bool {
+	_, ok := err.(*UpgradeError)
+	return ok
+}
+
+// Unwrap returns the base error that caused the upgrade to fail.
+func (u *UpgradeError) Unwrap() error {
+	return u.err
+}
+
+// Cause implements the sdk error interface which uses this function to unwrap the error in various functions such as `wrappedError.Is()`.
+// Cause returns the underlying error which caused the upgrade to fail.
+func (u *UpgradeError) Cause() error {
+	return u.err
+}
+
+// GetErrorReceipt returns an error receipt with the code from the underlying error type stripped.
+func (u *UpgradeError) GetErrorReceipt() ErrorReceipt {
+	// ABCIInfo returns the code and codespace of the error, the error message
+	// itself is intentionally omitted to keep the receipt deterministic
+	_, code, _ := sdkerrors.ABCIInfo(u, false)
+	return NewErrorReceipt(u.sequence, fmt.Sprintf("ABCI code: %d: %s", code, restoreErrorString))
+}
+
+// IsUpgradeError returns true if err is of type UpgradeError or contains an error of type UpgradeError.
+func IsUpgradeError(err error) bool {
+	var upgradeErr *UpgradeError
+	return errors.As(err, &upgradeErr)
+}
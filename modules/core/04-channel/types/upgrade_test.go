@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestUpgradeValidateBasic(t *testing.T) {
+	fields := types.NewUpgradeFields(types.UNORDERED, connHops, version)
+
+	testCases := []struct {
+		name    string
+		upgrade types.Upgrade
+		expPass bool
+	}{
+		{"valid upgrade with timeout height", types.NewUpgrade(fields, types.NewTimeout(timeoutHeight, 0), 1), true},
+		{"valid upgrade with timeout timestamp", types.NewUpgrade(fields, types.NewTimeout(disabledTimeout, timeoutTimestamp), 1), true},
+		{"timeout not set", types.NewUpgrade(fields, types.Timeout{}, 1), false},
+		{"invalid ordering", types.NewUpgrade(types.NewUpgradeFields(types.NONE, connHops, version), types.NewTimeout(timeoutHeight, 0), 1), false},
+		{"more than 1 connection hop", types.NewUpgrade(types.NewUpgradeFields(types.UNORDERED, invalidConnHops, version), types.NewTimeout(timeoutHeight, 0), 1), false},
+		{"invalid connection hop identifier", types.NewUpgrade(types.NewUpgradeFields(types.UNORDERED, invalidShortConnHops, version), types.NewTimeout(timeoutHeight, 0), 1), false},
+		{"empty version", types.NewUpgrade(types.NewUpgradeFields(types.UNORDERED, connHops, "  "), types.NewTimeout(timeoutHeight, 0), 1), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.upgrade.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestChannelUpgradeProposalValidateBasic(t *testing.T) {
+	fields := types.NewUpgradeFields(types.UNORDERED, connHops, version)
+
+	testCases := []struct {
+		name     string
+		proposal *types.ChannelUpgradeProposal
+		expPass  bool
+	}{
+		{"valid proposal", types.NewChannelUpgradeProposal("title", "description", portid, chanid, fields), true},
+		{"empty title", types.NewChannelUpgradeProposal("", "description", portid, chanid, fields), false},
+		{"invalid port id", types.NewChannelUpgradeProposal("title", "description", invalidPort, chanid, fields), false},
+		{"invalid channel id", types.NewChannelUpgradeProposal("title", "description", portid, invalidChannel, fields), false},
+		{"invalid upgrade fields", types.NewChannelUpgradeProposal("title", "description", portid, chanid, types.NewUpgradeFields(types.UNORDERED, connHops, "")), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestChannelGovernanceProposalsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{"valid close proposal", types.NewChannelCloseProposal("title", "description", portid, chanid), true},
+		{"close proposal with empty title", types.NewChannelCloseProposal("", "description", portid, chanid), false},
+		{"close proposal with invalid port id", types.NewChannelCloseProposal("title", "description", invalidPort, chanid), false},
+		{"close proposal with invalid channel id", types.NewChannelCloseProposal("title", "description", portid, invalidChannel), false},
+		{"valid freeze proposal", types.NewChannelFreezeProposal("title", "description", portid, chanid), true},
+		{"freeze proposal with empty description", types.NewChannelFreezeProposal("title", "", portid, chanid), false},
+		{"freeze proposal with invalid port id", types.NewChannelFreezeProposal("title", "description", invalidPort, chanid), false},
+		{"freeze proposal with invalid channel id", types.NewChannelFreezeProposal("title", "description", portid, invalidChannel), false},
+		{"valid unfreeze proposal", types.NewChannelUnfreezeProposal("title", "description", portid, chanid), true},
+		{"unfreeze proposal with empty title", types.NewChannelUnfreezeProposal("", "description", portid, chanid), false},
+		{"unfreeze proposal with invalid port id", types.NewChannelUnfreezeProposal("title", "description", invalidPort, chanid), false},
+		{"unfreeze proposal with invalid channel id", types.NewChannelUnfreezeProposal("title", "description", portid, invalidChannel), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUpgradeErrorReceipt(t *testing.T) {
+	upgradeErr := types.NewUpgradeError(1, sdkerrors.Wrap(types.ErrInvalidUpgrade, "non-deterministic message"))
+
+	require.True(t, types.IsUpgradeError(upgradeErr))
+	require.True(t, types.IsUpgradeError(sdkerrors.Wrap(upgradeErr, "wrapped")))
+	require.False(t, types.IsUpgradeError(types.ErrInvalidUpgrade))
+	require.ErrorIs(t, upgradeErr, types.ErrInvalidUpgrade)
+
+	errorReceipt := upgradeErr.GetErrorReceipt()
+	require.Equal(t, uint64(1), errorReceipt.Sequence)
+	require.NotContains(t, errorReceipt.Message, "non-deterministic message")
+}
@@ -74,6 +74,54 @@ type IBCModule interface {
 		channelID string,
 	) error
 
+	// OnChanUpgradeInit enables additional custom logic to be executed when the channel upgrade is initialized.
+	// It must validate the proposed version, order, and connection hops.
+	// NOTE: in the case of crossing hellos, this callback may be executed on both chains.
+	// NOTE: Any IBC application state changes made in this callback handler are not committed.
+	OnChanUpgradeInit(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	) (string, error)
+
+	// OnChanUpgradeTry enables additional custom logic to be executed in the ChannelUpgradeTry step of the
+	// channel upgrade handshake. It must validate the proposed version (provided by the counterparty), order,
+	// and connection hops.
+	// NOTE: Any IBC application state changes made in this callback handler are not committed.
+	OnChanUpgradeTry(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		counterpartyVersion string,
+	) (string, error)
+
+	// OnChanUpgradeAck enables additional custom logic to be executed in the ChannelUpgradeAck step of the
+	// channel upgrade handshake. It must validate the version proposed by the counterparty.
+	// NOTE: Any IBC application state changes made in this callback handler are not committed.
+	OnChanUpgradeAck(
+		ctx sdk.Context,
+		portID,
+		channelID,
+		counterpartyVersion string,
+	) error
+
+	// OnChanUpgradeOpen enables additional custom logic to be executed when the channel upgrade has successfully completed, and the channel
+	// has returned to the OPEN state. Any logic associated with changing of the channel fields should be performed
+	// in this callback, such as migrating application state.
+	OnChanUpgradeOpen(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	)
+
 	// OnRecvPacket must return an acknowledgement that implements the Acknowledgement interface.
 	// In the case of an asynchronous acknowledgement, nil should be returned.
 	// If the acknowledgement returned is successful, the state changes on callback are written,
@@ -116,8 +164,26 @@ type ICS4Wrapper interface {
 }
 
 // Middleware must implement IBCModule to wrap communication from core IBC to underlying application
-// and ICS4Wrapper to wrap communication from underlying application to core IBC.
+// and ICS4Wrapper to wrap communication from underlying application to core IBC. Middleware must also
+// implement RecvPacketWrapper, forwarding it to the underlying application if it implements it.
 type Middleware interface {
 	IBCModule
 	ICS4Wrapper
+	RecvPacketWrapper
+}
+
+// RecvPacketWrapper defines an interface for IBC modules which persist state when receiving a packet regardless of
+// the acknowledgement, such as the usage of a quota by packets failing with an error acknowledgement. It is optional
+// for applications and required for middleware, such that core IBC reaches the application through any middleware.
+type RecvPacketWrapper interface {
+	// WrapRecvPacket is called by core IBC in place of the OnRecvPacket callback, with a context whose state changes
+	// are written regardless of the acknowledgement. The provided recvPacket function performs the OnRecvPacket
+	// callback within a cached context, whose state changes are discarded if the acknowledgement is unsuccessful,
+	// and returns its acknowledgement.
+	WrapRecvPacket(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		recvPacket func(ctx sdk.Context) exported.Acknowledgement,
+	) exported.Acknowledgement
 }
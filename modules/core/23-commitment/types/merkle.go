@@ -203,10 +203,47 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	return nil
 }
 
-// BatchVerifyMembership verifies a group of key value pairs against the given root
-// NOTE: Currently left unimplemented as it is unused
+// BatchVerifyMembership verifies a group of key value pairs against the given root. The path is
+// the path of the lowest subtree containing the items, which are keyed by their key in that subtree.
+// The proof of the lowest subtree must be a batch (or compressed batch) proof of the existence of
+// every item, the subroot it commits to being proven up to the final root by the remaining proofs.
 func (proof MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items map[string][]byte) error {
-	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return err
+	}
+
+	// BatchVerifyMembership specific argument validation
+	mpath, ok := path.(MerklePath)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+	if len(mpath.KeyPath)+1 != len(specs) {
+		return sdkerrors.Wrapf(ErrInvalidProof, "path length %d not same as proof %d minus the lowest subtree",
+			len(mpath.KeyPath), len(specs))
+	}
+	if len(items) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProof, "no items in batch membership proof")
+	}
+	for key, value := range items {
+		if len(value) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidProof, "empty value for key %s in batch membership proof", key)
+		}
+	}
+
+	subroot, err := proof.Proofs[0].Calculate()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0, merkle tree is likely empty. %v", err)
+	}
+	if ok := ics23.BatchVerifyMembership(specs[0], subroot, proof.Proofs[0], items); !ok {
+		return sdkerrors.Wrapf(ErrInvalidProof, "could not verify membership of the %d batch items in subroot %X", len(items), subroot)
+	}
+
+	// the keys of the chained proofs are passed in from highest to lowest subtree, the key of the
+	// lowest subtree is left empty as it is not used when chaining from index 1
+	keys := NewMerklePath(append(append([]string{}, mpath.KeyPath...), "")...)
+
+	// Verify chained membership proof starting from index 1 with value = subroot
+	return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, keys, subroot, 1)
 }
 
 // BatchVerifyNonMembership verifies absence of a group of keys against the given root
@@ -215,6 +252,40 @@ func (proof MerkleProof) BatchVerifyNonMembership(specs []*ics23.ProofSpec, root
 	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
 }
 
+// CombineMerkleProofs combines the membership proofs of keys of the same lowest subtree, queried at
+// the same height, into a single proof usable with BatchVerifyMembership. The proofs of the lowest
+// subtree are combined into a compressed batch proof, while the proofs of the higher subtrees, which
+// must be equal for every proof, are kept once.
+func CombineMerkleProofs(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, sdkerrors.Wrap(ErrInvalidProof, "no proofs to combine")
+	}
+
+	lowest := make([]*ics23.CommitmentProof, len(proofs))
+	for i, p := range proofs {
+		if len(p.Proofs) == 0 || len(p.Proofs) != len(proofs[0].Proofs) {
+			return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidProof, "proof %d length %d not same as proof 0 length %d", i, len(p.Proofs), len(proofs[0].Proofs))
+		}
+
+		for j := 1; j < len(p.Proofs); j++ {
+			if !proto.Equal(p.Proofs[j], proofs[0].Proofs[j]) {
+				return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidProof, "proof %d does not share the subtree proof at index %d", i, j)
+			}
+		}
+
+		lowest[i] = p.Proofs[0]
+	}
+
+	batch, err := ics23.CombineProofs(lowest)
+	if err != nil {
+		return MerkleProof{}, sdkerrors.Wrap(ErrInvalidProof, err.Error())
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{batch}, proofs[0].Proofs[1:]...),
+	}, nil
+}
+
 // verifyChainedMembershipProof takes a list of proofs and specs and verifies each proof sequentially ensuring that the value is committed to
 // by first proof and each subsequent subroot is committed to by the next subroot and checking that the final calculated root is equal to the given roothash.
 // The proofs and specs are passed in from lowest subtree to the highest subtree, but the keys are passed in from highest subtree to lowest.
@@ -137,6 +137,116 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *MerkleTestSuite) TestBatchVerifyMembership() {
+	items := map[string][]byte{
+		"MYKEY1": []byte("MYVALUE1"),
+		"MYKEY2": []byte("MYVALUE2"),
+		"MYKEY3": []byte("MYVALUE3"),
+	}
+	for key, value := range items {
+		suite.iavlStore.Set([]byte(key), value)
+	}
+	suite.iavlStore.Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	cid := suite.store.Commit()
+
+	var proofs []types.MerkleProof
+	for key := range items {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		require.NotNil(suite.T(), res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		require.NoError(suite.T(), err)
+
+		proofs = append(proofs, proof)
+	}
+
+	_, err := types.CombineMerkleProofs(nil)
+	suite.Require().Error(err)
+
+	_, err = types.CombineMerkleProofs([]types.MerkleProof{proofs[0], {Proofs: proofs[1].Proofs[1:]}})
+	suite.Require().Error(err)
+
+	var (
+		proof      types.MerkleProof
+		root       []byte
+		pathArr    []string
+		batchItems map[string][]byte
+	)
+
+	cases := []struct {
+		name       string
+		malleate   func()
+		shouldPass bool
+	}{
+		{"valid proof", func() {}, true},
+		{"valid proof of a subset of the items", func() {
+			batchItems = map[string][]byte{"MYKEY1": []byte("MYVALUE1")}
+		}, true},
+		{"wrong value", func() {
+			batchItems["MYKEY2"] = []byte("WRONGVALUE")
+		}, false},
+		{"nil value", func() {
+			batchItems["MYKEY2"] = nil
+		}, false},
+		{"key not in batch", func() {
+			batchItems["OTHERKEY"] = []byte("OTHERVALUE")
+		}, false},
+		{"no items", func() {
+			batchItems = map[string][]byte{}
+		}, false},
+		{"wrong path", func() {
+			pathArr = []string{suite.storeKey.Name(), "MYKEY1"}
+		}, false},
+		{"wrong storekey", func() {
+			pathArr = []string{"otherStoreKey"}
+		}, false},
+		{"wrong root", func() {
+			root = []byte("WRONGROOT")
+		}, false},
+		{"nil root", func() {
+			root = nil
+		}, false},
+		{"proof is wrong length", func() {
+			proof = types.MerkleProof{
+				Proofs: proof.Proofs[1:],
+			}
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			proof, err = types.CombineMerkleProofs(proofs)
+			suite.Require().NoError(err)
+			suite.Require().Len(proof.Proofs, len(proofs[0].Proofs))
+
+			root = cid.Hash
+			pathArr = []string{suite.storeKey.Name()}
+			batchItems = make(map[string][]byte)
+			for key, value := range items {
+				batchItems[key] = value
+			}
+
+			tc.malleate()
+
+			merkleRoot := types.NewMerkleRoot(root)
+			path := types.NewMerklePath(pathArr...)
+
+			err := proof.BatchVerifyMembership(types.GetSDKSpecs(), &merkleRoot, path, batchItems)
+
+			if tc.shouldPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
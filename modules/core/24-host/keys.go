@@ -41,6 +41,14 @@ const (
 	KeyPacketCommitmentPrefix  = "commitments"
 	KeyPacketAckPrefix         = "acks"
 	KeyPacketReceiptPrefix     = "receipts"
+	KeyChannelUpgradePrefix    = "channelUpgrades"
+	KeyUpgradePrefix           = "upgrades"
+	KeyUpgradeErrorPrefix      = "upgradeError"
+	KeyCounterpartyUpgrade     = "counterpartyUpgrade"
+	KeyRecvStartSequence       = "recvStartSequence"
+	KeyPruningSequenceStart    = "pruningSequenceStart"
+	KeyChannelFrozen           = "channelFrozen"
+	KeyChannelStatistics       = "channelStatistics"
 )
 
 // FullClientPath returns the full path of a specific client path in the format:
@@ -218,6 +226,82 @@ func PacketReceiptKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(PacketReceiptPath(portID, channelID, sequence))
 }
 
+// ChannelUpgradePath defines the path which stores the information related to an upgrade attempt
+func ChannelUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyUpgradePrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeKey returns the store key for a particular channel upgrade attempt
+func ChannelUpgradeKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradePath(portID, channelID))
+}
+
+// ChannelUpgradeErrorPath defines the path under which the error receipt of the
+// last failed upgrade attempt of a channel is stored
+func ChannelUpgradeErrorPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyUpgradeErrorPrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeErrorKey returns the store key for a particular channel upgrade error receipt
+func ChannelUpgradeErrorKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradeErrorPath(portID, channelID))
+}
+
+// ChannelCounterpartyUpgradePath defines the path under which the counterparty
+// upgrade of an upgrade attempt is stored
+func ChannelCounterpartyUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID))
+}
+
+// ChannelCounterpartyUpgradeKey returns the store key for a particular counterparty channel upgrade
+func ChannelCounterpartyUpgradeKey(portID, channelID string) []byte {
+	return []byte(ChannelCounterpartyUpgradePath(portID, channelID))
+}
+
+// RecvStartSequencePath defines the path under which the first sequence a channel
+// accepts after a channel upgrade is stored
+func RecvStartSequencePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyRecvStartSequence, channelPath(portID, channelID))
+}
+
+// RecvStartSequenceKey returns the store key for the recv start sequence of a particular channel
+func RecvStartSequenceKey(portID, channelID string) []byte {
+	return []byte(RecvStartSequencePath(portID, channelID))
+}
+
+// PruningSequenceStartPath defines the path under which the next sequence to be
+// pruned for a channel is stored
+func PruningSequenceStartPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPruningSequenceStart, channelPath(portID, channelID))
+}
+
+// PruningSequenceStartKey returns the store key for the pruning sequence start of a particular channel
+func PruningSequenceStartKey(portID, channelID string) []byte {
+	return []byte(PruningSequenceStartPath(portID, channelID))
+}
+
+// ChannelFrozenPath defines the path under which the frozen flag of a channel
+// frozen by governance is stored
+func ChannelFrozenPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelFrozen, channelPath(portID, channelID))
+}
+
+// ChannelFrozenKey returns the store key for the frozen flag of a particular channel
+func ChannelFrozenKey(portID, channelID string) []byte {
+	return []byte(ChannelFrozenPath(portID, channelID))
+}
+
+// ChannelStatisticsPath defines the path under which the packet lifecycle statistics
+// of a channel are stored
+func ChannelStatisticsPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelStatistics, channelPath(portID, channelID))
+}
+
+// ChannelStatisticsKey returns the store key for the packet lifecycle statistics of a particular channel
+func ChannelStatisticsKey(portID, channelID string) []byte {
+	return []byte(ChannelStatisticsPath(portID, channelID))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
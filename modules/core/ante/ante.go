@@ -49,6 +49,30 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				}
 				packetMsgs += 1
 
+			case *channeltypes.MsgRecvPackets:
+				response, err := ad.k.RecvPackets(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies += 1
+					}
+				}
+				packetMsgs += len(response.Results)
+
+			case *channeltypes.MsgAcknowledgements:
+				response, err := ad.k.Acknowledgements(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies += 1
+					}
+				}
+				packetMsgs += len(response.Results)
+
 			case *channeltypes.MsgTimeout:
 				response, err := ad.k.Timeout(sdk.WrapSDKContext(ctx), msg)
 				if err != nil {
@@ -91,6 +91,67 @@ func (suite *AnteTestSuite) createAcknowledgementMessage(sequenceNumber uint64,
 	return channeltypes.NewMsgAcknowledgement(packet, ibctesting.MockAcknowledgement, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketsMessage creates a RecvPackets message for a batch of packets sent from chain A to chain B,
+// the first numRedundant packets of which are already received on chain B.
+func (suite *AnteTestSuite) createRecvPacketsMessage(numPackets, numRedundant int) sdk.Msg {
+	packets := make([]channeltypes.Packet, numPackets)
+	packetKeys := make([][]byte, numPackets)
+	for i := range packets {
+		packets[i] = channeltypes.NewPacket(ibctesting.MockPacketData, uint64(i+1),
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			clienttypes.NewHeight(1, 0), 0)
+
+		err := suite.path.EndpointA.SendPacket(packets[i])
+		suite.Require().NoError(err)
+
+		if i < numRedundant {
+			err = suite.path.EndpointB.RecvPacket(packets[i])
+			suite.Require().NoError(err)
+		}
+
+		packetKeys[i] = host.PacketCommitmentKey(packets[i].GetSourcePort(), packets[i].GetSourceChannel(), packets[i].GetSequence())
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.chainA.QueryProofs(packetKeys...)
+
+	return channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
+// createAcknowledgementsMessage creates an Acknowledgements message for a batch of packets sent from chain B to chain A,
+// the first numRedundant packets of which are already acknowledged on chain B.
+func (suite *AnteTestSuite) createAcknowledgementsMessage(numPackets, numRedundant int) sdk.Msg {
+	packets := make([]channeltypes.Packet, numPackets)
+	acks := make([][]byte, numPackets)
+	packetKeys := make([][]byte, numPackets)
+	for i := range packets {
+		packets[i] = channeltypes.NewPacket(ibctesting.MockPacketData, uint64(i+1),
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			clienttypes.NewHeight(1, 0), 0)
+
+		err := suite.path.EndpointB.SendPacket(packets[i])
+		suite.Require().NoError(err)
+		err = suite.path.EndpointA.RecvPacket(packets[i])
+		suite.Require().NoError(err)
+
+		acks[i] = ibctesting.MockAcknowledgement
+		packetKeys[i] = host.PacketAcknowledgementKey(packets[i].GetDestPort(), packets[i].GetDestChannel(), packets[i].GetSequence())
+	}
+
+	for i := 0; i < numRedundant; i++ {
+		err := suite.path.EndpointB.AcknowledgePacket(packets[i], acks[i])
+		suite.Require().NoError(err)
+	}
+
+	proof, proofHeight := suite.chainA.QueryProofs(packetKeys...)
+
+	return channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createTimeoutMessage creates an Timeout message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createTimeoutMessage(sequenceNumber uint64, isRedundant bool) sdk.Msg {
 	height := suite.chainA.LastHeader.GetHeight()
@@ -144,7 +205,7 @@ func (suite *AnteTestSuite) createTimeoutOnCloseMessage(sequenceNumber uint64, i
 	channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
 	proofClosed, _ := suite.chainA.QueryProof(channelKey)
 
-	return channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+	return channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String(), 0)
 }
 
 func (suite *AnteTestSuite) createUpdateClientMessage() sdk.Msg {
@@ -339,6 +400,34 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on one RecvPackets message with new and redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(3, 2)}
+			},
+			true,
+		},
+		{
+			"success on one Acknowledgements message with new and redundant acknowledgements",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(3, 2)}
+			},
+			true,
+		},
+		{
+			"no success on one RecvPackets message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(3, 3)}
+			},
+			false,
+		},
+		{
+			"no success on one Acknowledgements message with only redundant acknowledgements",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(3, 3)}
+			},
+			false,
+		},
 		{
 			"no success on one redundant RecvPacket message",
 			func(suite *AnteTestSuite) []sdk.Msg {
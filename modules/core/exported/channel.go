@@ -18,6 +18,19 @@ type CounterpartyChannelI interface {
 	ValidateBasic() error
 }
 
+// UpgradeI defines the standard interface for a channel upgrade attempt.
+type UpgradeI interface {
+	GetNextSequenceSend() uint64
+	ValidateBasic() error
+}
+
+// ErrorReceiptI defines the standard interface for the error receipt of a
+// failed channel upgrade attempt.
+type ErrorReceiptI interface {
+	GetSequence() uint64
+	GetMessage() string
+}
+
 // PacketI defines the standard interface for IBC packets
 type PacketI interface {
 	GetSequence() uint64
@@ -122,6 +122,26 @@ type ClientState interface {
 		channelID string,
 		channel ChannelI,
 	) error
+	VerifyChannelUpgrade(
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		upgrade UpgradeI,
+	) error
+	VerifyChannelUpgradeError(
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		errorReceipt ErrorReceiptI,
+	) error
 	VerifyPacketCommitment(
 		ctx sdk.Context,
 		store sdk.KVStore,
@@ -150,6 +170,32 @@ type ClientState interface {
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		commitments map[uint64][]byte,
+	) error
+	VerifyPacketAcknowledgements(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		acknowledgements map[uint64][]byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		store sdk.KVStore,
@@ -163,6 +209,33 @@ type ClientState interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketCommitmentAbsence(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		store sdk.KVStore,
@@ -340,6 +340,14 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 				suite.coordinator.SetupClients(ibctesting.NewPath(suite.chainA, suite.chainB))
 			},
 		},
+		{
+			"success with frozen channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+				suite.Require().NoError(path.EndpointA.FreezeChannel())
+			},
+		},
 	}
 
 	for _, tc := range testCases {
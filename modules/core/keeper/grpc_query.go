@@ -137,3 +137,38 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnrecei
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// Upgrade implements the IBC QueryServer interface
+func (q Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeRequest) (*channeltypes.QueryUpgradeResponse, error) {
+	return q.ChannelKeeper.Upgrade(c, req)
+}
+
+// UpgradeError implements the IBC QueryServer interface
+func (q Keeper) UpgradeError(c context.Context, req *channeltypes.QueryUpgradeErrorRequest) (*channeltypes.QueryUpgradeErrorResponse, error) {
+	return q.ChannelKeeper.UpgradeError(c, req)
+}
+
+// PrunableAcknowledgements implements the IBC QueryServer interface
+func (q Keeper) PrunableAcknowledgements(c context.Context, req *channeltypes.QueryPrunableAcknowledgementsRequest) (*channeltypes.QueryPrunableAcknowledgementsResponse, error) {
+	return q.ChannelKeeper.PrunableAcknowledgements(c, req)
+}
+
+// FrozenChannels implements the IBC QueryServer interface
+func (q Keeper) FrozenChannels(c context.Context, req *channeltypes.QueryFrozenChannelsRequest) (*channeltypes.QueryFrozenChannelsResponse, error) {
+	return q.ChannelKeeper.FrozenChannels(c, req)
+}
+
+// ChannelStatistics implements the IBC QueryServer interface
+func (q Keeper) ChannelStatistics(c context.Context, req *channeltypes.QueryChannelStatisticsRequest) (*channeltypes.QueryChannelStatisticsResponse, error) {
+	return q.ChannelKeeper.ChannelStatistics(c, req)
+}
+
+// PacketStatus implements the IBC QueryServer interface
+func (q Keeper) PacketStatus(c context.Context, req *channeltypes.QueryPacketStatusRequest) (*channeltypes.QueryPacketStatusResponse, error) {
+	return q.ChannelKeeper.PacketStatus(c, req)
+}
+
+// PacketStatuses implements the IBC QueryServer interface
+func (q Keeper) PacketStatuses(c context.Context, req *channeltypes.QueryPacketStatusesRequest) (*channeltypes.QueryPacketStatusesResponse, error) {
+	return q.ChannelKeeper.PacketStatuses(c, req)
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
+	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -30,3 +31,17 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration initializes the packet lifecycle statistics of every channel.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	channelMigrator := channelkeeper.NewMigrator(m.keeper.ChannelKeeper)
+	return channelMigrator.Migrate2to3(ctx)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// This migration initializes the pruning sequence start of every channel which has never been upgraded.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	channelMigrator := channelkeeper.NewMigrator(m.keeper.ChannelKeeper)
+	return channelMigrator.Migrate3to4(ctx)
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
 )
 
@@ -357,7 +359,7 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 		return nil, sdkerrors.Wrap(err, "channel close confirm callback failed")
 	}
 
-	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofInit, msg.ProofHeight)
+	err = k.ChannelKeeper.ChanCloseConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofInit, msg.ProofHeight, msg.CounterpartyUpgradeSequence)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "channel handshake close confirm failed")
 	}
@@ -401,29 +403,109 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+	case channeltypes.ErrTimeoutReceiptWritten:
+		// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel and is not executed
+		writeFn()
+		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.FAILURE}, nil
 	default:
 		return nil, sdkerrors.Wrap(err, "receive packet verification failed")
 	}
 
+	if err := k.executeRecvPacket(ctx, cbs, cap, msg.Packet, relayer); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// RecvPackets defines a rpc handler method for MsgRecvPackets.
+func (k Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	// Lookup module by channel capability, all packets of the batch share the same destination
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packets[0].DestinationPort, msg.Packets[0].DestinationChannel)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	packets := make([]exported.PacketI, len(msg.Packets))
+	for i := range msg.Packets {
+		packets[i] = msg.Packets[i]
+	}
+
+	// Perform TAO verification of the whole batch
+	//
+	// Packets already received are a no-op
+	recvErrs, err := k.ChannelKeeper.RecvPackets(ctx, cap, packets, msg.ProofCommitments, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "receive packets verification failed")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		switch recvErrs[i] {
+		case nil:
+			if err := k.executeRecvPacket(ctx, cbs, cap, packet, relayer); err != nil {
+				return nil, err
+			}
+			results[i] = channeltypes.SUCCESS
+		case channeltypes.ErrNoOpMsg:
+			results[i] = channeltypes.NOOP
+		case channeltypes.ErrTimeoutReceiptWritten:
+			// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel and is not executed
+			results[i] = channeltypes.FAILURE
+		}
+	}
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// executeRecvPacket performs the application logic callback of a received packet and writes
+// its acknowledgement.
+func (k Keeper) executeRecvPacket(ctx sdk.Context, cbs porttypes.IBCModule, cap *capabilitytypes.Capability, packet channeltypes.Packet, relayer sdk.AccAddress) error {
 	// Perform application logic callback
 	//
 	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
-	if ack == nil || ack.Success() {
-		// write application state changes for asynchronous and successful acknowledgements
-		writeFn()
+	recvPacket := func(ctx sdk.Context) exported.Acknowledgement {
+		cacheCtx, writeFn := ctx.CacheContext()
+		ack := cbs.OnRecvPacket(cacheCtx, packet, relayer)
+		if ack == nil || ack.Success() {
+			// write application state changes for asynchronous and successful acknowledgements
+			writeFn()
+		}
+
 		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 		// Events from callback are emitted regardless of acknowledgement success
 		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		return ack
+	}
+
+	// applications wrapping the receipt of packets write state outside of the cached context of the callback
+	var ack exported.Acknowledgement
+	if wrapper, ok := cbs.(porttypes.RecvPacketWrapper); ok {
+		ack = wrapper.WrapRecvPacket(ctx, packet, relayer, recvPacket)
+	} else {
+		ack = recvPacket(ctx)
 	}
 
 	// Set packet acknowledgement only if the acknowledgement is not nil.
 	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 	// acknowledgement is nil.
 	if ack != nil {
-		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, cap, msg.Packet, ack); err != nil {
-			return nil, err
+		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, cap, packet, ack); err != nil {
+			return err
 		}
 	}
 
@@ -432,15 +514,15 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 			[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
 			1,
 			[]metrics.Label{
-				telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-				telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-				telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-				telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 			},
 		)
 	}()
 
-	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+	return nil
 }
 
 // Timeout defines a rpc handler method for MsgTimeout.
@@ -537,7 +619,7 @@ func (k Keeper) TimeoutOnClose(goCtx context.Context, msg *channeltypes.MsgTimeo
 	// If the timeout was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.TimeoutOnClose(cacheCtx, cap, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv)
+	err = k.ChannelKeeper.TimeoutOnClose(cacheCtx, cap, msg.Packet, msg.ProofUnreceived, msg.ProofClose, msg.ProofHeight, msg.NextSequenceRecv, msg.CounterpartyUpgradeSequence)
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
@@ -622,10 +704,68 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 		return nil, sdkerrors.Wrap(err, "acknowledge packet verification failed")
 	}
 
-	// Perform application logic callback
-	err = cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer)
+	if err := k.executeAcknowledgement(ctx, cbs, msg.Packet, msg.Acknowledgement, relayer); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// Acknowledgements defines a rpc handler method for MsgAcknowledgements.
+func (k Keeper) Acknowledgements(goCtx context.Context, msg *channeltypes.MsgAcknowledgements) (*channeltypes.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "acknowledge packet callback failed")
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	// Lookup module by channel capability, all packets of the batch share the same source
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packets[0].SourcePort, msg.Packets[0].SourceChannel)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	packets := make([]exported.PacketI, len(msg.Packets))
+	for i := range msg.Packets {
+		packets[i] = msg.Packets[i]
+	}
+
+	// Perform TAO verification of the whole batch
+	//
+	// Acknowledgements already received are a no-op
+	ackErrs, err := k.ChannelKeeper.AcknowledgePackets(ctx, cap, packets, msg.Acknowledgements, msg.ProofAcked, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "acknowledge packets verification failed")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		if ackErrs[i] == channeltypes.ErrNoOpMsg {
+			results[i] = channeltypes.NOOP
+			continue
+		}
+
+		if err := k.executeAcknowledgement(ctx, cbs, packet, msg.Acknowledgements[i], relayer); err != nil {
+			return nil, err
+		}
+		results[i] = channeltypes.SUCCESS
+	}
+
+	return &channeltypes.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// executeAcknowledgement performs the application logic callback of an acknowledged packet.
+func (k Keeper) executeAcknowledgement(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	// Perform application logic callback
+	if err := cbs.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return sdkerrors.Wrap(err, "acknowledge packet callback failed")
 	}
 
 	defer func() {
@@ -633,13 +773,227 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 			[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
 			1,
 			[]metrics.Label{
-				telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-				telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-				telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-				telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 			},
 		)
 	}()
 
-	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
+	return nil
+}
+
+// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
+func (k Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTry) (*channeltypes.MsgChannelUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	channel, upgrade, err := k.ChannelKeeper.ChanUpgradeTry(ctx, msg.PortId, msg.ChannelId, msg.ProposedUpgradeConnectionHops, msg.CounterpartyUpgradeFields, msg.CounterpartyUpgradeSequence, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade try failed", "error", sdkerrors.Wrap(err, "channel upgrade try failed"))
+		if channeltypes.IsUpgradeError(err) {
+			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subsequently.
+			return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.FAILURE}, nil
+		}
+
+		return nil, sdkerrors.Wrap(err, "channel upgrade try failed")
+	}
+
+	// NOTE: the application callback is executed on a cached context which is discarded,
+	// application state may only be migrated once the upgrade has completed.
+	cacheCtx, _ := ctx.CacheContext()
+	upgradeVersion, err := cbs.OnChanUpgradeTry(cacheCtx, msg.PortId, msg.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, msg.CounterpartyUpgradeFields.Version)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade try callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+		return &channeltypes.MsgChannelUpgradeTryResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	channel, upgrade = k.ChannelKeeper.WriteUpgradeTryChannel(ctx, msg.PortId, msg.ChannelId, upgrade, upgradeVersion)
+
+	return &channeltypes.MsgChannelUpgradeTryResponse{Upgrade: upgrade, UpgradeSequence: channel.UpgradeSequence, Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeAck defines a rpc handler method for MsgChannelUpgradeAck.
+func (k Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeAck) (*channeltypes.MsgChannelUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	err = k.ChannelKeeper.ChanUpgradeAck(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade ack failed", "error", sdkerrors.Wrap(err, "channel upgrade ack failed"))
+		if channeltypes.IsUpgradeError(err) {
+			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subsequently.
+			return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+		}
+
+		return nil, sdkerrors.Wrap(err, "channel upgrade ack failed")
+	}
+
+	// NOTE: the application callback is executed on a cached context which is discarded,
+	// application state may only be migrated once the upgrade has completed.
+	cacheCtx, _ := ctx.CacheContext()
+	if err := cbs.OnChanUpgradeAck(cacheCtx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade.Fields.Version); err != nil {
+		ctx.Logger().Error("channel upgrade ack callback failed", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+		return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+	}
+
+	k.ChannelKeeper.WriteUpgradeAckChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
+
+	return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeConfirm defines a rpc handler method for MsgChannelUpgradeConfirm.
+func (k Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeConfirm) (*channeltypes.MsgChannelUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	err = k.ChannelKeeper.ChanUpgradeConfirm(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgrade, msg.ProofChannel, msg.ProofUpgrade, msg.ProofHeight)
+	if err != nil {
+		ctx.Logger().Error("channel upgrade confirm failed", "error", sdkerrors.Wrap(err, "channel upgrade confirm failed"))
+		if channeltypes.IsUpgradeError(err) {
+			k.ChannelKeeper.MustAbortUpgrade(ctx, msg.PortId, msg.ChannelId, err)
+
+			// NOTE: a FAILURE result is returned to the client and an error receipt is written to state.
+			// This signals to the relayer to begin the cancel upgrade handshake subsequently.
+			return &channeltypes.MsgChannelUpgradeConfirmResponse{Result: channeltypes.FAILURE}, nil
+		}
+
+		return nil, sdkerrors.Wrap(err, "channel upgrade confirm failed")
+	}
+
+	channel := k.ChannelKeeper.WriteUpgradeConfirmChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyUpgrade)
+
+	// move channel to OPEN state if both chains have finished flushing in-flight packets.
+	// Counterparty channel state has been verified in ChanUpgradeConfirm.
+	if channel.State == channeltypes.FLUSHCOMPLETE && msg.CounterpartyChannelState == channeltypes.FLUSHCOMPLETE {
+		channel = k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
+		cbs.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, channel.Ordering, channel.ConnectionHops, channel.Version)
+	}
+
+	return &channeltypes.MsgChannelUpgradeConfirmResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeOpen defines a rpc handler method for MsgChannelUpgradeOpen.
+func (k Keeper) ChannelUpgradeOpen(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeOpen) (*channeltypes.MsgChannelUpgradeOpenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	if err = k.ChannelKeeper.ChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannelState, msg.CounterpartyUpgradeSequence, msg.ProofChannel, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade open failed")
+	}
+
+	channel := k.ChannelKeeper.WriteUpgradeOpenChannel(ctx, msg.PortId, msg.ChannelId)
+	cbs.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, channel.Ordering, channel.ConnectionHops, channel.Version)
+
+	return &channeltypes.MsgChannelUpgradeOpenResponse{}, nil
+}
+
+// ChannelUpgradeTimeout defines a rpc handler method for MsgChannelUpgradeTimeout.
+func (k Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTimeout) (*channeltypes.MsgChannelUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ChannelKeeper.ChanUpgradeTimeout(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade timeout failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeTimeoutChannel(ctx, msg.PortId, msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeTimeoutResponse{}, nil
+}
+
+// ChannelUpgradeCancel defines a rpc handler method for MsgChannelUpgradeCancel.
+func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeCancel) (*channeltypes.MsgChannelUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ChannelKeeper.ChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt, msg.ProofErrorReceipt, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade cancel failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeCancelChannel(ctx, msg.PortId, msg.ChannelId, msg.ErrorReceipt.Sequence)
+
+	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
+}
+
+// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalPruned, totalRemaining, err := k.ChannelKeeper.PruneAcknowledgements(ctx, msg.PortId, msg.ChannelId, msg.Limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "prune acknowledgements failed")
+	}
+
+	return &channeltypes.MsgPruneAcknowledgementsResponse{
+		TotalPrunedSequences:    totalPruned,
+		TotalRemainingSequences: totalRemaining,
+	}, nil
+}
+
+// AdvanceTimeoutHorizon defines a rpc handler method for MsgAdvanceTimeoutHorizon.
+func (k Keeper) AdvanceTimeoutHorizon(goCtx context.Context, msg *channeltypes.MsgAdvanceTimeoutHorizon) (*channeltypes.MsgAdvanceTimeoutHorizonResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	timeoutHorizon, err := k.ChannelKeeper.AdvanceTimeoutHorizon(ctx, msg.PortId, msg.ChannelId, msg.ProofsCommitmentAbsence, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "advance timeout horizon failed")
+	}
+
+	return &channeltypes.MsgAdvanceTimeoutHorizonResponse{
+		TimeoutHorizon: timeoutHorizon,
+	}, nil
 }
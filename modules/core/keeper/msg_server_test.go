@@ -89,6 +89,15 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 			err := path.EndpointA.SendPacket(packet)
 			suite.Require().NoError(err)
 		}, true, false},
+		{"success: ORDERED_ALLOW_TIMEOUT - timed-out packet is not executed", func() {
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+			async = true // no acknowledgement is written for the timeout receipt
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+		}, true, true},
 		{"failure: ORDERED out of order packet", func() {
 			path.SetChannelOrdered()
 			suite.coordinator.Setup(path)
@@ -192,6 +201,125 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler receiving a batch of packets proven by a single batch proof on
+// ordered and unordered channels. It verifies the result returned for each packet and
+// that acknowledgements are only written for executed packets. More rigorous testing of
+// 'RecvPackets' can be found in the 04-channel/keeper/packet_test.go.
+func (suite *KeeperTestSuite) TestHandleRecvPackets() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	sendPacket := func(data []byte, sequence uint64, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) {
+		packet := channeltypes.NewPacket(data, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, timeoutTimestamp)
+		suite.Require().NoError(path.EndpointA.SendPacket(packet))
+		packets = append(packets, packet)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: ORDERED", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			for i := uint64(1); i <= 3; i++ {
+				sendPacket(ibctesting.MockPacketData, i, timeoutHeight, 0)
+			}
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: UNORDERED", func() {
+			suite.coordinator.Setup(path)
+			for i := uint64(1); i <= 3; i++ {
+				sendPacket(ibctesting.MockPacketData, i, timeoutHeight, 0)
+			}
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: OnRecvPacket callback returns revert=true", func() {
+			suite.coordinator.Setup(path)
+			sendPacket(ibctesting.MockPacketData, 1, timeoutHeight, 0)
+			sendPacket(ibctesting.MockFailPacketData, 2, timeoutHeight, 0)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT - timed-out packet is not executed", func() {
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+			sendPacket(ibctesting.MockPacketData, 1, clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+			sendPacket(ibctesting.MockPacketData, 2, timeoutHeight, 0)
+			expResults = []channeltypes.ResponseResultType{channeltypes.FAILURE, channeltypes.SUCCESS}
+		}, true},
+		{"successful no-op: packet already received (replay)", func() {
+			// mock will panic if application callback is called twice on the same packet
+			suite.coordinator.Setup(path)
+			for i := uint64(1); i <= 3; i++ {
+				sendPacket(ibctesting.MockPacketData, i, timeoutHeight, 0)
+			}
+			suite.Require().NoError(path.EndpointB.RecvPacket(packets[1]))
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS}
+		}, true},
+		{"failure: ORDERED out of order packet", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			sendPacket(ibctesting.MockPacketData, 1, timeoutHeight, 0)
+			sendPacket(ibctesting.MockPacketData, 2, timeoutHeight, 0)
+			packets[0], packets[1] = packets[1], packets[0]
+		}, false},
+		{"failure: packet commitment not proven by the batch proof", func() {
+			suite.coordinator.Setup(path)
+			sendPacket(ibctesting.MockPacketData, 1, timeoutHeight, 0)
+			sendPacket(ibctesting.MockPacketData, 2, timeoutHeight, 0)
+			packets[1].Data = ibctesting.MockFailPacketData
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			packets = nil     // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			// get batch proof of packet commitments from chainA
+			packetKeys := make([][]byte, len(packets))
+			for i, packet := range packets {
+				packetKeys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			}
+			proof, proofHeight := suite.chainA.QueryProofs(packetKeys...)
+
+			msg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// replay should not fail since every packet will be treated as a no-op
+				res, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+				suite.Require().NoError(err)
+				for _, result := range res.Results {
+					suite.Require().Equal(channeltypes.NOOP, result)
+				}
+
+				// verify that acks were only written for executed packets
+				for i, packet := range packets {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().Equal(expResults[i] != channeltypes.FAILURE, found)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -338,6 +466,107 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 	}
 }
 
+// tests the IBC handler acknowledging a batch of packets proven by a single batch proof on
+// ordered and unordered channels. It verifies the result returned for each packet and that
+// the packet commitments are deleted. More rigorous testing of 'AcknowledgePackets' can be
+// found in the 04-channel/keeper/packet_test.go.
+func (suite *KeeperTestSuite) TestHandleAcknowledgePackets() {
+	var (
+		packets    []channeltypes.Packet
+		acks       [][]byte
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	relayPackets := func(n uint64) {
+		for i := uint64(1); i <= n; i++ {
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, i, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			suite.Require().NoError(path.EndpointA.SendPacket(packet))
+			suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+			packets = append(packets, packet)
+			acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: ORDERED", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: UNORDERED", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"successful no-op: packet already acknowledged (replay)", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(3)
+			suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[2], acks[2]))
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.NOOP}
+		}, true},
+		{"failure: ORDERED out of order acknowledgement", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			relayPackets(2)
+			packets[0], packets[1] = packets[1], packets[0]
+		}, false},
+		{"failure: acknowledgement not proven by the batch proof", func() {
+			suite.coordinator.Setup(path)
+			relayPackets(2)
+			acks[0] = ibcmock.MockFailAcknowledgement.Acknowledgement()
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()        // reset
+			packets, acks = nil, nil // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			tc.malleate()
+
+			// get batch proof of acknowledgements from chainB
+			packetKeys := make([][]byte, len(packets))
+			for i, packet := range packets {
+				packetKeys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			}
+			proof, proofHeight := suite.chainB.QueryProofs(packetKeys...)
+
+			msg := channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// replay should not fail since every acknowledgement will be treated as a no-op
+				res, err := keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+				for _, result := range res.Results {
+					suite.Require().Equal(channeltypes.NOOP, result)
+				}
+
+				for _, packet := range packets {
+					pc := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().Nil(pc)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // tests the IBC handler timing out a packet on ordered and unordered channels.
 // It verifies that the deletion of a packet commitment occurs. It tests
 // high level properties like ordering and basic sanity checks. More
@@ -602,7 +831,7 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			proofClosed, _ := suite.chainB.QueryProof(channelKey)
 
-			msg := channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+			msg := channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, proofClosed, proofHeight, suite.chainA.SenderAccount.GetAddress().String(), 0)
 
 			_, err := keeper.Keeper.TimeoutOnClose(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
 
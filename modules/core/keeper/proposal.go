@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+// HandleChannelUpgradeProposal initializes the channel upgrade handshake for the channel
+// specified in the proposal. The proposed upgrade fields are validated by core IBC and
+// the application bound to the channel before the upgrade is written to state.
+// A previous upgrade attempt which has not yet completed is replaced.
+func (k Keeper) HandleChannelUpgradeProposal(ctx sdk.Context, p *channeltypes.ChannelUpgradeProposal) error {
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, p.PortId, p.ChannelId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	upgrade, err := k.ChannelKeeper.ChanUpgradeInit(ctx, p.PortId, p.ChannelId, p.Fields)
+	if err != nil {
+		return sdkerrors.Wrap(err, "channel upgrade init failed")
+	}
+
+	// NOTE: the application callback is executed on a cached context which is discarded,
+	// application state may only be migrated once the upgrade has completed.
+	cacheCtx, _ := ctx.CacheContext()
+	upgradeVersion, err := cbs.OnChanUpgradeInit(cacheCtx, p.PortId, p.ChannelId, upgrade.Fields.Ordering, upgrade.Fields.ConnectionHops, upgrade.Fields.Version)
+	if err != nil {
+		return sdkerrors.Wrap(err, "channel upgrade init callback failed")
+	}
+
+	k.ChannelKeeper.WriteUpgradeInitChannel(ctx, p.PortId, p.ChannelId, upgrade, upgradeVersion)
+
+	return nil
+}
+
+// HandleChannelCloseProposal force-closes the channel specified in the proposal. The
+// OnChanCloseConfirm callback of the application bound to the channel is executed so the
+// application may clean up any state associated with the channel.
+func (k Keeper) HandleChannelCloseProposal(ctx sdk.Context, p *channeltypes.ChannelCloseProposal) error {
+	// Lookup module by channel capability
+	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, p.PortId, p.ChannelId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	if err := k.ChannelKeeper.ChanForceClose(ctx, p.PortId, p.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "channel force close failed")
+	}
+
+	// NOTE: the application callback is executed on a cached context which is only
+	// written if the callback succeeds.
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := cbs.OnChanCloseConfirm(cacheCtx, p.PortId, p.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "channel force close callback failed")
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.ChannelKeeper.WriteForceCloseChannel(ctx, p.PortId, p.ChannelId)
+
+	return nil
+}
+
+// HandleChannelFreezeProposal freezes the channel specified in the proposal.
+func (k Keeper) HandleChannelFreezeProposal(ctx sdk.Context, p *channeltypes.ChannelFreezeProposal) error {
+	return k.ChannelKeeper.FreezeChannel(ctx, p.PortId, p.ChannelId)
+}
+
+// HandleChannelUnfreezeProposal unfreezes the channel specified in the proposal.
+func (k Keeper) HandleChannelUnfreezeProposal(ctx sdk.Context, p *channeltypes.ChannelUnfreezeProposal) error {
+	return k.ChannelKeeper.UnfreezeChannel(ctx, p.PortId, p.ChannelId)
+}
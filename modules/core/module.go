@@ -21,6 +21,7 @@ import (
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/client/cli"
@@ -149,6 +150,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	m := clientkeeper.NewMigrator(am.keeper.ClientKeeper)
 	cfg.RegisterMigration(host.ModuleName, 1, m.Migrate1to2)
+
+	km := keeper.NewMigrator(*am.keeper)
+	cfg.RegisterMigration(host.ModuleName, 2, km.Migrate2to3)
+	cfg.RegisterMigration(host.ModuleName, 3, km.Migrate3to4)
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -181,11 +186,12 @@ func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec) <-
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	ibcclient.BeginBlocker(ctx, am.keeper.ClientKeeper)
+	channel.BeginBlocker(ctx, am.keeper.ChannelKeeper)
 }
 
 // EndBlock returns the end blocker for the ibc module. It returns no validator
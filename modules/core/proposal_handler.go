@@ -0,0 +1,33 @@
+package ibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+)
+
+// NewChannelProposalHandler defines the 04-channel proposal handler. A pointer to the IBC keeper
+// is used as the router of application callbacks is only set once the app has been constructed.
+func NewChannelProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *channeltypes.ChannelUpgradeProposal:
+			return k.HandleChannelUpgradeProposal(ctx, c)
+
+		case *channeltypes.ChannelCloseProposal:
+			return k.HandleChannelCloseProposal(ctx, c)
+
+		case *channeltypes.ChannelFreezeProposal:
+			return k.HandleChannelFreezeProposal(ctx, c)
+
+		case *channeltypes.ChannelUnfreezeProposal:
+			return k.HandleChannelUnfreezeProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc proposal content type: %T", c)
+		}
+	}
+}
@@ -248,6 +248,83 @@ func (cs *ClientState) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the proposed upgrade of the
+// specified channel end, under the specified port, stored on the solo machine.
+func (cs *ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	upgradePath := commitmenttypes.NewMerklePath(host.ChannelUpgradePath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, upgradePath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := ChannelUpgradeSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, upgrade)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the error receipt of the last
+// failed upgrade attempt of the specified channel end, under the specified
+// port, stored on the solo machine.
+func (cs *ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	errorReceiptPath := commitmenttypes.NewMerklePath(host.ChannelUpgradeErrorPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, errorReceiptPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := ChannelUpgradeErrorSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, errorReceipt)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (cs *ClientState) VerifyPacketCommitment(
@@ -332,6 +409,36 @@ func (cs *ClientState) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketCommitments returns an error as solo machine proofs are signatures over
+// a single path and value, which cannot be batched.
+func (cs *ClientState) VerifyPacketCommitments(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, map[uint64][]byte,
+) error {
+	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are not supported by solo machine clients")
+}
+
+// VerifyPacketAcknowledgements returns an error as solo machine proofs are signatures over
+// a single path and value, which cannot be batched.
+func (cs *ClientState) VerifyPacketAcknowledgements(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, map[uint64][]byte,
+) error {
+	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are not supported by solo machine clients")
+}
+
+// VerifyPacketCommitmentAbsence returns an error as proofs of the absence of a packet
+// commitment are not supported by solo machine clients.
+func (cs *ClientState) VerifyPacketCommitmentAbsence(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, uint64,
+) error {
+	return sdkerrors.Wrap(ErrInvalidProof, "packet commitment absence proofs are not supported by solo machine clients")
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
@@ -374,6 +481,48 @@ func (cs *ClientState) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs *ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	receipt []byte,
+) error {
+	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	receiptPath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, packetSequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, receiptPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := PacketReceiptSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, receipt)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (cs *ClientState) VerifyNextSequenceRecv(
@@ -690,6 +690,82 @@ func (suite *SoloMachineTestSuite) TestVerifyPacketAcknowledgement() {
 	}
 }
 
+func (suite *SoloMachineTestSuite) TestVerifyPacketReceipt() {
+	receipt := channeltypes.PacketTimeoutReceipt
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		path := solomachine.GetPacketReceiptPath(testPortID, testChannelID)
+
+		value, err := types.PacketReceiptSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, receipt)
+		suite.Require().NoError(err)
+
+		sig := solomachine.GenerateSignature(value)
+		signatureDoc := &types.TimestampedSignatureData{
+			SignatureData: sig,
+			Timestamp:     solomachine.Time,
+		}
+
+		proof, err := suite.chainA.Codec.Marshal(signatureDoc)
+		suite.Require().NoError(err)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			prefix      exported.Prefix
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientState(),
+				prefix,
+				proof,
+				true,
+			},
+			{
+				"ApplyPrefix failed",
+				solomachine.ClientState(),
+				commitmenttypes.NewMerklePrefix([]byte{}),
+				proof,
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientState(),
+				prefix,
+				nil,
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientState(),
+				prefix,
+				suite.GetInvalidProof(),
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+			ctx := suite.chainA.GetContext()
+
+			err := tc.clientState.VerifyPacketReceipt(
+				ctx, suite.store, suite.chainA.Codec, solomachine.GetHeight(), 0, 0, tc.prefix, tc.proof, testPortID, testChannelID, solomachine.Sequence, receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
+
 func (suite *SoloMachineTestSuite) TestVerifyPacketReceiptAbsence() {
 	// test singlesig and multisig public keys
 	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
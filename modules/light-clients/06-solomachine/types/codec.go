@@ -117,6 +117,14 @@ func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte)
 
 		return receiptAbsenceData, nil
 
+	case PACKETRECEIPT:
+		receiptData := &PacketReceiptData{}
+		if err := cdc.Unmarshal(data, receiptData); err != nil {
+			return nil, err
+		}
+
+		return receiptData, nil
+
 	case NEXTSEQUENCERECV:
 		nextSeqRecvData := &NextSequenceRecvData{}
 		if err := cdc.Unmarshal(data, nextSeqRecvData); err != nil {
@@ -125,6 +133,22 @@ func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte)
 
 		return nextSeqRecvData, nil
 
+	case CHANNELUPGRADE:
+		channelUpgradeData := &ChannelUpgradeData{}
+		if err := cdc.Unmarshal(data, channelUpgradeData); err != nil {
+			return nil, err
+		}
+
+		return channelUpgradeData, nil
+
+	case CHANNELUPGRADEERROR:
+		channelUpgradeErrorData := &ChannelUpgradeErrorData{}
+		if err := cdc.Unmarshal(data, channelUpgradeErrorData); err != nil {
+			return nil, err
+		}
+
+		return channelUpgradeErrorData, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(ErrInvalidDataType, "unsupported data type %T", dataType)
 	}
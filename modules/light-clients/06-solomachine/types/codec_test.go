@@ -146,6 +146,14 @@ func (suite SoloMachineTestSuite) TestUnmarshalDataByType() {
 					suite.Require().NoError(err)
 				}, true,
 			},
+			{
+				"packet receipt", types.PACKETRECEIPT, func() {
+					path := solomachine.GetPacketReceiptPath("portID", "channelID")
+
+					data, err = types.PacketReceiptDataBytes(cdc, path, []byte{byte(2)})
+					suite.Require().NoError(err)
+				}, true,
+			},
 			{
 				"next sequence recv", types.NEXTSEQUENCERECV, func() {
 					path := solomachine.GetNextSequenceRecvPath("portID", "channelID")
@@ -299,6 +299,110 @@ func ChannelStateDataBytes(
 	return dataBz, nil
 }
 
+// ChannelUpgradeSignBytes returns the sign bytes for verification of the
+// channel upgrade.
+func ChannelUpgradeSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	path commitmenttypes.MerklePath,
+	upgrade exported.UpgradeI,
+) ([]byte, error) {
+	dataBz, err := ChannelUpgradeDataBytes(cdc, path, upgrade)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    CHANNELUPGRADE,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// ChannelUpgradeDataBytes returns the channel upgrade data bytes used in constructing
+// SignBytes.
+func ChannelUpgradeDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	upgrade exported.UpgradeI,
+) ([]byte, error) {
+	channelUpgrade, ok := upgrade.(channeltypes.Upgrade)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			channeltypes.ErrInvalidUpgrade,
+			"expected upgrade type %T, got %T", channeltypes.Upgrade{}, upgrade)
+	}
+
+	data := &ChannelUpgradeData{
+		Path:    []byte(path.String()),
+		Upgrade: &channelUpgrade,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
+
+// ChannelUpgradeErrorSignBytes returns the sign bytes for verification of the
+// channel upgrade error receipt.
+func ChannelUpgradeErrorSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	path commitmenttypes.MerklePath,
+	errorReceipt exported.ErrorReceiptI,
+) ([]byte, error) {
+	dataBz, err := ChannelUpgradeErrorDataBytes(cdc, path, errorReceipt)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    CHANNELUPGRADEERROR,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// ChannelUpgradeErrorDataBytes returns the channel upgrade error receipt data
+// bytes used in constructing SignBytes.
+func ChannelUpgradeErrorDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	errorReceipt exported.ErrorReceiptI,
+) ([]byte, error) {
+	receipt, ok := errorReceipt.(channeltypes.ErrorReceipt)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			channeltypes.ErrInvalidUpgradeErrorReceipt,
+			"expected error receipt type %T, got %T", channeltypes.ErrorReceipt{}, errorReceipt)
+	}
+
+	data := &ChannelUpgradeErrorData{
+		Path:         []byte(path.String()),
+		ErrorReceipt: &receipt,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
+
 // PacketCommitmentSignBytes returns the sign bytes for verification of the
 // packet commitment.
 func PacketCommitmentSignBytes(
@@ -431,6 +535,51 @@ func PacketReceiptAbsenceDataBytes(
 	return dataBz, nil
 }
 
+// PacketReceiptSignBytes returns the sign bytes for verification of a
+// packet receipt.
+func PacketReceiptSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	path commitmenttypes.MerklePath,
+	receipt []byte,
+) ([]byte, error) {
+	dataBz, err := PacketReceiptDataBytes(cdc, path, receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    PACKETRECEIPT,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// PacketReceiptDataBytes returns the packet receipt data bytes used in
+// constructing SignBytes.
+func PacketReceiptDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	receipt []byte,
+) ([]byte, error) {
+	data := &PacketReceiptData{
+		Path:    []byte(path.String()),
+		Receipt: receipt,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
+
 // NextSequenceRecvSignBytes returns the sign bytes for verification of the next
 // sequence to be received.
 func NextSequenceRecvSignBytes(
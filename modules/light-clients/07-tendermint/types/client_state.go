@@ -352,6 +352,88 @@ func (cs ClientState) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the proposed upgrade of the
+// specified channel end, under the specified port, stored on the target
+// machine.
+func (cs ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	upgradePath := commitmenttypes.NewMerklePath(host.ChannelUpgradePath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, upgradePath)
+	if err != nil {
+		return err
+	}
+
+	channelUpgrade, ok := upgrade.(channeltypes.Upgrade)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid upgrade type %T", upgrade)
+	}
+
+	bz, err := cdc.Marshal(&channelUpgrade)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, bz); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the error receipt of the last
+// failed upgrade attempt of the specified channel end, under the specified
+// port, stored on the target machine.
+func (cs ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	errorReceiptPath := commitmenttypes.NewMerklePath(host.ChannelUpgradeErrorPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, errorReceiptPath)
+	if err != nil {
+		return err
+	}
+
+	receipt, ok := errorReceipt.(channeltypes.ErrorReceipt)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid error receipt type %T", errorReceipt)
+	}
+
+	bz, err := cdc.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, bz); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (cs ClientState) VerifyPacketCommitment(
@@ -430,6 +512,87 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketCommitments verifies a batch proof of the outgoing packet commitments at the
+// specified port and specified channel, keyed by their sequence.
+func (cs ClientState) VerifyPacketCommitments(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	items := make(map[string][]byte, len(commitments))
+	for sequence, commitmentBytes := range commitments {
+		items[host.PacketCommitmentPath(portID, channelID, sequence)] = commitmentBytes
+	}
+
+	return cs.verifyBatchMembership(ctx, store, cdc, height, delayTimePeriod, delayBlockPeriod, prefix, proof, items)
+}
+
+// VerifyPacketAcknowledgements verifies a batch proof of the incoming packet acknowledgements
+// at the specified port and specified channel, keyed by their sequence.
+func (cs ClientState) VerifyPacketAcknowledgements(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	acknowledgements map[uint64][]byte,
+) error {
+	items := make(map[string][]byte, len(acknowledgements))
+	for sequence, acknowledgement := range acknowledgements {
+		items[host.PacketAcknowledgementPath(portID, channelID, sequence)] = channeltypes.CommitAcknowledgement(acknowledgement)
+	}
+
+	return cs.verifyBatchMembership(ctx, store, cdc, height, delayTimePeriod, delayBlockPeriod, prefix, proof, items)
+}
+
+// verifyBatchMembership verifies a batch proof of the items, keyed by their path under the
+// counterparty commitment prefix.
+func (cs ClientState) verifyBatchMembership(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	items map[string][]byte,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	// check delay period has passed
+	if err := verifyDelayPeriodPassed(ctx, store, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath())
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.BatchVerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, items); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
@@ -469,6 +632,84 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketCommitmentAbsence verifies a proof of the absence of an
+// outgoing packet commitment at the specified port, specified channel, and
+// specified sequence.
+func (cs ClientState) VerifyPacketCommitmentAbsence(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	// check delay period has passed
+	if err := verifyDelayPeriodPassed(ctx, store, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	commitmentPath := commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, sequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, commitmentPath)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	// check delay period has passed
+	if err := verifyDelayPeriodPassed(ctx, store, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	receiptPath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	path, err := commitmenttypes.ApplyPrefix(prefix, receiptPath)
+	if err != nil {
+		return err
+	}
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, receipt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (cs ClientState) VerifyNextSequenceRecv(
@@ -525,6 +525,126 @@ func (suite *TendermintTestSuite) TestVerifyPacketCommitment() {
 	}
 }
 
+// test batch verification of the packet commitments of several packets on chainB with a single
+// proof in the light client on chainA. Sends from chainB to chainA are simulated.
+func (suite *TendermintTestSuite) TestVerifyPacketCommitments() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		prefix           commitmenttypes.MerklePrefix
+		commitments      map[uint64][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			expPass: true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"no commitments", func() {
+				commitments = map[uint64][]byte{}
+			}, false,
+		},
+		{
+			"commitment not proven", func() {
+				commitments[2] = []byte("invalid commitment")
+			}, false,
+		},
+		{
+			"sequence not proven", func() {
+				commitments[3] = commitments[1]
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			commitments = make(map[uint64][]byte)
+			var packetKeys [][]byte
+			for seq := uint64(1); seq <= 2; seq++ {
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+				err := path.EndpointB.SendPacket(packet)
+				suite.Require().NoError(err)
+
+				commitments[seq] = channeltypes.CommitPacket(suite.chainA.App.GetIBCKeeper().Codec(), packet)
+				packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make batch proof of the packet commitments
+			proof, proofHeight = suite.chainB.QueryProofs(packetKeys...)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err := clientState.VerifyPacketCommitments(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, commitments,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // test verification of the acknowledgement on chainB being represented
 // in the light client on chainA. A send and ack from chainA to chainB
 // is simulated.
@@ -644,6 +764,132 @@ func (suite *TendermintTestSuite) TestVerifyPacketAcknowledgement() {
 	}
 }
 
+// test verification of the packet receipt on chainB being represented
+// in the light client on chainA. A send and receive from chainA to chainB
+// is simulated.
+func (suite *TendermintTestSuite) TestVerifyPacketReceipt() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		prefix           commitmenttypes.MerklePrefix
+		receipt          []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			expPass: true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			name: "delay block period has passed",
+			malleate: func() {
+				delayBlockPeriod = 1
+			},
+			expPass: true,
+		},
+		{
+			name: "delay block period has not passed",
+			malleate: func() {
+				delayBlockPeriod = 10
+			},
+			expPass: false,
+		},
+
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"receipt mismatch", func() {
+				receipt = channeltypes.PacketTimeoutReceipt
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// send packet
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// write receipt and ack
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = suite.chainB.GetPrefix()
+			receipt = []byte{byte(1)}
+
+			// make packet receipt proof
+			receiptKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight = path.EndpointB.QueryProof(receiptKey)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err = clientState.VerifyPacketReceipt(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // test verification of the absent acknowledgement on chainB being represented
 // in the light client on chainA. A send from chainB to chainA is simulated, but
 // no receive.
@@ -759,6 +1005,114 @@ func (suite *TendermintTestSuite) TestVerifyPacketReceiptAbsence() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestVerifyPacketCommitmentAbsence() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		prefix           commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			expPass: true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			name: "delay block period has passed",
+			malleate: func() {
+				delayBlockPeriod = 1
+			},
+			expPass: true,
+		},
+		{
+			name: "delay block period has not passed",
+			malleate: func() {
+				delayBlockPeriod = 10
+			},
+			expPass: false,
+		},
+
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = suite.chainB.GetPrefix()
+
+			// make packet commitment absence proof, the packet is never sent
+			commitmentKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight = path.EndpointB.QueryProof(commitmentKey)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err := clientState.VerifyPacketCommitmentAbsence(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // test verification of the next receive sequence on chainB being represented
 // in the light client on chainA. A send and receive from chainB to chainA is
 // simulated.
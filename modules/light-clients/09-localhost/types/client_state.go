@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -214,6 +215,83 @@ func (cs ClientState) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelUpgrade verifies a proof of the proposed upgrade of the
+// specified channel end, under the specified port, stored on the local machine.
+func (cs ClientState) VerifyChannelUpgrade(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	_ exported.Height,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	upgrade exported.UpgradeI,
+) error {
+	path := host.ChannelUpgradeKey(portID, channelID)
+	bz := store.Get(path)
+	if bz == nil {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedChannelUpgradeVerification, "not found for path %s", path)
+	}
+
+	channelUpgrade, ok := upgrade.(channeltypes.Upgrade)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid upgrade type %T", upgrade)
+	}
+
+	upgradeBz, err := cdc.Marshal(&channelUpgrade)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(bz, upgradeBz) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedChannelUpgradeVerification,
+			"upgrade ≠ previous stored upgrade: \n%X\n≠\n%X", upgradeBz, bz,
+		)
+	}
+
+	return nil
+}
+
+// VerifyChannelUpgradeError verifies a proof of the error receipt of the last
+// failed upgrade attempt of the specified channel end, under the specified port,
+// stored on the local machine.
+func (cs ClientState) VerifyChannelUpgradeError(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	_ exported.Height,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	errorReceipt exported.ErrorReceiptI,
+) error {
+	path := host.ChannelUpgradeErrorKey(portID, channelID)
+	bz := store.Get(path)
+	if bz == nil {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedChannelUpgradeErrorVerification, "not found for path %s", path)
+	}
+
+	receipt, ok := errorReceipt.(channeltypes.ErrorReceipt)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid error receipt type %T", errorReceipt)
+	}
+
+	receiptBz, err := cdc.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(bz, receiptBz) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedChannelUpgradeErrorVerification,
+			"error receipt ≠ previous stored error receipt: \n%X\n≠\n%X", receiptBz, bz,
+		)
+	}
+
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
 // the specified port, specified channel, and specified sequence.
 func (cs ClientState) VerifyPacketCommitment(
@@ -280,6 +358,60 @@ func (cs ClientState) VerifyPacketAcknowledgement(
 	return nil
 }
 
+// VerifyPacketCommitments verifies the outgoing packet commitments at the specified port and
+// specified channel, keyed by their sequence, in increasing sequence order.
+func (cs ClientState) VerifyPacketCommitments(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	commitments map[uint64][]byte,
+) error {
+	for _, sequence := range sortedSequences(commitments) {
+		if err := cs.VerifyPacketCommitment(
+			ctx, store, cdc, height, delayTimePeriod, delayBlockPeriod, prefix, proof,
+			portID, channelID, sequence, commitments[sequence],
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgements verifies the incoming packet acknowledgements at the specified
+// port and specified channel, keyed by their sequence, in increasing sequence order.
+func (cs ClientState) VerifyPacketAcknowledgements(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	acknowledgements map[uint64][]byte,
+) error {
+	for _, sequence := range sortedSequences(acknowledgements) {
+		if err := cs.VerifyPacketAcknowledgement(
+			ctx, store, cdc, height, delayTimePeriod, delayBlockPeriod, prefix, proof,
+			portID, channelID, sequence, acknowledgements[sequence],
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
 // specified sequence.
@@ -306,6 +438,65 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketCommitmentAbsence verifies a proof of the absence of an
+// outgoing packet commitment at the specified port, specified channel, and
+// specified sequence.
+func (cs ClientState) VerifyPacketCommitmentAbsence(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	path := host.PacketCommitmentKey(portID, channelID, sequence)
+
+	data := store.Get(path)
+	if data != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketCommitmentVerification, "expected no packet commitment")
+	}
+
+	return nil
+}
+
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	path := host.PacketReceiptKey(portID, channelID, sequence)
+
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedPacketReceiptVerification, "not found for path %s", path)
+	}
+
+	if !bytes.Equal(data, receipt) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedPacketReceiptVerification,
+			"receipt ≠ previous stored receipt: \n%X\n≠\n%X", receipt, data,
+		)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (cs ClientState) VerifyNextSequenceRecv(
@@ -338,3 +529,16 @@ func (cs ClientState) VerifyNextSequenceRecv(
 
 	return nil
 }
+
+// sortedSequences returns the sequences of the provided map in increasing order, so that
+// the store is read deterministically.
+func sortedSequences(values map[uint64][]byte) []uint64 {
+	sequences := make([]uint64, 0, len(values))
+	for sequence := range values {
+		sequences = append(sequences, sequence)
+	}
+
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	return sequences
+}
@@ -445,6 +445,65 @@ func (suite *LocalhostTestSuite) TestVerifyPacketAcknowledgement() {
 	}
 }
 
+func (suite *LocalhostTestSuite) TestVerifyPacketReceipt() {
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		malleate    func()
+		receipt     []byte
+		expPass     bool
+	}{
+		{
+			name:        "proof verification success",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.PacketReceiptKey(testPortID, testChannelID, testSequence), []byte("receipt"),
+				)
+			},
+			receipt: []byte("receipt"),
+			expPass: true,
+		},
+		{
+			name:        "proof verification failed: different receipt stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.PacketReceiptKey(testPortID, testChannelID, testSequence), []byte("different"),
+				)
+			},
+			receipt: []byte("receipt"),
+			expPass: false,
+		},
+		{
+			name:        "proof verification failed: no receipt stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate:    func() {},
+			receipt:     []byte("receipt"),
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := tc.clientState.VerifyPacketReceipt(
+				suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, []byte{}, testPortID, testChannelID, testSequence, tc.receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *LocalhostTestSuite) TestVerifyPacketReceiptAbsence() {
 	clientState := types.NewClientState("chainID", clientHeight)
 
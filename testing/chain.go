@@ -223,6 +223,41 @@ func (chain *TestChain) QueryProofAtHeight(key []byte, height int64) ([]byte, cl
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryProofs performs an abci query for each of the given keys and returns the proto encoded
+// merkle proof combining the existence proofs of all keys into a single batch proof, together
+// with the height at which the proof will succeed on a tendermint verifier.
+func (chain *TestChain) QueryProofs(keys ...[]byte) ([]byte, clienttypes.Height) {
+	height := chain.App.LastBlockHeight() - 1
+
+	merkleProofs := make([]commitmenttypes.MerkleProof, len(keys))
+	for i, key := range keys {
+		res := chain.App.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+			Height: height,
+			Data:   key,
+			Prove:  true,
+		})
+
+		merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+		require.NoError(chain.T, err)
+
+		merkleProofs[i] = merkleProof
+	}
+
+	batchProof, err := commitmenttypes.CombineMerkleProofs(merkleProofs)
+	require.NoError(chain.T, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&batchProof)
+	require.NoError(chain.T, err)
+
+	revision := clienttypes.ParseChainID(chain.ChainID)
+
+	// proof height + 1 is returned as the proof created corresponds to the height the proof
+	// was created in the IAVL tree. Tendermint and subsequently the clients that rely on it
+	// have heights 1 above the IAVL tree. Thus we return proof height + 1
+	return proof, clienttypes.NewHeight(revision, uint64(height)+1)
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
@@ -54,6 +54,9 @@ type ChannelConfig struct {
 	PortID  string
 	Version string
 	Order   channeltypes.Order
+
+	// ProposedUpgrade defines the upgrade used by the endpoint in the channel upgrade handshake.
+	ProposedUpgrade channeltypes.Upgrade
 }
 
 func NewChannelConfig() *ChannelConfig {
@@ -358,6 +358,209 @@ func (endpoint *Endpoint) ChanCloseInit() error {
 	return endpoint.Chain.sendMsgs(msg)
 }
 
+// ChanUpgradeInit initializes a channel upgrade on the associated endpoint using the upgrade fields
+// of the ProposedUpgrade set in the channel config. The upgrade is initialized through the handler
+// of the governance proposal, and a block is committed to persist the upgrade.
+func (endpoint *Endpoint) ChanUpgradeInit() error {
+	proposal := channeltypes.NewChannelUpgradeProposal(
+		"channel upgrade", "upgrade the channel",
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.ChannelConfig.ProposedUpgrade.Fields,
+	)
+
+	if err := endpoint.Chain.App.GetIBCKeeper().HandleChannelUpgradeProposal(endpoint.Chain.GetContext(), proposal); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// ChanForceClose closes the channel on the associated endpoint through the handler of
+// the governance proposal, and a block is committed to persist the closure.
+func (endpoint *Endpoint) ChanForceClose() error {
+	proposal := channeltypes.NewChannelCloseProposal(
+		"channel close", "close the channel",
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+	)
+
+	if err := endpoint.Chain.App.GetIBCKeeper().HandleChannelCloseProposal(endpoint.Chain.GetContext(), proposal); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// FreezeChannel freezes the channel on the associated endpoint through the handler of
+// the governance proposal, and a block is committed to persist the freeze.
+func (endpoint *Endpoint) FreezeChannel() error {
+	proposal := channeltypes.NewChannelFreezeProposal(
+		"channel freeze", "freeze the channel",
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+	)
+
+	if err := endpoint.Chain.App.GetIBCKeeper().HandleChannelFreezeProposal(endpoint.Chain.GetContext(), proposal); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// UnfreezeChannel unfreezes the channel on the associated endpoint through the handler of
+// the governance proposal, and a block is committed to persist the unfreeze.
+func (endpoint *Endpoint) UnfreezeChannel() error {
+	proposal := channeltypes.NewChannelUnfreezeProposal(
+		"channel unfreeze", "unfreeze the channel",
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+	)
+
+	if err := endpoint.Chain.App.GetIBCKeeper().HandleChannelUnfreezeProposal(endpoint.Chain.GetContext(), proposal); err != nil {
+		return err
+	}
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// ChanUpgradeTry sends a MsgChannelUpgradeTry on the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeTry() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.T, err)
+
+	upgrade := endpoint.GetProposedUpgrade()
+	counterpartyUpgrade := endpoint.Counterparty.GetChannelUpgrade()
+	proofChannel, proofUpgrade, height := endpoint.Counterparty.QueryChannelUpgradeProof()
+
+	msg := channeltypes.NewMsgChannelUpgradeTry(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		upgrade.Fields.ConnectionHops,
+		counterpartyUpgrade.Fields,
+		endpoint.Counterparty.GetChannel().UpgradeSequence,
+		proofChannel,
+		proofUpgrade,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeAck sends a MsgChannelUpgradeAck to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeAck() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.T, err)
+
+	counterpartyUpgrade := endpoint.Counterparty.GetChannelUpgrade()
+	proofChannel, proofUpgrade, height := endpoint.Counterparty.QueryChannelUpgradeProof()
+
+	msg := channeltypes.NewMsgChannelUpgradeAck(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		counterpartyUpgrade,
+		proofChannel,
+		proofUpgrade,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeConfirm sends a MsgChannelUpgradeConfirm to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.T, err)
+
+	counterpartyUpgrade := endpoint.Counterparty.GetChannelUpgrade()
+	proofChannel, proofUpgrade, height := endpoint.Counterparty.QueryChannelUpgradeProof()
+
+	msg := channeltypes.NewMsgChannelUpgradeConfirm(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		endpoint.Counterparty.GetChannel().State,
+		counterpartyUpgrade,
+		proofChannel,
+		proofUpgrade,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeOpen sends a MsgChannelUpgradeOpen to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeOpen() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.T, err)
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofChannel, height := endpoint.Counterparty.QueryProof(channelKey)
+
+	counterpartyChannel := endpoint.Counterparty.GetChannel()
+	msg := channeltypes.NewMsgChannelUpgradeOpen(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		counterpartyChannel.State,
+		counterpartyChannel.UpgradeSequence,
+		proofChannel,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeTimeout sends a MsgChannelUpgradeTimeout to the associated endpoint.
+func (endpoint *Endpoint) ChanUpgradeTimeout() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.T, err)
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofChannel, height := endpoint.Counterparty.QueryProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelUpgradeTimeout(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		endpoint.Counterparty.GetChannel(),
+		proofChannel,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanUpgradeCancel sends a MsgChannelUpgradeCancel to the associated endpoint using the
+// error receipt written on the counterparty.
+func (endpoint *Endpoint) ChanUpgradeCancel() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.T, err)
+
+	errorReceipt, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgradeErrorReceipt(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	require.True(endpoint.Chain.T, found)
+
+	errorReceiptKey := host.ChannelUpgradeErrorKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proofErrorReceipt, height := endpoint.Counterparty.QueryProof(errorReceiptKey)
+
+	msg := channeltypes.NewMsgChannelUpgradeCancel(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		errorReceipt,
+		proofErrorReceipt,
+		height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
 // SendPacket sends a packet through the channel keeper using the associated endpoint
 // The counterparty client is updated so proofs can be sent to the counterparty chain.
 func (endpoint *Endpoint) SendPacket(packet exported.PacketI) error {
@@ -408,6 +611,27 @@ func (endpoint *Endpoint) RecvPacketWithResult(packet channeltypes.Packet) (*sdk
 	return res, nil
 }
 
+// RecvPackets receives a batch of packets sent on the counterparty channel end on the
+// associated endpoint with a single batch proof of their commitments. The counterparty
+// client is updated.
+func (endpoint *Endpoint) RecvPackets(packets []channeltypes.Packet) error {
+	// get batch proof of packet commitments on source
+	packetKeys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		packetKeys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+	proof, proofHeight := endpoint.Counterparty.Chain.QueryProofs(packetKeys...)
+
+	recvMsg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	// receive on counterparty and update source client
+	if err := endpoint.Chain.sendMsgs(recvMsg); err != nil {
+		return err
+	}
+
+	return endpoint.Counterparty.UpdateClient()
+}
+
 // WriteAcknowledgement writes an acknowledgement on the channel associated with the endpoint.
 // The counterparty client is updated.
 func (endpoint *Endpoint) WriteAcknowledgement(ack exported.Acknowledgement, packet exported.PacketI) error {
@@ -436,6 +660,21 @@ func (endpoint *Endpoint) AcknowledgePacket(packet channeltypes.Packet, ack []by
 	return endpoint.Chain.sendMsgs(ackMsg)
 }
 
+// AcknowledgePackets sends a MsgAcknowledgements to the channel associated with the endpoint
+// with a single batch proof of the acknowledgements written on the counterparty.
+func (endpoint *Endpoint) AcknowledgePackets(packets []channeltypes.Packet, acks [][]byte) error {
+	// get batch proof of acknowledgements on counterparty
+	packetKeys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		packetKeys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+	proof, proofHeight := endpoint.Counterparty.Chain.QueryProofs(packetKeys...)
+
+	ackMsg := channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
 // TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	// get proof for timeout based on channel order
@@ -444,7 +683,7 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
+	case channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
@@ -472,6 +711,12 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		// a timeout receipt is proven for packets received after their timeout
+		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+		if _, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()); found {
+			packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		}
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
@@ -487,6 +732,7 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	timeoutOnCloseMsg := channeltypes.NewMsgTimeoutOnClose(
 		packet, nextSeqRecv,
 		proof, proofClosed, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.GetChannel().UpgradeSequence,
 	)
 
 	return endpoint.Chain.sendMsgs(timeoutOnCloseMsg)
@@ -557,6 +803,39 @@ func (endpoint *Endpoint) SetChannel(channel channeltypes.Channel) {
 	endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, channel)
 }
 
+// GetChannelUpgrade retrieves the upgrade for the channel of the endpoint. The
+// upgrade is expected to exist otherwise testing will fail.
+func (endpoint *Endpoint) GetChannelUpgrade() channeltypes.Upgrade {
+	upgrade, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.T, found)
+
+	return upgrade
+}
+
+// SetChannelUpgrade sets the upgrade for the channel of the endpoint.
+func (endpoint *Endpoint) SetChannelUpgrade(upgrade channeltypes.Upgrade) {
+	endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SetUpgrade(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, upgrade)
+}
+
+// GetProposedUpgrade returns the upgrade set in the channel config of the endpoint.
+func (endpoint *Endpoint) GetProposedUpgrade() channeltypes.Upgrade {
+	return endpoint.ChannelConfig.ProposedUpgrade
+}
+
+// QueryChannelUpgradeProof returns the proofs of the channel and the channel upgrade
+// of the endpoint along with the proof height.
+func (endpoint *Endpoint) QueryChannelUpgradeProof() ([]byte, []byte, clienttypes.Height) {
+	portID, channelID := endpoint.ChannelConfig.PortID, endpoint.ChannelID
+
+	channelKey := host.ChannelKey(portID, channelID)
+	proofChannel, height := endpoint.QueryProof(channelKey)
+
+	upgradeKey := host.ChannelUpgradeKey(portID, channelID)
+	proofUpgrade, _ := endpoint.QueryProof(upgradeKey)
+
+	return proofChannel, proofUpgrade, height
+}
+
 // QueryClientStateProof performs and abci query for a client stat associated
 // with this endpoint and returns the ClientState along with the proof.
 func (endpoint *Endpoint) QueryClientStateProof() (exported.ClientState, []byte) {
@@ -22,8 +22,9 @@ import (
 const (
 	ModuleName = "mock"
 
-	PortID  = ModuleName
-	Version = "mock-version"
+	PortID         = ModuleName
+	Version        = "mock-version"
+	UpgradeVersion = "mock-version-v2"
 )
 
 var (
@@ -9,11 +9,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	ibcfeetypes "github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	"github.com/cosmos/ibc-go/v3/testing/mock"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
 
 const (
@@ -33,6 +35,7 @@ const (
 	// Application Ports
 	TransferPort = ibctransfertypes.ModuleName
 	MockPort     = mock.ModuleName
+	MockFeePort  = simapp.MockFeePort
 
 	// used for testing proposals
 	Title       = "title"
@@ -59,5 +62,7 @@ var (
 	MockFailPacketData           = mock.MockFailPacketData
 	MockRecvCanaryCapabilityName = mock.MockRecvCanaryCapabilityName
 
+	MockFeeVersion = string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: mock.Version}))
+
 	prefix = commitmenttypes.NewMerklePrefix([]byte("ibc"))
 )